@@ -0,0 +1,198 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"container/list"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+// DefaultCompileCacheSize is the default maximum number of entries held by each of the compile caches.
+const DefaultCompileCacheSize = 1000
+
+var (
+	compileCacheEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dikastes_compile_cache_evictions_total",
+		Help: "Number of compiled match clauses evicted from the compile caches, by cache.",
+	}, []string{"cache"})
+	selectorCompileFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "dikastes_selector_compile_failures_total",
+		Help: "Number of label selector clauses evaluated whose selector failed to compile.",
+	})
+
+	// Caches of the compiled forms of the selectors, CIDRs and regexes used in rules, keyed on their string form.  Policy is
+	// evaluated for every request so compiling these each time is wasteful.
+	selectorCache = newCompileCache("selector", DefaultCompileCacheSize, selector.Parse)
+	cidrCache     = newCompileCache("cidr", DefaultCompileCacheSize, func(s string) (*net.IPNet, error) {
+		_, ipn, err := net.ParseCIDR(s)
+		return ipn, err
+	})
+	// Regexes must match the whole path or header value, so they are anchored at both ends.  Explicit anchors are
+	// harmless.
+	regexCache = newCompileCache("regex", DefaultCompileCacheSize, func(s string) (*regexp.Regexp, error) {
+		return regexp.Compile("^(?:" + s + ")$")
+	})
+	// Globs are compiled to anchored regexes, in which "*" matches any run of characters and "?" any single character.
+	globCache = newCompileCache("glob", DefaultCompileCacheSize, func(s string) (*regexp.Regexp, error) {
+		var re strings.Builder
+		re.WriteString("^")
+		for _, r := range s {
+			switch r {
+			case '*':
+				re.WriteString(".*")
+			case '?':
+				re.WriteString(".")
+			default:
+				re.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+		re.WriteString("$")
+		return regexp.Compile(re.String())
+	})
+)
+
+func init() {
+	prometheus.MustRegister(compileCacheEvictions, selectorCompileFailures)
+}
+
+// SelectorFailureBehavior determines how a rule clause is treated when its label selector fails to compile.
+type SelectorFailureBehavior string
+
+const (
+	// SelectorFailClosed treats a clause whose selector fails to compile as not matching, so the rule doesn't match.
+	SelectorFailClosed SelectorFailureBehavior = "fail-closed"
+	// SelectorFailOpen treats a clause whose selector fails to compile as matching any labels, so the rule matches if
+	// its other clauses do.
+	SelectorFailOpen SelectorFailureBehavior = "fail-open"
+)
+
+var (
+	selectorFailureBehaviorLock sync.RWMutex
+	selectorFailureBehavior     = SelectorFailClosed
+)
+
+// ParseSelectorFailureBehavior parses a SelectorFailureBehavior from its (case-insensitive) name.
+func ParseSelectorFailureBehavior(s string) (SelectorFailureBehavior, error) {
+	b := SelectorFailureBehavior(strings.ToLower(s))
+	switch b {
+	case SelectorFailClosed, SelectorFailOpen:
+		return b, nil
+	}
+	return "", fmt.Errorf("unknown selector failure behavior %q", s)
+}
+
+// SetSelectorFailureBehavior sets how rule clauses whose label selectors fail to compile are treated.  The default is
+// SelectorFailClosed.
+func SetSelectorFailureBehavior(b SelectorFailureBehavior) {
+	selectorFailureBehaviorLock.Lock()
+	defer selectorFailureBehaviorLock.Unlock()
+	selectorFailureBehavior = b
+}
+
+// currentSelectorFailureBehavior returns the SelectorFailureBehavior set by SetSelectorFailureBehavior.
+func currentSelectorFailureBehavior() SelectorFailureBehavior {
+	selectorFailureBehaviorLock.RLock()
+	defer selectorFailureBehaviorLock.RUnlock()
+	return selectorFailureBehavior
+}
+
+// SetCompileCacheSize sets the maximum number of entries held by each of the compile caches, evicting the least
+// recently used entries if a cache is already over the new limit.
+func SetCompileCacheSize(size int) {
+	selectorCache.setMaxSize(size)
+	cidrCache.setMaxSize(size)
+	regexCache.setMaxSize(size)
+	globCache.setMaxSize(size)
+}
+
+// compileCache is a bounded cache of compiled values, keyed on their source string.  Once the cache is full, the
+// least recently used entry is evicted to make room for a new one.  Compilation errors are cached along with values.
+type compileCache[V any] struct {
+	lock    sync.Mutex
+	name    string
+	maxSize int
+	compile func(string) (V, error)
+
+	// lru holds the entries, most recently used first.  entries indexes the list elements by key.
+	lru     *list.List
+	entries map[string]*list.Element
+
+	evictions prometheus.Counter
+}
+
+type compileCacheEntry[V any] struct {
+	key   string
+	value V
+	err   error
+}
+
+func newCompileCache[V any](name string, maxSize int, compile func(string) (V, error)) *compileCache[V] {
+	return &compileCache[V]{
+		name:      name,
+		maxSize:   maxSize,
+		compile:   compile,
+		lru:       list.New(),
+		entries:   map[string]*list.Element{},
+		evictions: compileCacheEvictions.WithLabelValues(name),
+	}
+}
+
+// get returns the compiled form of the given string, compiling it if it isn't already cached.
+func (c *compileCache[V]) get(key string) (V, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		entry := elem.Value.(*compileCacheEntry[V])
+		return entry.value, entry.err
+	}
+	value, err := c.compile(key)
+	c.entries[key] = c.lru.PushFront(&compileCacheEntry[V]{key: key, value: value, err: err})
+	c.evictLockHeld()
+	return value, err
+}
+
+func (c *compileCache[V]) setMaxSize(size int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.maxSize = size
+	c.evictLockHeld()
+}
+
+func (c *compileCache[V]) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *compileCache[V]) evictLockHeld() {
+	for c.lru.Len() > c.maxSize {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.entries, elem.Value.(*compileCacheEntry[V]).key)
+		c.evictions.Inc()
+	}
+}
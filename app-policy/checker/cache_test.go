@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+// Entries past the cache's limit evict the least recently used entry and count the eviction.
+func TestCompileCacheEviction(t *testing.T) {
+	RegisterTestingT(t)
+
+	var compiled []string
+	c := newCompileCache("test-eviction", 2, func(s string) (string, error) {
+		compiled = append(compiled, s)
+		return s + "-compiled", nil
+	})
+	evictions := func() float64 {
+		return testutil.ToFloat64(compileCacheEvictions.WithLabelValues("test-eviction"))
+	}
+
+	v, err := c.get("a")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(v).To(Equal("a-compiled"))
+	_, _ = c.get("b")
+	_, _ = c.get("a") // Cached, and now more recently used than "b".
+	Expect(compiled).To(Equal([]string{"a", "b"}))
+	Expect(c.len()).To(Equal(2))
+	Expect(evictions()).To(BeZero())
+
+	// Going over the limit evicts "b", the least recently used entry.
+	_, _ = c.get("c")
+	Expect(c.len()).To(Equal(2))
+	Expect(evictions()).To(Equal(1.0))
+	_, _ = c.get("a")
+	Expect(compiled).To(Equal([]string{"a", "b", "c"}))
+	_, _ = c.get("b")
+	Expect(compiled).To(Equal([]string{"a", "b", "c", "b"}))
+	Expect(evictions()).To(Equal(2.0))
+
+	// Shrinking the cache evicts down to the new limit.
+	c.setMaxSize(1)
+	Expect(c.len()).To(Equal(1))
+	Expect(evictions()).To(Equal(3.0))
+}
+
+// Compilation errors are cached along with the values.
+func TestCompileCacheError(t *testing.T) {
+	RegisterTestingT(t)
+
+	calls := 0
+	compileErr := errors.New("bad input")
+	c := newCompileCache("test-error", 2, func(s string) (int, error) {
+		calls++
+		return 0, compileErr
+	})
+	_, err := c.get("x")
+	Expect(err).To(Equal(compileErr))
+	_, err = c.get("x")
+	Expect(err).To(Equal(compileErr))
+	Expect(calls).To(Equal(1))
+}
+
+// A malformed selector is cached as such, and keeps failing to match.
+func TestMatchLabelsBadSelectorCached(t *testing.T) {
+	RegisterTestingT(t)
+
+	const bad = "app == 'foo' &&"
+	labels := map[string]string{"app": "foo"}
+	Expect(matchLabels(bad, labels)).To(BeFalse())
+	_, err := selectorCache.get(bad)
+	Expect(err).To(HaveOccurred())
+	Expect(matchLabels(bad, labels)).To(BeFalse())
+}
+
+// Compare matching labels through the selector cache with parsing the selector for every match.
+func BenchmarkMatchLabels(b *testing.B) {
+	const sel = "app == 'web' && env in {'prod', 'staging'} && !has(debug)"
+	labels := map[string]string{"app": "web", "env": "prod"}
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			matchLabels(sel, labels)
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			parsed, err := selector.Parse(sel)
+			if err != nil {
+				b.Fatal(err)
+			}
+			parsed.Evaluate(labels)
+		}
+	})
+}
@@ -15,6 +15,8 @@
 package checker
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/projectcalico/calico/app-policy/policystore"
@@ -43,10 +45,171 @@ const (
 	NO_MATCH // Indicates policy did not match request. Cannot be assigned to rule.
 )
 
+// String returns the name of the action, as used in rules, or "no-match".
+func (a Action) String() string {
+	switch a {
+	case ALLOW:
+		return "allow"
+	case DENY:
+		return "deny"
+	case LOG:
+		return "log"
+	case PASS:
+		return "pass"
+	}
+	return "no-match"
+}
+
+// MalformedRequestAction is the action to take for a CheckRequest that is missing required attributes.
+type MalformedRequestAction string
+
+const (
+	MalformedRequestDeny  MalformedRequestAction = "deny"
+	MalformedRequestAllow MalformedRequestAction = "allow"
+	MalformedRequestError MalformedRequestAction = "error"
+)
+
+// ParseMalformedRequestAction parses a MalformedRequestAction from its (case-insensitive) name.
+func ParseMalformedRequestAction(s string) (MalformedRequestAction, error) {
+	a := MalformedRequestAction(strings.ToLower(s))
+	switch a {
+	case MalformedRequestDeny, MalformedRequestAllow, MalformedRequestError:
+		return a, nil
+	}
+	return "", fmt.Errorf("unknown malformed request action %q", s)
+}
+
+// UnknownClauseBehavior is a policy for treating rule clauses that can't be evaluated because the request lacks the
+// data that they need.
+type UnknownClauseBehavior string
+
+const (
+	// UnknownClauseNoMatch treats a clause that can't be evaluated as not matching, so the rule doesn't match.
+	UnknownClauseNoMatch UnknownClauseBehavior = "no-match"
+	// UnknownClauseMatch ignores a clause that can't be evaluated, so the rule matches if its other clauses do.
+	UnknownClauseMatch UnknownClauseBehavior = "match"
+	// UnknownClauseFailClosed treats a clause that can't be evaluated as matching in deny rules and as not matching in
+	// all other rules, so missing data can only ever result in a request being denied.
+	UnknownClauseFailClosed UnknownClauseBehavior = "fail-closed"
+)
+
+// ParseUnknownClauseBehavior parses an UnknownClauseBehavior from its (case-insensitive) name.
+func ParseUnknownClauseBehavior(s string) (UnknownClauseBehavior, error) {
+	b := UnknownClauseBehavior(strings.ToLower(s))
+	switch b {
+	case UnknownClauseNoMatch, UnknownClauseMatch, UnknownClauseFailClosed:
+		return b, nil
+	}
+	return "", fmt.Errorf("unknown unknown-clause behavior %q", s)
+}
+
+// statusCode returns the status code to respond with for a malformed request.
+func (a MalformedRequestAction) statusCode() int32 {
+	switch a {
+	case MalformedRequestAllow:
+		return OK
+	case MalformedRequestError:
+		return INVALID_ARGUMENT
+	default:
+		return PERMISSION_DENIED
+	}
+}
+
+// validateCheckRequest returns an error if the request is missing attributes that policy evaluation requires.
+func validateCheckRequest(req *authz.CheckRequest) error {
+	attrs := req.GetAttributes()
+	if attrs == nil {
+		return errors.New("request has no attributes")
+	}
+	if attrs.GetSource() == nil {
+		return errors.New("request has no source")
+	}
+	if attrs.GetDestination() == nil {
+		return errors.New("request has no destination")
+	}
+	return nil
+}
+
 // checkStore applies the policy in the given store and returns OK if the check passes, or PERMISSION_DENIED if the
 // check fails. Note, if no policy matches, the default is PERMISSION_DENIED.
 func checkStore(store *policystore.PolicyStore, req *authz.CheckRequest) (s status.Status) {
+	s, _ = checkStoreWithMatch(store, req)
+	return
+}
+
+// MatchResult identifies the policy or profile, and the rule within it, that determined a decision.  Exactly one of
+// Policy and Profile is set if a rule matched.
+type MatchResult struct {
+	// Tier is the tier of the policy.  It is empty if the decision was made by a profile.
+	Tier    string
+	Policy  string
+	Profile string
+	// RuleIndex is the index of the rule in the inbound rules of the policy or profile, or -1 if no rule matched,
+	// for example because no policy in the tier matched and the tier's default deny applies.
+	RuleIndex int
+	// Action is the action of the rule, or NO_MATCH if no rule matched.
+	Action Action
+}
+
+// NoMatch is the MatchResult of a decision that wasn't made by a rule.
+var NoMatch = MatchResult{RuleIndex: -1, Action: NO_MATCH}
+
+// Matched returns true if a rule determined the decision.
+func (m MatchResult) Matched() bool {
+	return m.RuleIndex >= 0
+}
+
+// String returns the policy or profile in the form "<tier>/<policy>" or "profile/<profile>", or "" if there is none.
+func (m MatchResult) String() string {
+	switch {
+	case m.Policy != "":
+		return m.Tier + "/" + m.Policy
+	case m.Profile != "":
+		return "profile/" + m.Profile
+	}
+	return ""
+}
+
+// checkStoreWithMatch is checkStore, but it also returns the policy or profile, and the rule within it, that
+// determined the decision.
+func checkStoreWithMatch(store *policystore.PolicyStore, req *authz.CheckRequest) (s status.Status, matched MatchResult) {
+	return checkStoreWithConfig(store, req, checkConfig{})
+}
+
+// checkConfig holds the settings that apply to every check, as opposed to the policy state in the store, which is
+// replaced on every resync.  The zero value is the default configuration.
+type checkConfig struct {
+	// allowedHTTPMethods is a global allowlist of HTTP methods.  When non-empty, any HTTP request whose method is not
+	// in the list is denied before any policy is evaluated.
+	allowedHTTPMethods []string
+	// trustedProxyCIDRs holds the addresses of the proxies that are trusted to report the client address in the
+	// X-Forwarded-For header.  When non-empty, source net clauses match the client address found by walking the header
+	// right-to-left past the trusted proxies, rather than the address of the immediate peer.
+	trustedProxyCIDRs []string
+	// unknownClauseBehavior determines how a rule clause is treated when the request lacks the data needed to
+	// evaluate it, for example an HTTP match on a request without HTTP attributes.  The zero value is
+	// UnknownClauseNoMatch.
+	unknownClauseBehavior UnknownClauseBehavior
+	// denyHairpin restricts hairpin requests, whose source and destination are the same IP, to the Allow rules that
+	// set allow_hairpin.  Other Allow rules don't match them, so they are denied unless explicitly allowed.
+	denyHairpin bool
+	// responsePhase indicates that checks are made after requests complete, for example by an access-log style
+	// integration, so that Envoy passes the response code and request duration in the metadata.  Only then can rules
+	// match on them.
+	responsePhase bool
+	// reverseDNS, if set, gives the reverse-DNS names of destination IP addresses for rules that match on them.  If
+	// it is nil, no address has a reverse-DNS name.
+	reverseDNS policystore.ReverseDNSCache
+	// missingDataBehavior determines how rules that refer to data missing from the store are treated.
+	missingDataBehavior MissingDataBehavior
+}
+
+// checkStoreWithConfig is checkStoreWithMatch, using the given configuration rather than the default.
+func checkStoreWithConfig(
+	store *policystore.PolicyStore, req *authz.CheckRequest, config checkConfig,
+) (s status.Status, matched MatchResult) {
 	s = status.Status{Code: PERMISSION_DENIED}
+	matched = NoMatch
 	ep := store.Endpoint
 	if ep == nil {
 		log.Warning("CheckRequest before we synced Endpoint information.")
@@ -57,6 +220,12 @@ func checkStore(store *policystore.PolicyStore, req *authz.CheckRequest) (s stat
 		log.WithField("error", err).Error("Failed to init requestCache")
 		return
 	}
+	reqCache.config = config
+	if http := req.GetAttributes().GetRequest().GetHttp(); http != nil &&
+		!matchHTTPMethods(config.allowedHTTPMethods, http.GetMethod(), false) {
+		log.WithField("method", http.GetMethod()).Debug("HTTP method not in global allowlist, deny request.")
+		return
+	}
 	defer func() {
 		if r := recover(); r != nil {
 			// Recover from the panic if we know what it is and we know what to do with it.
@@ -67,67 +236,68 @@ func checkStore(store *policystore.PolicyStore, req *authz.CheckRequest) (s stat
 			}
 		}
 	}()
-	if len(ep.Tiers) > 0 {
-		// We only support a single tier.
-		log.Debug("Checking policy tier 1.")
-
-		tier := ep.Tiers[0]
-		policies := tier.IngressPolicies
-		action := NO_MATCH
-	Policy:
-		for i, name := range policies {
+	// Evaluate the tiers in order.  An allow or deny ends evaluation, while a pass moves on to the next tier.
+Tier:
+	for t, tier := range ep.Tiers {
+		log.WithField("tier", tier.GetName()).Debugf("Checking policy tier %d.", t+1)
+		for i, name := range tier.IngressPolicies {
 			pID := proto.PolicyID{Tier: tier.GetName(), Name: name}
 			policy := store.PolicyByID[pID]
-			action = checkPolicy(policy, reqCache)
+			action, ruleIndex := checkPolicyRules(policy, reqCache)
 			log.WithFields(log.Fields{
-				"ordinal":  i,
-				"PolicyID": pID,
-				"result":   action,
+				"ordinal":   i,
+				"PolicyID":  pID,
+				"result":    action,
+				"ruleIndex": ruleIndex,
 			}).Debug("Policy checked")
 			switch action {
 			case NO_MATCH:
 				continue
-			// If the Policy matches, end evaluation (skipping profiles, if any)
+			// If the Policy matches, end evaluation (skipping later tiers and profiles, if any)
 			case ALLOW:
 				s.Code = OK
+				matched = MatchResult{Tier: tier.GetName(), Policy: name, RuleIndex: ruleIndex, Action: action}
 				return
 			case DENY:
 				s.Code = PERMISSION_DENIED
+				matched = MatchResult{Tier: tier.GetName(), Policy: name, RuleIndex: ruleIndex, Action: action}
 				return
 			case PASS:
-				// Pass means end evaluation of policies and proceed to profiles, if any.
-				break Policy
+				// Pass means end evaluation of policies in this tier and proceed to the next tier, or to the
+				// profiles if this is the last tier.
+				continue Tier
 			case LOG:
 				panic("policy should never return LOG action")
 			}
 		}
-		// Done evaluating policies in the tier. If no policy rules have matched, there is an implicit default deny
-		// at the end of the tier.
-		if action == NO_MATCH {
-			log.Debug("No policy matched. Tier default DENY applies.")
-			s.Code = PERMISSION_DENIED
-			return
-		}
+		// Done evaluating policies in the tier and no policy rules have matched, so there is an implicit default
+		// deny at the end of the tier.
+		log.WithField("tier", tier.GetName()).Debug("No policy matched. Tier default DENY applies.")
+		s.Code = PERMISSION_DENIED
+		return
 	}
-	// If we reach here, there were either no tiers, or a policy PASSed the request.
+	// If we reach here, there were either no tiers, or a policy in every tier PASSed the request.
 	if len(ep.ProfileIds) > 0 {
 		for i, name := range ep.ProfileIds {
 			pID := proto.ProfileID{Name: name}
 			profile := store.ProfileByID[pID]
-			action := checkProfile(profile, reqCache)
+			action, ruleIndex := checkProfileRules(profile, reqCache)
 			log.WithFields(log.Fields{
 				"ordinal":   i,
 				"ProfileID": pID,
 				"result":    action,
+				"ruleIndex": ruleIndex,
 			}).Debug("Profile checked")
 			switch action {
 			case NO_MATCH:
 				continue
 			case ALLOW:
 				s.Code = OK
+				matched = MatchResult{Profile: name, RuleIndex: ruleIndex, Action: action}
 				return
 			case DENY, PASS:
 				s.Code = PERMISSION_DENIED
+				matched = MatchResult{Profile: name, RuleIndex: ruleIndex, Action: action}
 				return
 			case LOG:
 				log.Panic("profile should never return LOG action")
@@ -142,27 +312,39 @@ func checkStore(store *policystore.PolicyStore, req *authz.CheckRequest) (s stat
 
 // checkPolicy checks if the policy matches the request data, and returns the action.
 func checkPolicy(policy *proto.Policy, req *requestCache) (action Action) {
+	action, _ = checkPolicyRules(policy, req)
+	return
+}
+
+// checkPolicyRules is checkPolicy, but it also returns the index of the rule that matched, or -1 if none did.
+func checkPolicyRules(policy *proto.Policy, req *requestCache) (action Action, ruleIndex int) {
 	// Note that we support only inbound policy.
 	return checkRules(policy.InboundRules, req, policy.Namespace)
 }
 
 func checkProfile(p *proto.Profile, req *requestCache) (action Action) {
+	action, _ = checkProfileRules(p, req)
+	return
+}
+
+// checkProfileRules is checkProfile, but it also returns the index of the rule that matched, or -1 if none did.
+func checkProfileRules(p *proto.Profile, req *requestCache) (action Action, ruleIndex int) {
 	return checkRules(p.InboundRules, req, "")
 }
 
-func checkRules(rules []*proto.Rule, req *requestCache, policyNamespace string) (action Action) {
-	for _, r := range rules {
+func checkRules(rules []*proto.Rule, req *requestCache, policyNamespace string) (action Action, ruleIndex int) {
+	for i, r := range rules {
 		if match(r, req, policyNamespace) {
 			log.Debugf("Rule matched.")
 			a := actionFromString(r.Action)
 			if a != LOG {
 				// We don't support actually logging requests, but if we hit a LOG action, we should
 				// continue processing rules.
-				return a
+				return a, i
 			}
 		}
 	}
-	return NO_MATCH
+	return NO_MATCH, -1
 }
 
 // actionFromString converts a string action name, like "allow" into an Action.
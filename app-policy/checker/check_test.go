@@ -355,6 +355,70 @@ func TestCheckStorePass(t *testing.T) {
 	Expect(status.Code).To(Equal(OK))
 }
 
+// Ensure policy action of "Pass" moves evaluation to the next tier, and that an allow or deny in a tier ends
+// evaluation without considering later tiers.
+func TestCheckStoreMultipleTiers(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{
+			{Name: "tier1", IngressPolicies: []string{"policy1"}},
+			{Name: "tier2", IngressPolicies: []string{"policy2"}},
+		},
+		ProfileIds: []string{"profile1"},
+	}
+
+	// Policy1 passes GETs to tier2, denies DELETEs and doesn't match anything else.
+	store.PolicyByID[proto.PolicyID{Tier: "tier1", Name: "policy1"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{Action: "pass", HttpMatch: &proto.HTTPMatch{Methods: []string{"GET"}}},
+			{Action: "deny", HttpMatch: &proto.HTTPMatch{Methods: []string{"DELETE"}}},
+		},
+	}
+	// Policy2 would allow everything.
+	store.PolicyByID[proto.PolicyID{Tier: "tier2", Name: "policy2"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{{Action: "allow"}},
+	}
+	// Profile1 would allow everything.
+	store.ProfileByID[proto.ProfileID{Name: "profile1"}] = &proto.Profile{
+		InboundRules: []*proto.Rule{{Action: "allow"}},
+	}
+
+	req := func(method string) *authz.CheckRequest {
+		return &authz.CheckRequest{Attributes: &authz.AttributeContext{
+			Source:      &authz.AttributeContext_Peer{Principal: "spiffe://cluster.local/ns/default/sa/steve"},
+			Destination: &authz.AttributeContext_Peer{Principal: "spiffe://cluster.local/ns/default/sa/molly"},
+			Request: &authz.AttributeContext_Request{
+				Http: &authz.AttributeContext_HttpRequest{Method: method},
+			},
+		}}
+	}
+
+	// The pass in tier1 moves on to tier2, which allows.
+	status, matched := checkStoreWithMatch(store, req("GET"))
+	Expect(status.Code).To(Equal(OK))
+	Expect(matched).To(Equal(MatchResult{Tier: "tier2", Policy: "policy2", RuleIndex: 0, Action: ALLOW}))
+
+	// The deny in tier1 is terminal, so tier2 is never evaluated.
+	status, matched = checkStoreWithMatch(store, req("DELETE"))
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+	Expect(matched).To(Equal(MatchResult{Tier: "tier1", Policy: "policy1", RuleIndex: 1, Action: DENY}))
+
+	// Nothing in tier1 matches, so its default deny applies, again without evaluating tier2.
+	status, matched = checkStoreWithMatch(store, req("POST"))
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+	Expect(matched).To(Equal(NoMatch))
+
+	// If tier2 passes too, evaluation continues with the profiles.
+	store.PolicyByID[proto.PolicyID{Tier: "tier2", Name: "policy2"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{{Action: "pass"}},
+	}
+	status, matched = checkStoreWithMatch(store, req("GET"))
+	Expect(status.Code).To(Equal(OK))
+	Expect(matched).To(Equal(MatchResult{Profile: "profile1", RuleIndex: 0, Action: ALLOW}))
+}
+
 func TestCheckStoreInitFails(t *testing.T) {
 	RegisterTestingT(t)
 
@@ -417,3 +481,104 @@ func TestCheckStoreWithInvalidData(t *testing.T) {
 	status := checkStore(store, req)
 	Expect(status.Code).To(Equal(INVALID_ARGUMENT))
 }
+
+// The HTTP method allowlist denies methods outside the list, regardless of policy.
+func TestCheckStoreAllowedHTTPMethods(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{{
+			Name:            "tier1",
+			IngressPolicies: []string{"policy1"},
+		}},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "tier1", Name: "policy1"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{{Action: "allow"}},
+	}
+	config := checkConfig{allowedHTTPMethods: []string{"GET", "HEAD"}}
+
+	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/steve",
+		},
+		Destination: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/sue",
+		},
+		Request: &authz.AttributeContext_Request{
+			Http: &authz.AttributeContext_HttpRequest{Method: "GET"},
+		},
+	}}
+	status, _ := checkStoreWithConfig(store, req, config)
+	Expect(status.Code).To(Equal(OK))
+
+	http := req.GetAttributes().GetRequest().GetHttp()
+	http.Method = "DELETE"
+	status, _ = checkStoreWithConfig(store, req, config)
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+
+	// An empty allowlist allows all methods.
+	status, _ = checkStoreWithConfig(store, req, checkConfig{})
+	Expect(status.Code).To(Equal(OK))
+}
+
+// checkStoreWithMatch reports the policy and rule that made the decision when allow and deny policies overlap.
+func TestCheckStoreMatchResult(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{{
+			Name:            "tier1",
+			IngressPolicies: []string{"deny-delete", "allow-all"},
+		}},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "tier1", Name: "deny-delete"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{Action: "deny", HttpMatch: &proto.HTTPMatch{Methods: []string{"PUT"}}},
+			{Action: "deny", HttpMatch: &proto.HTTPMatch{Methods: []string{"DELETE"}}},
+		},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "tier1", Name: "allow-all"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{Action: "allow", HttpMatch: &proto.HTTPMatch{Methods: []string{"POST"}}},
+			{Action: "allow"},
+		},
+	}
+
+	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/steve",
+		},
+		Destination: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/sue",
+		},
+		Request: &authz.AttributeContext_Request{
+			Http: &authz.AttributeContext_HttpRequest{Method: "DELETE"},
+		},
+	}}
+	status, matched := checkStoreWithMatch(store, req)
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+	Expect(matched).To(Equal(MatchResult{Tier: "tier1", Policy: "deny-delete", RuleIndex: 1, Action: DENY}))
+	Expect(matched.String()).To(Equal("tier1/deny-delete"))
+
+	// Neither deny rule matches a GET, so the allow-all policy's catch-all rule decides.
+	req.GetAttributes().GetRequest().GetHttp().Method = "GET"
+	status, matched = checkStoreWithMatch(store, req)
+	Expect(status.Code).To(Equal(OK))
+	Expect(matched).To(Equal(MatchResult{Tier: "tier1", Policy: "allow-all", RuleIndex: 1, Action: ALLOW}))
+
+	req.GetAttributes().GetRequest().GetHttp().Method = "POST"
+	_, matched = checkStoreWithMatch(store, req)
+	Expect(matched.Policy).To(Equal("allow-all"))
+	Expect(matched.RuleIndex).To(Equal(0))
+	Expect(matched.Matched()).To(BeTrue())
+
+	// With no matching rule, the tier's default deny applies and no rule is reported.
+	store.PolicyByID[proto.PolicyID{Tier: "tier1", Name: "allow-all"}].InboundRules = nil
+	req.GetAttributes().GetRequest().GetHttp().Method = "GET"
+	status, matched = checkStoreWithMatch(store, req)
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+	Expect(matched.Matched()).To(BeFalse())
+	Expect(matched.RuleIndex).To(Equal(-1))
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Decision is the record of a single policy decision that is passed to a DecisionLogger.
+type Decision struct {
+	Time     time.Time `json:"time"`
+	SrcIP    string    `json:"src_ip"`
+	SrcPort  uint32    `json:"src_port"`
+	DstIP    string    `json:"dst_ip"`
+	DstPort  uint32    `json:"dst_port"`
+	Protocol string    `json:"protocol"`
+	// SrcPrincipal and DstPrincipal are the peers' principals, e.g. their SPIFFE IDs, and SrcNamespace and
+	// DstNamespace are the namespaces of the identities extracted from them.  They are empty for plain text requests.
+	SrcPrincipal string `json:"src_principal,omitempty"`
+	DstPrincipal string `json:"dst_principal,omitempty"`
+	SrcNamespace string `json:"src_namespace,omitempty"`
+	DstNamespace string `json:"dst_namespace,omitempty"`
+	// MatchedPolicy is the policy or profile that determined the decision, in the same form as recorded on check
+	// spans.  It is empty if the decision wasn't made by a rule, for example because the request was malformed or no
+	// policy matched.
+	MatchedPolicy string `json:"matched_policy"`
+	// Tier and Policy, or Profile, identify the policy or profile that determined the decision, and RuleIndex is the
+	// index of the matching rule in its inbound rules, or -1 if no rule matched.
+	Tier      string `json:"tier,omitempty"`
+	Policy    string `json:"policy,omitempty"`
+	Profile   string `json:"profile,omitempty"`
+	RuleIndex int    `json:"rule_index"`
+	// Action is the action of the matching rule, e.g. "allow" or "deny", or "no-match" if no rule matched.
+	Action string `json:"action"`
+	// Outcome is the name of the status code returned to Envoy, e.g. "OK" or "PERMISSION_DENIED".
+	Outcome string `json:"outcome"`
+}
+
+// DecisionLogger receives a Decision for every request that the server or a PolicyEvaluator checks.  LogDecision is called synchronously
+// on the request path, so implementations should not block; they must be safe for concurrent use.
+type DecisionLogger interface {
+	LogDecision(d Decision)
+}
+
+// NoOpDecisionLogger discards all decisions.  It is the default.
+type NoOpDecisionLogger struct{}
+
+func (NoOpDecisionLogger) LogDecision(Decision) {}
+
+// JSONDecisionLogger writes each decision to a writer as a line of JSON.
+type JSONDecisionLogger struct {
+	lock sync.Mutex
+	enc  *json.Encoder
+}
+
+// NewJSONDecisionLogger returns a JSONDecisionLogger that writes to w.
+func NewJSONDecisionLogger(w io.Writer) *JSONDecisionLogger {
+	return &JSONDecisionLogger{enc: json.NewEncoder(w)}
+}
+
+func (l *JSONDecisionLogger) LogDecision(d Decision) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if err := l.enc.Encode(d); err != nil {
+		log.WithError(err).Warn("Failed to write decision log.")
+	}
+}
+
+// WithDecisionLogger makes the server pass a record of each decision to the given DecisionLogger.
+func WithDecisionLogger(l DecisionLogger) ServerOption {
+	return func(s *authServer) {
+		s.decisionLogger = l
+	}
+}
+
+// newDecision builds the Decision for a check of the given request.
+func newDecision(req *authz.CheckRequest, st *status.Status, matched MatchResult) Decision {
+	srcPeer := req.GetAttributes().GetSource()
+	dstPeer := req.GetAttributes().GetDestination()
+	src := srcPeer.GetAddress().GetSocketAddress()
+	dst := dstPeer.GetAddress().GetSocketAddress()
+	return Decision{
+		Time:          time.Now(),
+		SrcIP:         src.GetAddress(),
+		SrcPort:       src.GetPortValue(),
+		DstIP:         dst.GetAddress(),
+		DstPort:       dst.GetPortValue(),
+		Protocol:      dst.GetProtocol().String(),
+		SrcPrincipal:  srcPeer.GetPrincipal(),
+		DstPrincipal:  dstPeer.GetPrincipal(),
+		SrcNamespace:  peerNamespace(req, srcPeer),
+		DstNamespace:  peerNamespace(req, dstPeer),
+		MatchedPolicy: matched.String(),
+		Tier:          matched.Tier,
+		Policy:        matched.Policy,
+		Profile:       matched.Profile,
+		RuleIndex:     matched.RuleIndex,
+		Action:        matched.Action.String(),
+		Outcome:       code.Code(st.GetCode()).String(),
+	}
+}
+
+// peerNamespace returns the namespace of the peer's identity, or "" if it has none or it can't be extracted.
+func peerNamespace(req *authz.CheckRequest, peer *authz.AttributeContext_Peer) string {
+	if peer == nil {
+		return ""
+	}
+	id, err := currentIdentityExtractor().Extract(req, peer)
+	if err != nil {
+		return ""
+	}
+	return id.Namespace
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/app-policy/policystore"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+type recordingDecisionLogger struct {
+	lock      sync.Mutex
+	decisions []Decision
+}
+
+func (l *recordingDecisionLogger) LogDecision(d Decision) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.decisions = append(l.decisions, d)
+}
+
+func decisionLogTestServer(ctx context.Context, l DecisionLogger) *authServer {
+	uut := NewServer(ctx, make(chan *policystore.PolicyStore), WithDecisionLogger(l))
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{{Name: "default", IngressPolicies: []string{"allow-80"}}},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "allow-80"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{{Action: "Allow", DstPorts: []*proto.PortRange{{First: 80, Last: 80}}}},
+	}
+	uut.Store = store
+	return uut
+}
+
+func decisionLogTestRequest(port uint32) *authz.CheckRequest {
+	return &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{Address: &core.Address{
+			Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+				Address:       "10.0.0.1",
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: 41000},
+			}},
+		}},
+		Destination: &authz.AttributeContext_Peer{Address: &core.Address{
+			Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+				Address:       "10.0.0.2",
+				Protocol:      core.SocketAddress_TCP,
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: port},
+			}},
+		}},
+	}}
+}
+
+// The sink receives exactly one record per check, with the flow tuple, the matched policy and the outcome.
+func TestDecisionLoggerOneRecordPerCheck(t *testing.T) {
+	RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := &recordingDecisionLogger{}
+	uut := decisionLogTestServer(ctx, sink)
+
+	for _, port := range []uint32{80, 443, 80} {
+		_, err := uut.Check(ctx, decisionLogTestRequest(port))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	_, err := uut.Check(ctx, &authz.CheckRequest{})
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(sink.decisions).To(HaveLen(4))
+	d := sink.decisions[0]
+	Expect(d.SrcIP).To(Equal("10.0.0.1"))
+	Expect(d.SrcPort).To(Equal(uint32(41000)))
+	Expect(d.DstIP).To(Equal("10.0.0.2"))
+	Expect(d.DstPort).To(Equal(uint32(80)))
+	Expect(d.Protocol).To(Equal("TCP"))
+	Expect(d.MatchedPolicy).To(Equal("default/allow-80"))
+	Expect(d.Outcome).To(Equal("OK"))
+	Expect(sink.decisions[1].DstPort).To(Equal(uint32(443)))
+	Expect(sink.decisions[1].Outcome).To(Equal("PERMISSION_DENIED"))
+	Expect(sink.decisions[2].Outcome).To(Equal("OK"))
+	// The malformed request is logged too, without a matched policy.
+	Expect(sink.decisions[3].MatchedPolicy).To(Equal(""))
+	Expect(sink.decisions[3].Outcome).To(Equal("PERMISSION_DENIED"))
+}
+
+func TestJSONDecisionLogger(t *testing.T) {
+	RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf bytes.Buffer
+	uut := decisionLogTestServer(ctx, NewJSONDecisionLogger(&buf))
+	for _, port := range []uint32{80, 443} {
+		_, err := uut.Check(ctx, decisionLogTestRequest(port))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	Expect(lines).To(HaveLen(2))
+	var d Decision
+	Expect(json.Unmarshal([]byte(lines[0]), &d)).To(Succeed())
+	Expect(d.DstPort).To(Equal(uint32(80)))
+	Expect(d.MatchedPolicy).To(Equal("default/allow-80"))
+	Expect(d.Outcome).To(Equal("OK"))
+	Expect(lines[1]).To(ContainSubstring(`"outcome":"PERMISSION_DENIED"`))
+}
+
+// Every field of the record of a denied HTTP request is filled in, whether it comes from the server or an evaluator.
+func TestDecisionLoggerDeniedHTTPRequest(t *testing.T) {
+	RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := decisionLogTestRequest(8080)
+	req.Attributes.Source.Principal = "spiffe://cluster.local/ns/frontend/sa/web"
+	req.Attributes.Destination.Principal = "spiffe://cluster.local/ns/payments/sa/api"
+	req.Attributes.Request = &authz.AttributeContext_Request{
+		Http: &authz.AttributeContext_HttpRequest{Method: "POST", Path: "/charge"},
+	}
+	expectDenied := func(d Decision) {
+		Expect(d.Time).ToNot(BeZero())
+		Expect(d.SrcIP).To(Equal("10.0.0.1"))
+		Expect(d.SrcPort).To(Equal(uint32(41000)))
+		Expect(d.DstIP).To(Equal("10.0.0.2"))
+		Expect(d.DstPort).To(Equal(uint32(8080)))
+		Expect(d.Protocol).To(Equal("TCP"))
+		Expect(d.SrcPrincipal).To(Equal("spiffe://cluster.local/ns/frontend/sa/web"))
+		Expect(d.DstPrincipal).To(Equal("spiffe://cluster.local/ns/payments/sa/api"))
+		Expect(d.SrcNamespace).To(Equal("frontend"))
+		Expect(d.DstNamespace).To(Equal("payments"))
+		Expect(d.MatchedPolicy).To(Equal("default/deny-8080"))
+		Expect(d.Tier).To(Equal("default"))
+		Expect(d.Policy).To(Equal("deny-8080"))
+		Expect(d.RuleIndex).To(Equal(1))
+		Expect(d.Action).To(Equal("deny"))
+		Expect(d.Outcome).To(Equal("PERMISSION_DENIED"))
+	}
+
+	sink := &recordingDecisionLogger{}
+	uut := decisionLogTestServer(ctx, sink)
+	uut.Store.Endpoint.Tiers[0].IngressPolicies = []string{"deny-8080"}
+	uut.Store.PolicyByID[proto.PolicyID{Tier: "default", Name: "deny-8080"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{Action: "Allow", DstPorts: []*proto.PortRange{{First: 80, Last: 80}}},
+			{Action: "Deny", HttpMatch: &proto.HTTPMatch{Methods: []string{"POST"}}},
+		},
+	}
+	_, err := uut.Check(ctx, req)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(sink.decisions).To(HaveLen(1))
+	expectDenied(sink.decisions[0])
+
+	evalSink := &recordingDecisionLogger{}
+	evaluator := NewPolicyEvaluator(uut.Store, WithEvaluatorDecisionLogger(evalSink))
+	d, err := evaluator.Evaluate(req)
+	Expect(err).ToNot(HaveOccurred())
+	expectDenied(d)
+	Expect(evalSink.decisions).To(HaveLen(1))
+	expectDenied(evalSink.decisions[0])
+}
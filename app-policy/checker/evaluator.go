@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+
+	"github.com/projectcalico/calico/app-policy/policystore"
+)
+
+// PolicyEvaluator evaluates requests against the policy in a PolicyStore, in the same way as the authorization server
+// but without the gRPC plumbing, for embedding the checker in other programs.  It is safe for concurrent use.
+type PolicyEvaluator struct {
+	store          *policystore.PolicyStore
+	decisionLogger DecisionLogger
+	config         checkConfig
+}
+
+// MissingDataBehavior determines how a rule is treated when it refers to data that the store doesn't have, such as an
+// IP set that hasn't been synced yet.  Felix renders selectors as IP sets, so this also covers unresolved selectors.
+type MissingDataBehavior int
+
+const (
+	// FailClosed makes a Deny rule that refers to missing data match, and any other rule not match, so that missing
+	// data can only ever result in a request being denied.  This is the default.
+	FailClosed MissingDataBehavior = iota
+	// FailOpen makes an Allow rule that refers to missing data match, and any other rule not match, so that missing
+	// data can only ever result in a request being allowed.
+	FailOpen
+)
+
+func (b MissingDataBehavior) String() string {
+	switch b {
+	case FailClosed:
+		return "fail-closed"
+	case FailOpen:
+		return "fail-open"
+	}
+	return fmt.Sprintf("MissingDataBehavior(%d)", int(b))
+}
+
+// ParseMissingDataBehavior parses a MissingDataBehavior from its (case-insensitive) name.
+func ParseMissingDataBehavior(s string) (MissingDataBehavior, error) {
+	switch strings.ToLower(s) {
+	case "fail-closed":
+		return FailClosed, nil
+	case "fail-open":
+		return FailOpen, nil
+	}
+	return FailClosed, fmt.Errorf("unknown missing data behavior %q", s)
+}
+
+// EvaluatorOption is an option for a PolicyEvaluator.
+type EvaluatorOption func(*PolicyEvaluator)
+
+// WithEvaluatorDecisionLogger makes the evaluator pass each decision that it makes to the given DecisionLogger.
+func WithEvaluatorDecisionLogger(l DecisionLogger) EvaluatorOption {
+	return func(e *PolicyEvaluator) {
+		e.decisionLogger = l
+	}
+}
+
+// WithEvaluatorMissingDataBehavior sets how the evaluator treats rules that refer to data missing from the store.
+func WithEvaluatorMissingDataBehavior(b MissingDataBehavior) EvaluatorOption {
+	return func(e *PolicyEvaluator) {
+		e.config.missingDataBehavior = b
+	}
+}
+
+// NewPolicyEvaluator returns a PolicyEvaluator for the policy in the given store.  The store may be updated while the
+// evaluator is in use, through its Write method.
+func NewPolicyEvaluator(store *policystore.PolicyStore, opts ...EvaluatorOption) *PolicyEvaluator {
+	e := &PolicyEvaluator{store: store, decisionLogger: NoOpDecisionLogger{}}
+	for _, o := range opts {
+		o(e)
+	}
+	return e
+}
+
+// Evaluate applies the policy to the request and returns the decision, including the tier, policy or profile and rule
+// that determined it.  It returns an error if the request is missing attributes that policy evaluation requires, or if
+// the store doesn't have the endpoint's policy yet.  Decisions are passed to the evaluator's DecisionLogger.
+func (e *PolicyEvaluator) Evaluate(req *authz.CheckRequest) (Decision, error) {
+	if err := validateCheckRequest(req); err != nil {
+		return Decision{}, fmt.Errorf("malformed request: %w", err)
+	}
+	var st status.Status
+	matched := NoMatch
+	var err error
+	e.store.Read(func(ps *policystore.PolicyStore) {
+		if ps.Endpoint == nil {
+			err = errors.New("policy store has no endpoint")
+			return
+		}
+		st, matched = checkStoreWithConfig(ps, req, e.config)
+	})
+	if err != nil {
+		return Decision{}, err
+	}
+	d := newDecision(req, &st, matched)
+	e.decisionLogger.LogDecision(d)
+	return d, nil
+}
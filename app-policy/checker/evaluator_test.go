@@ -0,0 +1,202 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"testing"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/app-policy/policystore"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func evaluatorTestRequest(port uint32) *authz.CheckRequest {
+	return &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{Address: &core.Address{
+			Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{Address: "10.0.0.1"}},
+		}},
+		Destination: &authz.AttributeContext_Peer{Address: &core.Address{
+			Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+				Address:       "10.0.0.2",
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: port},
+			}},
+		}},
+	}}
+}
+
+func portRule(action string, port int32) *proto.Rule {
+	return &proto.Rule{Action: action, DstPorts: []*proto.PortRange{{First: port, Last: port}}}
+}
+
+// The decision identifies the tier, policy or profile and rule that determined it.
+func TestPolicyEvaluator(t *testing.T) {
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{
+			{Name: "security", IngressPolicies: []string{"block", "web"}},
+			{Name: "default", IngressPolicies: []string{"everything"}},
+		},
+		ProfileIds: []string{"kns.default"},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "security", Name: "block"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{portRule("Deny", 22), portRule("Pass", 9090)},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "security", Name: "web"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{portRule("Log", 80), portRule("Deny", 8080), portRule("Allow", 80)},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "everything"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{{Action: "Allow"}},
+	}
+	store.ProfileByID[proto.ProfileID{Name: "kns.default"}] = &proto.Profile{
+		InboundRules: []*proto.Rule{portRule("Allow", 9090)},
+	}
+	uut := NewPolicyEvaluator(store)
+
+	testCases := []struct {
+		title     string
+		port      uint32
+		tier      string
+		policy    string
+		profile   string
+		ruleIndex int
+		action    string
+		outcome   string
+	}{
+		{"deny in first policy", 22, "security", "block", "", 0, "deny", "PERMISSION_DENIED"},
+		{"allow after log rule", 80, "security", "web", "", 2, "allow", "OK"},
+		{"deny in second policy", 8080, "security", "web", "", 1, "deny", "PERMISSION_DENIED"},
+		{"pass to next tier", 9090, "default", "everything", "", 0, "allow", "OK"},
+		{"tier default deny", 443, "", "", "", -1, "no-match", "PERMISSION_DENIED"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			d, err := uut.Evaluate(evaluatorTestRequest(tc.port))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.Tier).To(Equal(tc.tier))
+			Expect(d.Policy).To(Equal(tc.policy))
+			Expect(d.Profile).To(Equal(tc.profile))
+			Expect(d.RuleIndex).To(Equal(tc.ruleIndex))
+			Expect(d.Action).To(Equal(tc.action))
+			Expect(d.Outcome).To(Equal(tc.outcome))
+			Expect(d.DstPort).To(Equal(tc.port))
+		})
+	}
+}
+
+func TestPolicyEvaluatorErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	uut := NewPolicyEvaluator(store)
+	_, err := uut.Evaluate(evaluatorTestRequest(80))
+	Expect(err).To(MatchError(ContainSubstring("no endpoint")))
+
+	store.Write(func(s *policystore.PolicyStore) { s.Endpoint = &proto.WorkloadEndpoint{} })
+	_, err = uut.Evaluate(&authz.CheckRequest{})
+	Expect(err).To(MatchError(ContainSubstring("malformed request")))
+
+	d, err := uut.Evaluate(evaluatorTestRequest(80))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(d.Outcome).To(Equal("PERMISSION_DENIED"))
+}
+
+// A store that has been reset evaluates requests in the same way as a new store, both before and after it is
+// repopulated.
+func TestPolicyEvaluatorAfterReset(t *testing.T) {
+	RegisterTestingT(t)
+
+	populate := func(s *policystore.PolicyStore) {
+		s.Endpoint = &proto.WorkloadEndpoint{
+			Tiers: []*proto.TierInfo{{Name: "default", IngressPolicies: []string{"web"}}},
+		}
+		s.PolicyByID[proto.PolicyID{Tier: "default", Name: "web"}] = &proto.Policy{
+			InboundRules: []*proto.Rule{portRule("Allow", 80)},
+		}
+	}
+	reused := policystore.NewPolicyStore()
+	reused.Write(func(s *policystore.PolicyStore) {
+		populate(s)
+		s.Endpoint.Tiers[0].IngressPolicies = append(s.Endpoint.Tiers[0].IngressPolicies, "stale")
+		s.PolicyByID[proto.PolicyID{Tier: "default", Name: "stale"}] = &proto.Policy{
+			InboundRules: []*proto.Rule{portRule("Allow", 443)},
+		}
+	})
+	reused.Reset()
+	fresh := policystore.NewPolicyStore()
+
+	_, err := NewPolicyEvaluator(reused).Evaluate(evaluatorTestRequest(80))
+	Expect(err).To(MatchError(ContainSubstring("no endpoint")))
+
+	reused.Write(populate)
+	fresh.Write(populate)
+	for _, port := range []uint32{80, 443} {
+		got, err := NewPolicyEvaluator(reused).Evaluate(evaluatorTestRequest(port))
+		Expect(err).ToNot(HaveOccurred())
+		want, err := NewPolicyEvaluator(fresh).Evaluate(evaluatorTestRequest(port))
+		Expect(err).ToNot(HaveOccurred())
+		got.Time, want.Time = time.Time{}, time.Time{}
+		Expect(got).To(Equal(want))
+	}
+}
+
+// A rule that refers to an IP set that isn't in the store can only deny when failing closed, and only allow when
+// failing open.
+func TestPolicyEvaluatorMissingIPSet(t *testing.T) {
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{{Name: "default", IngressPolicies: []string{"missing", "fallback"}}},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "missing"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{Action: "Deny", DstPorts: []*proto.PortRange{{First: 22, Last: 22}}, SrcIpSetIds: []string{"unsynced"}},
+			{Action: "Allow", DstPorts: []*proto.PortRange{{First: 80, Last: 80}}, SrcIpSetIds: []string{"unsynced"}},
+		},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "fallback"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{portRule("Allow", 22), portRule("Deny", 80)},
+	}
+
+	testCases := []struct {
+		title     string
+		behavior  MissingDataBehavior
+		port      uint32
+		policy    string
+		ruleIndex int
+		outcome   string
+	}{
+		{"fail closed deny rule", FailClosed, 22, "missing", 0, "PERMISSION_DENIED"},
+		{"fail closed allow rule", FailClosed, 80, "fallback", 1, "PERMISSION_DENIED"},
+		{"fail open deny rule", FailOpen, 22, "fallback", 0, "OK"},
+		{"fail open allow rule", FailOpen, 80, "missing", 1, "OK"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			uut := NewPolicyEvaluator(store, WithEvaluatorMissingDataBehavior(tc.behavior))
+			d, err := uut.Evaluate(evaluatorTestRequest(tc.port))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.Policy).To(Equal(tc.policy))
+			Expect(d.RuleIndex).To(Equal(tc.ruleIndex))
+			Expect(d.Outcome).To(Equal(tc.outcome))
+		})
+	}
+}
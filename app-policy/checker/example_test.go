@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker_test
+
+import (
+	"fmt"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+
+	"github.com/projectcalico/calico/app-policy/checker"
+	"github.com/projectcalico/calico/app-policy/policystore"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func ExamplePolicyEvaluator() {
+	store := policystore.NewPolicyStore()
+	store.Write(func(s *policystore.PolicyStore) {
+		s.Endpoint = &proto.WorkloadEndpoint{
+			Tiers: []*proto.TierInfo{{Name: "default", IngressPolicies: []string{"web"}}},
+		}
+		s.PolicyByID[proto.PolicyID{Tier: "default", Name: "web"}] = &proto.Policy{
+			InboundRules: []*proto.Rule{
+				{Action: "Deny", HttpMatch: &proto.HTTPMatch{Methods: []string{"DELETE"}}},
+				{Action: "Allow"},
+			},
+		}
+	})
+	evaluator := checker.NewPolicyEvaluator(store)
+
+	for _, method := range []string{"GET", "DELETE"} {
+		req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+			Source: &authz.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{Address: "10.0.0.1"},
+			}}},
+			Destination: &authz.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{Address: "10.0.0.2"},
+			}}},
+			Request: &authz.AttributeContext_Request{Http: &authz.AttributeContext_HttpRequest{
+				Method: method,
+				Path:   "/",
+			}},
+		}}
+		d, err := evaluator.Evaluate(req)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf("%s: %s/%s rule %d %s -> %s\n", method, d.Tier, d.Policy, d.RuleIndex, d.Action, d.Outcome)
+	}
+	// Output:
+	// GET: default/web rule 1 allow -> OK
+	// DELETE: default/web rule 0 deny -> PERMISSION_DENIED
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	log "github.com/sirupsen/logrus"
+)
+
+// Identity is the Kubernetes service account that a peer of a request runs as.  The zero value means that the peer's
+// identity is unknown, for example because the request is plain text.
+type Identity struct {
+	Name      string
+	Namespace string
+	// TrustDomain is the SPIFFE trust domain that issued the identity, e.g. "cluster.local", if it is known.
+	TrustDomain string
+}
+
+// IdentityExtractor extracts the identity of one of the peers of a request.  Implementations must be safe for
+// concurrent use.
+type IdentityExtractor interface {
+	// Extract returns the identity of the peer, which is the source or destination of the request.  It returns an
+	// error if the peer presents an identity that can't be understood, in which case the request is denied.
+	Extract(req *authz.CheckRequest, peer *authz.AttributeContext_Peer) (Identity, error)
+}
+
+// SPIFFE_ID_PATTERN is a regular expression to match SPIFFE ID URIs, e.g. spiffe://cluster.local/ns/default/sa/foo
+const SPIFFE_ID_PATTERN = "^spiffe://([^/]+)/ns/([^/]+)/sa/([^/]+)$"
+
+var spiffeIdRegExp *regexp.Regexp
+var spiffeIdRegExpOnce = sync.Once{}
+
+// SPIFFEIdentityExtractor extracts identities from Istio SPIFFE IDs in the peers' principals.  It is the default.
+type SPIFFEIdentityExtractor struct{}
+
+func (SPIFFEIdentityExtractor) Extract(_ *authz.CheckRequest, peer *authz.AttributeContext_Peer) (Identity, error) {
+	return parseSpiffeID(peer.GetPrincipal())
+}
+
+// parseSpiffeID parses an Istio SPIFFE ID and extracts the trust domain, and the service account name and namespace.
+func parseSpiffeID(id string) (identity Identity, err error) {
+	if id == "" {
+		log.Debug("empty spiffe/plain text request.")
+		// Assume this is plain text.
+		return identity, nil
+	}
+	// Init the regexp the first time this is called, and store it in the package namespace.
+	spiffeIdRegExpOnce.Do(func() {
+		spiffeIdRegExp, _ = regexp.Compile(SPIFFE_ID_PATTERN)
+	})
+	match := spiffeIdRegExp.FindStringSubmatch(id)
+	if match == nil {
+		err = fmt.Errorf("expected match %s, got %s", SPIFFE_ID_PATTERN, id)
+	} else {
+		identity.TrustDomain = match[1]
+		identity.Namespace = match[2]
+		identity.Name = match[3]
+	}
+	return
+}
+
+var (
+	identityExtractorsLock sync.RWMutex
+	identityExtractor      IdentityExtractor = SPIFFEIdentityExtractor{}
+	identityExtractors                       = map[string]IdentityExtractor{
+		"spiffe": SPIFFEIdentityExtractor{},
+	}
+)
+
+// RegisterIdentityExtractor makes an IdentityExtractor available under the given (case-insensitive) name, so that it
+// can be selected by IdentityExtractorByName.
+func RegisterIdentityExtractor(name string, e IdentityExtractor) {
+	identityExtractorsLock.Lock()
+	defer identityExtractorsLock.Unlock()
+	identityExtractors[strings.ToLower(name)] = e
+}
+
+// IdentityExtractorByName returns the IdentityExtractor registered under the given (case-insensitive) name.
+func IdentityExtractorByName(name string) (IdentityExtractor, error) {
+	identityExtractorsLock.RLock()
+	defer identityExtractorsLock.RUnlock()
+	e, ok := identityExtractors[strings.ToLower(name)]
+	if !ok {
+		var names []string
+		for n := range identityExtractors {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown identity extractor %q, expected one of %v", name, names)
+	}
+	return e, nil
+}
+
+// SetIdentityExtractor sets the IdentityExtractor that is used to find the identities of the peers of every request.
+func SetIdentityExtractor(e IdentityExtractor) {
+	identityExtractorsLock.Lock()
+	defer identityExtractorsLock.Unlock()
+	identityExtractor = e
+}
+
+// currentIdentityExtractor returns the IdentityExtractor set by SetIdentityExtractor.
+func currentIdentityExtractor() IdentityExtractor {
+	identityExtractorsLock.RLock()
+	defer identityExtractorsLock.RUnlock()
+	return identityExtractor
+}
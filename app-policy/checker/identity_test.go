@@ -0,0 +1,100 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/app-policy/policystore"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+// headerIdentityExtractor reads the source's identity from an "x-identity: <namespace>/<name>" header, as a mesh that
+// doesn't use SPIFFE IDs might pass it.
+type headerIdentityExtractor struct{}
+
+func (headerIdentityExtractor) Extract(req *authz.CheckRequest, peer *authz.AttributeContext_Peer) (Identity, error) {
+	if peer != req.GetAttributes().GetSource() {
+		return Identity{}, nil
+	}
+	h := req.GetAttributes().GetRequest().GetHttp().GetHeaders()["x-identity"]
+	if h == "" {
+		return Identity{}, nil
+	}
+	ns, name, ok := strings.Cut(h, "/")
+	if !ok {
+		return Identity{}, fmt.Errorf("malformed identity header %q", h)
+	}
+	return Identity{Name: name, Namespace: ns}, nil
+}
+
+// A custom extractor determines the service accounts that rules match against.
+func TestCustomIdentityExtractor(t *testing.T) {
+	RegisterTestingT(t)
+	SetIdentityExtractor(headerIdentityExtractor{})
+	defer SetIdentityExtractor(SPIFFEIdentityExtractor{})
+
+	store := policystore.NewPolicyStore()
+	store.ServiceAccountByID[proto.ServiceAccountID{Name: "web", Namespace: "prod"}] = &proto.ServiceAccountUpdate{
+		Id:     &proto.ServiceAccountID{Name: "web", Namespace: "prod"},
+		Labels: map[string]string{"tier": "frontend"},
+	}
+	rule := &proto.Rule{SrcServiceAccountMatch: &proto.ServiceAccountMatch{Names: []string{"web"}}}
+	request := func(header, principal string) *authz.CheckRequest {
+		return &authz.CheckRequest{Attributes: &authz.AttributeContext{
+			Source:      &authz.AttributeContext_Peer{Principal: principal},
+			Destination: &authz.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			Request: &authz.AttributeContext_Request{Http: &authz.AttributeContext_HttpRequest{
+				Headers: map[string]string{"x-identity": header},
+			}},
+		}}
+	}
+
+	reqCache, err := NewRequestCache(store, request("prod/web", ""))
+	Expect(err).To(Succeed())
+	Expect(reqCache.SourcePeer().Name).To(Equal("web"))
+	Expect(reqCache.SourcePeer().Namespace).To(Equal("prod"))
+	Expect(reqCache.SourcePeer().Labels).To(Equal(map[string]string{"tier": "frontend"}))
+	Expect(match(rule, reqCache, "prod")).To(BeTrue())
+
+	// The SPIFFE ID is ignored by the custom extractor.
+	reqCache, err = NewRequestCache(store, request("prod/api", "spiffe://cluster.local/ns/prod/sa/web"))
+	Expect(err).To(Succeed())
+	Expect(match(rule, reqCache, "prod")).To(BeFalse())
+
+	_, err = NewRequestCache(store, request("web", ""))
+	Expect(err).To(HaveOccurred())
+}
+
+func TestIdentityExtractorByName(t *testing.T) {
+	RegisterTestingT(t)
+
+	e, err := IdentityExtractorByName("SPIFFE")
+	Expect(err).To(Succeed())
+	Expect(e).To(Equal(SPIFFEIdentityExtractor{}))
+
+	_, err = IdentityExtractorByName("header")
+	Expect(err).To(HaveOccurred())
+
+	RegisterIdentityExtractor("header", headerIdentityExtractor{})
+	e, err = IdentityExtractorByName("header")
+	Expect(err).To(Succeed())
+	Expect(e).To(Equal(headerIdentityExtractor{}))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector/parser"
+)
+
+// RuleToK8sSelectors translates the source pod and namespace selectors of a rule into Kubernetes label selectors, for
+// tooling that maps Calico rules onto Kubernetes NetworkPolicy peers.  A nil selector means the rule doesn't constrain
+// that field.  It returns an error if the rule uses a selector that a Kubernetes label selector can't represent, such
+// as one with "||", string matching operators or a negated expression other than "!has()", or a negated source
+// selector.
+//
+// The "projectcalico.org/orchestrator == 'k8s'" term that Calico adds to the pod selectors of converted Kubernetes
+// policies is dropped, so that converting a Kubernetes policy to Calico and back is lossless.
+func RuleToK8sSelectors(r *proto.Rule) (podSelector, namespaceSelector *metav1.LabelSelector, err error) {
+	if r.GetOriginalNotSrcSelector() != "" {
+		return nil, nil, fmt.Errorf("negated source selector %q can't be represented", r.GetOriginalNotSrcSelector())
+	}
+	if podSelector, err = calicoSelectorToK8s(r.GetOriginalSrcSelector(), true); err != nil {
+		return nil, nil, fmt.Errorf("source selector: %w", err)
+	}
+	if namespaceSelector, err = calicoSelectorToK8s(r.GetOriginalSrcNamespaceSelector(), false); err != nil {
+		return nil, nil, fmt.Errorf("source namespace selector: %w", err)
+	}
+	return podSelector, namespaceSelector, nil
+}
+
+// calicoSelectorToK8s translates a Calico selector into a Kubernetes label selector.  An empty selector translates to
+// nil and "all()" to an empty label selector, which selects everything.
+func calicoSelectorToK8s(s string, dropOrchestrator bool) (*metav1.LabelSelector, error) {
+	if s == "" {
+		return nil, nil
+	}
+	sel, err := parser.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	// The parser doesn't expose the root of the expression, but visits it first.
+	var root interface{}
+	sel.AcceptVisitor(visitorFunc(func(n interface{}) {
+		if root == nil {
+			root = n
+		}
+	}))
+
+	ls := &metav1.LabelSelector{}
+	if err := addK8sSelectorTerms(ls, root, dropOrchestrator); err != nil {
+		return nil, fmt.Errorf("%q can't be represented: %w", s, err)
+	}
+	// Catch keys and values that are valid in Calico but not in Kubernetes.
+	if _, err := metav1.LabelSelectorAsSelector(ls); err != nil {
+		return nil, fmt.Errorf("%q can't be represented: %w", s, err)
+	}
+	return ls, nil
+}
+
+// addK8sSelectorTerms adds the requirements of a node of a parsed Calico selector to a Kubernetes label selector.
+// Only conjunctions of terms that Kubernetes supports can be added.
+func addK8sSelectorTerms(ls *metav1.LabelSelector, n interface{}, dropOrchestrator bool) error {
+	switch n := n.(type) {
+	case *parser.AllNode:
+	case *parser.AndNode:
+		for _, op := range n.Operands {
+			if err := addK8sSelectorTerms(ls, op, dropOrchestrator); err != nil {
+				return err
+			}
+		}
+	case *parser.LabelEqValueNode:
+		if dropOrchestrator && n.LabelName == apiv3.LabelOrchestrator && n.Value == apiv3.OrchestratorKubernetes {
+			return nil
+		}
+		if v, ok := ls.MatchLabels[n.LabelName]; ok && v != n.Value {
+			// Contradictory, but representable.
+			addK8sSelectorExpression(ls, n.LabelName, metav1.LabelSelectorOpIn, n.Value)
+			return nil
+		}
+		if ls.MatchLabels == nil {
+			ls.MatchLabels = map[string]string{}
+		}
+		ls.MatchLabels[n.LabelName] = n.Value
+	case *parser.LabelNeValueNode:
+		// Like "!=", NotIn also matches when the label is absent.
+		addK8sSelectorExpression(ls, n.LabelName, metav1.LabelSelectorOpNotIn, n.Value)
+	case *parser.LabelInSetNode:
+		addK8sSelectorExpression(ls, n.LabelName, metav1.LabelSelectorOpIn, n.Value.SliceCopy()...)
+	case *parser.LabelNotInSetNode:
+		addK8sSelectorExpression(ls, n.LabelName, metav1.LabelSelectorOpNotIn, n.Value.SliceCopy()...)
+	case *parser.HasNode:
+		addK8sSelectorExpression(ls, n.LabelName, metav1.LabelSelectorOpExists)
+	case *parser.NotNode:
+		has, ok := n.Operand.(*parser.HasNode)
+		if !ok {
+			return fmt.Errorf("negation is only supported for has()")
+		}
+		addK8sSelectorExpression(ls, has.LabelName, metav1.LabelSelectorOpDoesNotExist)
+	case *parser.OrNode:
+		return fmt.Errorf("|| is not supported")
+	default:
+		return fmt.Errorf("unsupported expression %T", n)
+	}
+	return nil
+}
+
+func addK8sSelectorExpression(ls *metav1.LabelSelector, key string, op metav1.LabelSelectorOperator, values ...string) {
+	ls.MatchExpressions = append(ls.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      key,
+		Operator: op,
+		Values:   values,
+	})
+}
+
+// visitorFunc adapts a function to the selector parser's Visitor interface.
+type visitorFunc func(n interface{})
+
+func (f visitorFunc) Visit(n interface{}) {
+	f(n)
+}
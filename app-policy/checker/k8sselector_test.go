@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func TestRuleToK8sSelectors(t *testing.T) {
+	testCases := []struct {
+		title    string
+		rule     *proto.Rule
+		pod      *metav1.LabelSelector
+		ns       *metav1.LabelSelector
+		errorStr string
+	}{
+		{
+			title: "no selectors",
+			rule:  &proto.Rule{},
+		},
+		{
+			title: "equality",
+			rule:  &proto.Rule{OriginalSrcSelector: "app == 'web' && tier == 'frontend'"},
+			pod:   &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web", "tier": "frontend"}},
+		},
+		{
+			title: "set and existence operators",
+			rule:  &proto.Rule{OriginalSrcSelector: "app in {'a', 'b'} && env not in {'dev'} && has(team) && !has(debug) && role != 'db'"},
+			pod: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"a", "b"}},
+				{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"dev"}},
+				{Key: "team", Operator: metav1.LabelSelectorOpExists},
+				{Key: "debug", Operator: metav1.LabelSelectorOpDoesNotExist},
+				{Key: "role", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"db"}},
+			}},
+		},
+		{
+			title: "orchestrator term is dropped from the pod selector",
+			rule: &proto.Rule{
+				OriginalSrcSelector:          "projectcalico.org/orchestrator == 'k8s' && app == 'web'",
+				OriginalSrcNamespaceSelector: "projectcalico.org/orchestrator == 'k8s'",
+			},
+			pod: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			ns:  &metav1.LabelSelector{MatchLabels: map[string]string{"projectcalico.org/orchestrator": "k8s"}},
+		},
+		{
+			title: "all namespaces",
+			rule:  &proto.Rule{OriginalSrcNamespaceSelector: "all()"},
+			ns:    &metav1.LabelSelector{},
+		},
+		{
+			title:    "or",
+			rule:     &proto.Rule{OriginalSrcSelector: "app == 'a' || app == 'b'"},
+			errorStr: "|| is not supported",
+		},
+		{
+			title:    "string match",
+			rule:     &proto.Rule{OriginalSrcNamespaceSelector: "name starts with 'kube-'"},
+			errorStr: "source namespace selector",
+		},
+		{
+			title:    "negated expression",
+			rule:     &proto.Rule{OriginalSrcSelector: "!(app == 'web')"},
+			errorStr: "negation is only supported for has()",
+		},
+		{
+			title:    "negated source selector",
+			rule:     &proto.Rule{OriginalNotSrcSelector: "app == 'web'"},
+			errorStr: "negated source selector",
+		},
+		{
+			title:    "invalid Kubernetes label value",
+			rule:     &proto.Rule{OriginalSrcSelector: "app == 'not a valid value'"},
+			errorStr: "can't be represented",
+		},
+		{
+			title:    "unparsable",
+			rule:     &proto.Rule{OriginalSrcSelector: "app =="},
+			errorStr: "source selector",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			pod, ns, err := RuleToK8sSelectors(tc.rule)
+			if tc.errorStr != "" {
+				Expect(err).To(MatchError(ContainSubstring(tc.errorStr)))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod).To(Equal(tc.pod))
+			Expect(ns).To(Equal(tc.ns))
+		})
+	}
+}
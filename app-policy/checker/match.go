@@ -16,23 +16,84 @@ package checker
 
 import (
 	"net"
+	"net/url"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/projectcalico/calico/app-policy/policystore"
 	"github.com/projectcalico/calico/felix/proto"
-	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 
 	"fmt"
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	pb "github.com/gogo/protobuf/proto"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/structpb"
+	v1 "k8s.io/api/core/v1"
 )
 
-var (
-	// Envoy supports TCP only. Add a k:v into this map if more protocol is supported in the future.
-	protocolMapL4 = map[int32]string{6: "tcp"}
+const (
+	// The filter metadata namespace and key under which Envoy passes the application protocol it detected on the
+	// connection, for example "mysql" for a server-first protocol.
+	appProtocolMetadataNamespace = "envoy.filters.network.protocol_detection"
+	appProtocolMetadataKey       = "protocol"
+
+	// The filter metadata namespace and key under which Envoy's JWT authentication filter passes the verified JWT
+	// payload.  The filter must be configured with "payload_in_metadata: jwt_payload".
+	jwtMetadataNamespace  = "envoy.filters.http.jwt_authn"
+	jwtPayloadMetadataKey = "jwt_payload"
+
+	// The filter metadata namespace and key under which Envoy passes the name of the route that the request matched.
+	routeMetadataNamespace = "envoy.route"
+	routeNameMetadataKey   = "name"
+
+	// The filter metadata namespace and key under which Envoy passes whether a gRPC call is streaming.  Envoy can't
+	// tell from the request headers alone, so the routes for streaming methods must set this, for example with the
+	// "envoy.filters.http.set_metadata" filter.
+	grpcMetadataNamespace    = "io.projectcalico.grpc"
+	grpcStreamingMetadataKey = "streaming"
+
+	// The filter metadata namespace under which Envoy passes request-scoped labels, for example a feature-flag cohort,
+	// as string fields.
+	requestLabelsMetadataNamespace = "io.projectcalico.request_labels"
+
+	// The filter metadata namespace and keys under which Envoy passes the JA3 and JA4 fingerprints of the client's TLS
+	// hello, for example by copying them from the TLS inspector's connection info with a set_metadata filter.
+	tlsFingerprintMetadataNamespace = "io.projectcalico.tls_fingerprint"
+	tlsFingerprintJA3MetadataKey    = "ja3"
+	tlsFingerprintJA4MetadataKey    = "ja4"
+
+	// The filter metadata namespace and keys under which Envoy passes the outcome of a completed request, as numbers,
+	// when checks are made in the response phase.
+	responseMetadataNamespace     = "io.projectcalico.response"
+	responseCodeMetadataKey       = "response_code"
+	responseDurationMsMetadataKey = "duration_ms"
+
+	// The filter metadata namespace and keys under which the ICMP type and code of an ICMP flow are passed, as numbers,
+	// by integrations that check flows other than Envoy's TCP and UDP connections.  The presence of the type marks the
+	// flow as ICMP, or ICMPv6 if its addresses are IPv6.
+	icmpMetadataNamespace = "io.projectcalico.icmp"
+	icmpTypeMetadataKey   = "type"
+	icmpCodeMetadataKey   = "code"
+
+	// The filter metadata namespace and key under which Envoy passes, as a bool, whether the request is on a connection
+	// for which it reused an earlier authorization decision.
+	connectionMetadataNamespace = "io.projectcalico.connection"
+	connectionReusedMetadataKey = "reused"
+)
+
+// gRPC call types, as matched by a rule's grpc_call_types.
+const (
+	grpcCallTypeUnary     = "Unary"
+	grpcCallTypeStreaming = "Streaming"
 )
 
+// endpointStateActive is the state that Felix reports for a workload endpoint that is ready to receive traffic.
+const endpointStateActive = "active"
+
 type namespaceMatch struct {
 	Names    []string
 	Selector string
@@ -59,10 +120,283 @@ func match(rule *proto.Rule, req *requestCache, policyNamespace string) bool {
 		"Req.Destination": req.Request.GetAttributes().GetDestination(),
 	}).Debug("Checking rule on request")
 	attr := req.Request.GetAttributes()
-	return matchSource(rule, req, policyNamespace) &&
-		matchDestination(rule, req, policyNamespace) &&
-		matchRequest(rule, attr.GetRequest()) &&
-		matchL4Protocol(rule, attr.GetDestination())
+	if req.config.denyHairpin && !rule.GetAllowHairpin() && strings.EqualFold(rule.GetAction(), "allow") &&
+		req.IsHairpin() {
+		log.Debug("Hairpin request doesn't match Allow rule without allow_hairpin")
+		return false
+	}
+	if missing := missingIPSets(rule, req); len(missing) > 0 {
+		// The rest of the rule must still match for the missing data to matter.
+		if !match(withoutIPSets(rule, missing), req, policyNamespace) {
+			return false
+		}
+		return resolveMissingData(req.config.missingDataBehavior, rule, missing)
+	}
+	if !matchSource(rule, req, policyNamespace) ||
+		!matchDestination(rule, req, policyNamespace) ||
+		(rule.GetTlsTerminated() && !tlsTerminated(attr)) ||
+		!matchTLSFingerprints(rule.GetTlsFingerprints(), rule.GetNotTlsFingerprints(), attr.GetMetadataContext()) ||
+		!matchGRPC(rule.GetGrpcMatch(), attr.GetRequest().GetHttp()) {
+		return false
+	}
+	// The remaining clauses depend on attributes that Envoy only supplies for some requests, or on reverse-DNS names
+	// that may not be resolved yet, so they may be unknown.
+	result := combineClauses(
+		httpClause(rule, attr),
+		l4ProtocolClause(rule, attr),
+		appProtocolClause(rule.GetAppProtocols(), attr),
+		jwtAudiencesClause(rule.GetJwtAudiences(), attr),
+		routeNameClause(rule.GetRouteNames(), attr),
+		grpcCallTypesClause(rule.GetGrpcCallTypes(), attr),
+		requestLabelsClause(rule.GetRequestLabelSelector(), attr),
+		responseClause(rule.GetHttpMatch(), attr, req.config.responsePhase),
+		clauseResultOf(matchICMP(rule, attr)),
+		connectionReusedClause(rule.GetConnectionReused(), attr),
+		reverseDNSNamesClause(rule.GetDstReverseDnsNames(), req),
+	)
+	if result == clauseUnknown {
+		return resolveUnknownClause(req.config.unknownClauseBehavior, rule)
+	}
+	return result == clauseMatch
+}
+
+// clauseResult is the result of evaluating one of a rule's clauses against a request.
+type clauseResult int
+
+const (
+	clauseNoMatch clauseResult = iota
+	clauseMatch
+	// clauseUnknown means that the clause couldn't be evaluated because the request lacks the data it needs.
+	clauseUnknown
+)
+
+// clauseResultOf converts the result of a clause that could be evaluated into a clauseResult.
+func clauseResultOf(matched bool) clauseResult {
+	if matched {
+		return clauseMatch
+	}
+	return clauseNoMatch
+}
+
+// combineClauses ANDs the results of a rule's clauses.  Any clause that doesn't match means that the rule doesn't
+// match, whatever the other clauses' results; otherwise, any unknown clause makes the combined result unknown.
+func combineClauses(results ...clauseResult) clauseResult {
+	combined := clauseMatch
+	for _, r := range results {
+		switch r {
+		case clauseNoMatch:
+			return clauseNoMatch
+		case clauseUnknown:
+			combined = clauseUnknown
+		}
+	}
+	return combined
+}
+
+// resolveUnknownClause decides whether a rule whose clauses are otherwise all matched, but which has at least one
+// clause that couldn't be evaluated, matches the request.
+func resolveUnknownClause(behavior UnknownClauseBehavior, rule *proto.Rule) bool {
+	var matched bool
+	switch behavior {
+	case UnknownClauseMatch:
+		matched = true
+	case UnknownClauseFailClosed:
+		matched = actionFromString(rule.GetAction()) == DENY
+	default:
+		matched = false
+	}
+	log.WithFields(log.Fields{
+		"behavior": behavior,
+		"action":   rule.GetAction(),
+		"matched":  matched,
+	}).Debug("Rule has a clause that can't be evaluated")
+	return matched
+}
+
+// missingIPSets returns the IDs of the IP sets that the rule refers to but that aren't in the store.
+func missingIPSets(rule *proto.Rule, req *requestCache) []string {
+	var missing []string
+	for _, ids := range policystore.RuleIPSetIDs(rule) {
+		for _, id := range *ids {
+			if _, ok := req.store.IPSetByID[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+	}
+	return missing
+}
+
+// withoutIPSets returns a copy of the rule that doesn't refer to the given IP sets.
+func withoutIPSets(rule *proto.Rule, ipSets []string) *proto.Rule {
+	r := pb.Clone(rule).(*proto.Rule)
+	for _, ids := range policystore.RuleIPSetIDs(r) {
+		*ids = slices.DeleteFunc(*ids, func(id string) bool { return slices.Contains(ipSets, id) })
+	}
+	return r
+}
+
+// loggedMissingIPSets holds the IDs of the missing IP sets that have already been logged at warning level, so that
+// each is only warned about once rather than for every request that reaches a rule referring to it.
+var loggedMissingIPSets sync.Map
+
+// resolveMissingData decides whether a rule that refers to data missing from the store matches the request.  Only
+// a Deny rule matches when failing closed, and only an Allow rule when failing open.
+func resolveMissingData(behavior MissingDataBehavior, rule *proto.Rule, missingIPSets []string) bool {
+	var matched bool
+	switch behavior {
+	case FailOpen:
+		matched = actionFromString(rule.GetAction()) == ALLOW
+	default:
+		matched = actionFromString(rule.GetAction()) == DENY
+	}
+	logCtx := log.WithFields(log.Fields{
+		"behavior": behavior,
+		"action":   rule.GetAction(),
+		"ipSets":   missingIPSets,
+		"matched":  matched,
+	})
+	newlyMissing := false
+	for _, id := range missingIPSets {
+		if _, logged := loggedMissingIPSets.LoadOrStore(id, struct{}{}); !logged {
+			newlyMissing = true
+		}
+	}
+	if newlyMissing {
+		logCtx.Warn("Rule refers to IP sets that aren't in the store")
+	} else {
+		logCtx.Debug("Rule refers to IP sets that aren't in the store")
+	}
+	return matched
+}
+
+// httpClause evaluates the rule's HTTP match.  It is unknown for a request without HTTP attributes.
+func httpClause(rule *proto.Rule, attr *authz.AttributeContext) clauseResult {
+	if rule.GetHttpMatch() == nil {
+		return clauseMatch
+	}
+	if attr.GetRequest().GetHttp() == nil {
+		return clauseUnknown
+	}
+	return clauseResultOf(matchRequest(rule, attr.GetRequest()))
+}
+
+// l4ProtocolClause evaluates the rule's protocol and not-protocol.  It is unknown for a request without a destination
+// peer.
+func l4ProtocolClause(rule *proto.Rule, attr *authz.AttributeContext) clauseResult {
+	if attr.GetDestination() == nil {
+		if rule.GetProtocol() == nil && rule.GetNotProtocol() == nil {
+			return clauseMatch
+		}
+		return clauseUnknown
+	}
+	if _, _, ok := icmpTypeAndCode(attr); ok {
+		return clauseResultOf(matchProtocolName(rule, icmpProtocol(attr.GetDestination())))
+	}
+	return clauseResultOf(matchL4Protocol(rule, attr.GetDestination()))
+}
+
+// appProtocolClause evaluates the rule's application protocols.  It is unknown if Envoy didn't detect the protocol.
+func appProtocolClause(protocols []string, attr *authz.AttributeContext) clauseResult {
+	if len(protocols) == 0 {
+		return clauseMatch
+	}
+	md := attr.GetMetadataContext()
+	if md.GetFilterMetadata()[appProtocolMetadataNamespace].GetFields()[appProtocolMetadataKey].GetStringValue() == "" {
+		return clauseUnknown
+	}
+	return clauseResultOf(matchAppProtocol(protocols, md))
+}
+
+// responseClause evaluates the response codes and duration bounds of the rule's HTTP match.  It is unknown unless the
+// check is made in the response phase and Envoy passed the outcome of the request.
+func responseClause(rule *proto.HTTPMatch, attr *authz.AttributeContext, responsePhase bool) clauseResult {
+	if len(rule.GetResponseCodes()) == 0 && rule.GetMinDurationMs() == 0 && rule.GetMaxDurationMs() == 0 {
+		return clauseMatch
+	}
+	fields := attr.GetMetadataContext().GetFilterMetadata()[responseMetadataNamespace].GetFields()
+	code, codeOK := fields[responseCodeMetadataKey].GetKind().(*structpb.Value_NumberValue)
+	duration, durationOK := fields[responseDurationMsMetadataKey].GetKind().(*structpb.Value_NumberValue)
+	if !responsePhase || !codeOK || !durationOK {
+		log.WithField("responsePhase", responsePhase).Debug("Response outcome unknown")
+		return clauseUnknown
+	}
+	return clauseResultOf(matchResponse(rule, uint32(code.NumberValue), uint64(duration.NumberValue)))
+}
+
+// matchResponse returns true if the response code is one of the rule's response codes, if it has any, and the
+// duration is within the rule's bounds, if it has them.
+func matchResponse(rule *proto.HTTPMatch, code uint32, durationMs uint64) bool {
+	log.WithFields(log.Fields{
+		"codes":         rule.GetResponseCodes(),
+		"minDurationMs": rule.GetMinDurationMs(),
+		"maxDurationMs": rule.GetMaxDurationMs(),
+		"code":          code,
+		"durationMs":    durationMs,
+	}).Debug("Matching response")
+	return (len(rule.GetResponseCodes()) == 0 || slices.Contains(rule.GetResponseCodes(), code)) &&
+		durationMs >= rule.GetMinDurationMs() &&
+		(rule.GetMaxDurationMs() == 0 || durationMs <= rule.GetMaxDurationMs())
+}
+
+// jwtAudiencesClause evaluates the rule's JWT audiences.  It is unknown for a request without a verified JWT payload.
+func jwtAudiencesClause(audiences []string, attr *authz.AttributeContext) clauseResult {
+	if len(audiences) == 0 {
+		return clauseMatch
+	}
+	md := attr.GetMetadataContext()
+	if md.GetFilterMetadata()[jwtMetadataNamespace].GetFields()[jwtPayloadMetadataKey].GetStructValue() == nil {
+		return clauseUnknown
+	}
+	return clauseResultOf(matchJWTAudiences(audiences, md))
+}
+
+// routeNameClause evaluates the rule's route names.  It is unknown if Envoy didn't pass the name of the route.
+func routeNameClause(names []string, attr *authz.AttributeContext) clauseResult {
+	if len(names) == 0 {
+		return clauseMatch
+	}
+	md := attr.GetMetadataContext()
+	if md.GetFilterMetadata()[routeMetadataNamespace].GetFields()[routeNameMetadataKey].GetStringValue() == "" {
+		return clauseUnknown
+	}
+	return clauseResultOf(matchRouteName(names, md))
+}
+
+// connectionReusedClause evaluates the rule's connection_reused flag.  It is unknown if Envoy didn't say whether the
+// connection was reused.
+func connectionReusedClause(reused bool, attr *authz.AttributeContext) clauseResult {
+	if !reused {
+		return clauseMatch
+	}
+	fields := attr.GetMetadataContext().GetFilterMetadata()[connectionMetadataNamespace].GetFields()
+	v, ok := fields[connectionReusedMetadataKey].GetKind().(*structpb.Value_BoolValue)
+	if !ok {
+		return clauseUnknown
+	}
+	log.WithField("reused", v.BoolValue).Debug("Matching connection reuse")
+	return clauseResultOf(v.BoolValue)
+}
+
+// grpcCallTypesClause evaluates the rule's gRPC call types.  It is unknown for a request without HTTP attributes.
+func grpcCallTypesClause(types []string, attr *authz.AttributeContext) clauseResult {
+	if len(types) == 0 {
+		return clauseMatch
+	}
+	if attr.GetRequest().GetHttp() == nil {
+		return clauseUnknown
+	}
+	return clauseResultOf(matchGRPCCallTypes(types, attr))
+}
+
+// MatchAll evaluates each of the rules against the request, returning whether each one matched.  Information about the
+// request that has to be looked up in the store, such as the peers' namespaces, IP set membership and the destination
+// endpoint, is resolved once and shared by all the rules, so this is cheaper than building a request cache per rule.
+func MatchAll(rules []*proto.Rule, req *requestCache, policyNamespace string) []bool {
+	matches := make([]bool, len(rules))
+	for i, r := range rules {
+		matches[i] = match(r, req, policyNamespace)
+	}
+	return matches
 }
 
 func matchSource(r *proto.Rule, req *requestCache, policyNamespace string) bool {
@@ -75,9 +409,19 @@ func matchSource(r *proto.Rule, req *requestCache, policyNamespace string) bool
 	addr := req.Request.GetAttributes().GetSource().GetAddress()
 	return matchServiceAccounts(r.GetSrcServiceAccountMatch(), req.SourcePeer()) &&
 		matchNamespace(nsMatch, req.SourceNamespace()) &&
+		matchNamespaceLabels(r.GetSrcNamespaceLabels(), req.SourceNamespace()) &&
 		matchSrcIPSets(r, req) &&
-		matchPort("src", r.GetSrcPorts(), r.GetSrcNamedPortIpSetIds(), req, addr) &&
-		matchNet("src", r.GetSrcNet(), addr)
+		matchSrcIPPortSets(r, req) &&
+		matchSrcPort(r, req) &&
+		matchNet("src", r.GetSrcNet(), req.SourceClientAddress()) &&
+		(!r.GetSrcIsLocalNode() || req.SourceIsLocalNode()) &&
+		matchIPPools("src", r.GetSrcIpPools(), req.store.IPPoolByID, addr) &&
+		matchNet("direct remote", r.GetDirectRemoteNet(), addr) &&
+		matchPrincipal("src", r.GetSrcPrincipalPrefixes(), r.GetSrcPrincipalSuffixes(),
+			req.Request.GetAttributes().GetSource().GetPrincipal()) &&
+		matchNodeLabel(v1.LabelTopologyZone, r.GetSrcZones(), req) &&
+		matchNodeLabel(v1.LabelTopologyRegion, r.GetSrcRegions(), req) &&
+		matchSrcASNumbers(r.GetSrcAsNumbers(), req)
 }
 
 func computeNamespaceMatch(
@@ -114,8 +458,17 @@ func matchDestination(r *proto.Rule, req *requestCache, policyNamespace string)
 	return matchServiceAccounts(r.GetDstServiceAccountMatch(), req.DestinationPeer()) &&
 		matchNamespace(nsMatch, req.DestinationNamespace()) &&
 		matchDstIPSets(r, req) &&
-		matchPort("dst", r.GetDstPorts(), r.GetDstNamedPortIpSetIds(), req, addr) &&
-		matchNet("dst", r.GetDstNet(), addr)
+		matchDstIPPortSets(r, req) &&
+		matchDstPort(r, req) &&
+		matchNet("dst", r.GetDstNet(), addr) &&
+		matchAnnotations(r.GetDstAnnotations(), req.DestinationEndpoint()) &&
+		matchServicePorts(r.GetDstServicePorts(), req) &&
+		matchEncapsulations(r.GetDstEncapsulations(), req) &&
+		(!r.GetDstInLocalIpamBlock() || req.DestinationInLocalIPAMBlock()) &&
+		(!r.GetDstReady() || endpointReady(req.DestinationEndpoint())) &&
+		(!r.GetDstListening() || endpointListening(req.DestinationEndpoint(), addr.GetSocketAddress())) &&
+		matchPrincipal("dst", r.GetDstPrincipalPrefixes(), r.GetDstPrincipalSuffixes(),
+			req.Request.GetAttributes().GetDestination().GetPrincipal())
 }
 
 func matchRequest(rule *proto.Rule, req *authz.AttributeContext_Request) bool {
@@ -139,14 +492,37 @@ func matchServiceAccounts(saMatch *proto.ServiceAccountMatch, p peer) bool {
 	// In case of plain text so Dikastes only matches if the IP addresses are part of
 	// IP sets of a policy rule. So empty service account is considered a match in such a case.
 	return p.Name == "" ||
-		(matchName(saMatch.GetNames(), p.Name) &&
-			matchLabels(saMatch.GetSelector(), p.Labels))
+		(matchName(saMatch.GetNames(), p.Name, saMatch.GetGlobNames()) &&
+			matchLabels(saMatch.GetSelector(), p.Labels) &&
+			matchTrustDomain(saMatch.GetTrustDomains(), p.TrustDomain))
+}
+
+// matchTrustDomain returns true if the trust domain is one of the given trust domains, which are compared
+// case-insensitively.  An empty list matches any peer, even one whose trust domain is unknown because its service
+// account was found from its endpoint rather than its identity.
+func matchTrustDomain(trustDomains []string, trustDomain string) bool {
+	log.WithFields(log.Fields{
+		"trustDomains": trustDomains,
+		"trustDomain":  trustDomain,
+	}).Debug("Matching trust domain")
+	if len(trustDomains) == 0 {
+		return true
+	}
+	for _, td := range trustDomains {
+		if trustDomain != "" && strings.EqualFold(td, trustDomain) {
+			return true
+		}
+	}
+	return false
 }
 
-func matchName(names []string, name string) bool {
+// matchName returns true if the name is one of the given names, or if there are no names.  If glob is set, the names
+// may contain the wildcards "*" and "?"; names without wildcards are still compared exactly.
+func matchName(names []string, name string, glob bool) bool {
 	log.WithFields(log.Fields{
 		"names": names,
 		"name":  name,
+		"glob":  glob,
 	}).Debug("Matching name")
 	if len(names) == 0 {
 		log.Debug("No names on rule.")
@@ -156,24 +532,80 @@ func matchName(names []string, name string) bool {
 		if n == name {
 			return true
 		}
+		if glob && strings.ContainsAny(n, "*?") && matchGlob(n, name) {
+			return true
+		}
 	}
 	return false
 }
 
+// matchGlob returns true if the whole of s matches the glob pattern.  An invalid pattern matches nothing.
+func matchGlob(pattern, s string) bool {
+	re, err := globCache.get(pattern)
+	if err != nil {
+		log.WithError(err).WithField("glob", pattern).Warn("Invalid glob")
+		return false
+	}
+	return re.MatchString(s)
+}
+
 func matchLabels(selectorStr string, labels map[string]string) bool {
 	log.WithFields(log.Fields{
 		"selector": selectorStr,
 		"labels":   labels,
 	}).Debug("Matching labels.")
-	sel, err := selector.Parse(selectorStr)
+	sel, err := selectorCache.get(selectorStr)
 	if err != nil {
-		log.Warnf("Could not parse label selector %v, %v", selectorStr, err)
-		return false
+		selectorCompileFailures.Inc()
+		behavior := currentSelectorFailureBehavior()
+		log.Warnf("Could not parse label selector %v, %v; treating the clause as %v", selectorStr, err, behavior)
+		return behavior == SelectorFailOpen
 	}
 	log.Debugf("Parsed selector.")
 	return sel.Evaluate(labels)
 }
 
+// matchNamespaceLabels returns true if the namespace carries all the given labels with the given values.  An empty set
+// of labels matches any namespace, even an unknown one; otherwise, a peer whose namespace is unknown, for example
+// because the request is plain text, doesn't match.
+func matchNamespaceLabels(labels map[string]string, ns namespace) bool {
+	log.WithFields(log.Fields{
+		"labels":    labels,
+		"namespace": ns.Name,
+	}).Debug("Matching namespace labels.")
+	if len(labels) == 0 {
+		return true
+	}
+	for k, v := range labels {
+		if actual, ok := ns.Labels[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
+
+// matchAnnotations returns true if the endpoint carries all the given annotations with the given values. An empty set
+// of annotations matches any endpoint, even an unknown one.
+func matchAnnotations(annotations map[string]string, ep *proto.WorkloadEndpoint) bool {
+	log.WithFields(log.Fields{
+		"annotations": annotations,
+		"endpoint":    ep.GetName(),
+	}).Debug("Matching annotations.")
+	if len(annotations) == 0 {
+		return true
+	}
+	if ep == nil {
+		log.Debug("No endpoint to match annotations against.")
+		return false
+	}
+	for k, v := range annotations {
+		if actual, ok := ep.GetAnnotations()[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
+
 func matchNamespace(nsMatch *namespaceMatch, ns namespace) bool {
 	log.WithFields(log.Fields{
 		"namespace": ns.Name,
@@ -185,7 +617,7 @@ func matchNamespace(nsMatch *namespaceMatch, ns namespace) bool {
 	// In case of plain text so Dikastes only matches if the IP addresses are part of
 	// IP sets of a policy rule. So empty namespace is considered a match in such a case.
 	return ns.Name == "" ||
-		(matchName(nsMatch.Names, ns.Name) &&
+		(matchName(nsMatch.Names, ns.Name, false) &&
 			matchLabels(nsMatch.Selector, ns.Labels))
 }
 
@@ -197,13 +629,44 @@ func matchHTTP(rule *proto.HTTPMatch, req *authz.AttributeContext_HttpRequest) b
 		log.Debug("nil HTTPRule.  Return true")
 		return true
 	}
-	return matchHTTPMethods(rule.GetMethods(), req.GetMethod()) && matchHTTPPaths(rule.GetPaths(), req.GetPath())
+	return matchHTTPMethods(rule.GetMethods(), req.GetMethod(), rule.GetCaseInsensitive()) &&
+		matchHTTPPaths(rule.GetPaths(), req.GetPath(), rule.GetIgnoreTrailingSlash()) &&
+		matchHTTPContentTypes(rule.GetContentTypes(), req.GetHeaders()["content-type"]) &&
+		matchHTTPQueryParams(rule.GetQueryParams(), req.GetPath()) &&
+		matchHTTPHeaders(rule.GetHeaders(), req.GetHeaders()) &&
+		matchHTTPUpgrades(rule.GetUpgrades(), req) &&
+		(len(rule.GetNotMethods()) == 0 ||
+			!matchHTTPMethods(rule.GetNotMethods(), req.GetMethod(), rule.GetCaseInsensitive())) &&
+		(len(rule.GetNotPaths()) == 0 ||
+			!matchHTTPPaths(rule.GetNotPaths(), req.GetPath(), rule.GetIgnoreTrailingSlash())) &&
+		matchHTTPBodySize(rule.GetMaxBodyBytes(), req.GetSize())
+}
+
+// matchHTTPBodySize returns true if the request body is no larger than maxBytes, or maxBytes is zero.  Envoy reports
+// a size of -1 if it doesn't know the size, for example for a chunked request whose body it hasn't buffered; such
+// requests match, since the limit can't be checked.
+func matchHTTPBodySize(maxBytes uint64, size int64) bool {
+	if maxBytes == 0 {
+		return true
+	}
+	if size < 0 {
+		log.WithField("maxBytes", maxBytes).Debug("HTTP request size unknown, matching body size limit.")
+		return true
+	}
+	log.WithFields(log.Fields{
+		"maxBytes": maxBytes,
+		"size":     size,
+	}).Debug("Matching HTTP body size")
+	return uint64(size) <= maxBytes
 }
 
-func matchHTTPMethods(methods []string, reqMethod string) bool {
+// matchHTTPMethods returns true if the request method is one of the given methods, or the methods include the "*"
+// wildcard.  Methods are case-sensitive, as HTTP specifies, unless caseInsensitive is set.
+func matchHTTPMethods(methods []string, reqMethod string, caseInsensitive bool) bool {
 	log.WithFields(log.Fields{
-		"methods":   methods,
-		"reqMethod": reqMethod,
+		"methods":         methods,
+		"reqMethod":       reqMethod,
+		"caseInsensitive": caseInsensitive,
 	}).Debug("Matching HTTP Methods")
 	if len(methods) == 0 {
 		log.Debug("Rule has 0 HTTP Methods, matched.")
@@ -214,7 +677,7 @@ func matchHTTPMethods(methods []string, reqMethod string) bool {
 			log.Debug("Rule matches all methods with wildcard *")
 			return true
 		}
-		if method == reqMethod {
+		if method == reqMethod || (caseInsensitive && strings.EqualFold(method, reqMethod)) {
 			log.Debug("HTTP Method matched.")
 			return true
 		}
@@ -223,10 +686,13 @@ func matchHTTPMethods(methods []string, reqMethod string) bool {
 	return false
 }
 
-func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath string) bool {
+// matchHTTPPaths returns true if the request path matches one of the exact or prefix path matches. If
+// ignoreTrailingSlash is set, "/foo" and "/foo/" are equivalent for exact matches.
+func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath string, ignoreTrailingSlash bool) bool {
 	log.WithFields(log.Fields{
-		"paths":   paths,
-		"reqPath": reqPath,
+		"paths":               paths,
+		"reqPath":             reqPath,
+		"ignoreTrailingSlash": ignoreTrailingSlash,
 	}).Debug("Matching HTTP Paths")
 	if len(paths) == 0 {
 		log.Debug("Rule has 0 HTTP Paths, matched.")
@@ -246,7 +712,13 @@ func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath string) bool {
 	for _, pathMatch := range paths {
 		switch pathMatch.GetPathMatch().(type) {
 		case *proto.HTTPMatch_PathMatch_Exact:
-			if reqPath == pathMatch.GetExact() {
+			exact := pathMatch.GetExact()
+			if ignoreTrailingSlash {
+				if trimTrailingSlash(reqPath) == trimTrailingSlash(exact) {
+					log.Debug("HTTP Path exact matched, ignoring trailing slash.")
+					return true
+				}
+			} else if reqPath == exact {
 				log.Debug("HTTP Path exact matched.")
 				return true
 			}
@@ -255,12 +727,181 @@ func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath string) bool {
 				log.Debugf("HTTP Path prefix %s matched.", pathMatch.GetPrefix())
 				return true
 			}
+		case *proto.HTTPMatch_PathMatch_Regex:
+			re, err := regexCache.get(pathMatch.GetRegex())
+			if err != nil {
+				s := fmt.Sprintf("Invalid HTTP Path regex %q: %v", pathMatch.GetRegex(), err)
+				log.Error(s)
+				// Let the caller recover from the panic.
+				panic(&InvalidDataFromDataPlane{s})
+			}
+			if re.MatchString(reqPath) {
+				log.Debugf("HTTP Path regex %s matched.", pathMatch.GetRegex())
+				return true
+			}
 		}
 	}
 	log.Debug("HTTP Path not matched.")
 	return false
 }
 
+// trimTrailingSlash removes a single trailing slash from the path, unless the path is just "/".
+func trimTrailingSlash(path string) string {
+	if len(path) > 1 {
+		return strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+// matchHTTPQueryParams returns true if all the query parameter matches match the query string of the request path.
+// Parameters may be repeated, in which case an exact match only needs to match one of the values.  Names and values
+// are URL-decoded before matching.
+func matchHTTPQueryParams(matches []*proto.HTTPMatch_QueryParamMatch, reqPath string) bool {
+	log.WithFields(log.Fields{
+		"queryParams": matches,
+		"reqPath":     reqPath,
+	}).Debug("Matching HTTP query parameters")
+	if len(matches) == 0 {
+		return true
+	}
+	query := ""
+	if i := strings.Index(reqPath, "?"); i >= 0 {
+		query = strings.SplitN(reqPath[i+1:], "#", 2)[0]
+	}
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		// ParseQuery still returns the parameters that it could parse, so carry on with those.
+		log.WithError(err).WithField("query", query).Debug("Failed to parse some HTTP query parameters")
+	}
+	for _, m := range matches {
+		values, ok := params[m.GetName()]
+		if !ok {
+			log.WithField("name", m.GetName()).Debug("HTTP query parameter not present.")
+			return false
+		}
+		if exact, isExact := m.GetValueMatch().(*proto.HTTPMatch_QueryParamMatch_Exact); isExact &&
+			!slices.Contains(values, exact.Exact) {
+			log.WithField("name", m.GetName()).Debug("HTTP query parameter value not matched.")
+			return false
+		}
+	}
+	return true
+}
+
+// matchHTTPHeaders returns true if all the header matches match the request's headers.  Header names are compared
+// case-insensitively.  Envoy joins the values of a repeated header with commas, so a value matcher matches if it
+// matches either the joined value or any one of the values.
+func matchHTTPHeaders(matches []*proto.HTTPMatch_HeaderMatch, reqHeaders map[string]string) bool {
+	log.WithFields(log.Fields{
+		"headers":    matches,
+		"reqHeaders": reqHeaders,
+	}).Debug("Matching HTTP headers")
+	for _, m := range matches {
+		value, ok := reqHeaders[strings.ToLower(m.GetName())]
+		if !ok {
+			for name, v := range reqHeaders {
+				if strings.EqualFold(name, m.GetName()) {
+					value, ok = v, true
+					break
+				}
+			}
+		}
+		if !ok {
+			log.WithField("name", m.GetName()).Debug("HTTP header not present.")
+			return false
+		}
+		if !matchHTTPHeaderValue(m, value) {
+			log.WithField("name", m.GetName()).Debug("HTTP header value not matched.")
+			return false
+		}
+	}
+	return true
+}
+
+// matchHTTPUpgrades returns true if the request is upgrading the connection to one of the given protocols.  HTTP/1.1
+// requests list the protocols they accept in the Upgrade header; HTTP/2 requests bootstrap a websocket with an extended
+// CONNECT, which names the protocol in the :protocol pseudo-header.  An empty list matches any request.
+func matchHTTPUpgrades(upgrades []string, req *authz.AttributeContext_HttpRequest) bool {
+	if len(upgrades) == 0 {
+		return true
+	}
+	reqUpgrades := strings.Split(req.GetHeaders()["upgrade"], ",")
+	if p := req.GetHeaders()[":protocol"]; p != "" && req.GetMethod() == "CONNECT" {
+		reqUpgrades = append(reqUpgrades, p)
+	}
+	log.WithFields(log.Fields{
+		"upgrades":    upgrades,
+		"reqUpgrades": reqUpgrades,
+	}).Debug("Matching HTTP upgrades")
+	for _, u := range reqUpgrades {
+		u = strings.TrimSpace(u)
+		if u == "" {
+			continue
+		}
+		// Upgrade header entries may carry a version, e.g. "HTTP/2.0", which the rule may omit.
+		name, _, _ := strings.Cut(u, "/")
+		for _, want := range upgrades {
+			if strings.EqualFold(want, u) || strings.EqualFold(want, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchHTTPHeaderValue(m *proto.HTTPMatch_HeaderMatch, value string) bool {
+	var matchOne func(v string) bool
+	switch vm := m.GetValueMatch().(type) {
+	case *proto.HTTPMatch_HeaderMatch_Exact:
+		matchOne = func(v string) bool { return v == vm.Exact }
+	case *proto.HTTPMatch_HeaderMatch_Prefix:
+		matchOne = func(v string) bool { return strings.HasPrefix(v, vm.Prefix) }
+	case *proto.HTTPMatch_HeaderMatch_Regex:
+		re, err := regexCache.get(vm.Regex)
+		if err != nil {
+			s := fmt.Sprintf("Invalid HTTP header regex %q: %v", vm.Regex, err)
+			log.Error(s)
+			// Let the caller recover from the panic.
+			panic(&InvalidDataFromDataPlane{s})
+		}
+		matchOne = re.MatchString
+	default:
+		// Present only.
+		return true
+	}
+	if matchOne(value) {
+		return true
+	}
+	for _, v := range strings.Split(value, ",") {
+		if matchOne(strings.TrimSpace(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchHTTPContentTypes returns true if the media type of the request's Content-Type header is one of the given
+// content types. Parameters, such as "; charset=utf-8", are ignored.
+func matchHTTPContentTypes(contentTypes []string, reqContentType string) bool {
+	log.WithFields(log.Fields{
+		"contentTypes":   contentTypes,
+		"reqContentType": reqContentType,
+	}).Debug("Matching HTTP Content-Types")
+	if len(contentTypes) == 0 {
+		log.Debug("Rule has 0 HTTP Content-Types, matched.")
+		return true
+	}
+	mediaType := strings.TrimSpace(strings.Split(reqContentType, ";")[0])
+	for _, ct := range contentTypes {
+		if strings.EqualFold(ct, mediaType) {
+			log.Debug("HTTP Content-Type matched.")
+			return true
+		}
+	}
+	log.Debug("HTTP Content-Type not matched.")
+	return false
+}
+
 func matchSrcIPSets(r *proto.Rule, req *requestCache) bool {
 	log.WithFields(log.Fields{
 		"SrcIpSetIds":    r.SrcIpSetIds,
@@ -268,7 +909,9 @@ func matchSrcIPSets(r *proto.Rule, req *requestCache) bool {
 	}).Debug("matching source IP sets")
 	addr := req.Request.GetAttributes().GetSource().GetAddress()
 	return matchIPSetsAll(r.SrcIpSetIds, req, addr) &&
-		matchIPSetsNotAny(r.NotSrcIpSetIds, req, addr)
+		matchIPSetsNotAny(r.NotSrcIpSetIds, req, addr) &&
+		matchIPSetsAddedWithin(r.SrcIpSetIds, r.SrcIpSetAddedWithinSecs, req, addr) &&
+		matchIPSetsCardinalityAbove(r.SrcIpSetIds, r.SrcIpSetCardinalityAbove, req, addr)
 }
 
 func matchDstIPSets(r *proto.Rule, req *requestCache) bool {
@@ -278,31 +921,118 @@ func matchDstIPSets(r *proto.Rule, req *requestCache) bool {
 	}).Debug("matching destination IP sets")
 	addr := req.Request.GetAttributes().GetDestination().GetAddress()
 	return matchIPSetsAll(r.DstIpSetIds, req, addr) &&
-		matchIPSetsNotAny(r.NotDstIpSetIds, req, addr)
+		matchIPSetsNotAny(r.NotDstIpSetIds, req, addr) &&
+		matchIPSetsAddedWithin(r.DstIpSetIds, r.DstIpSetAddedWithinSecs, req, addr)
+}
+
+// matchDstIPPortSets returns true if the destination IP, protocol and port are in all of the rule's destination
+// IP_AND_PORT sets and in none of its negated ones.
+func matchDstIPPortSets(r *proto.Rule, req *requestCache) bool {
+	return matchIPPortSets("dst", r.GetDstIpPortSetIds(), r.GetNotDstIpPortSetIds(), req,
+		req.Request.GetAttributes().GetDestination().GetAddress())
+}
+
+// matchSrcIPPortSets returns true if the source IP, protocol and port are in all of the rule's source IP_AND_PORT sets
+// and in none of its negated ones.
+func matchSrcIPPortSets(r *proto.Rule, req *requestCache) bool {
+	return matchIPPortSets("src", r.GetSrcIpPortSetIds(), r.GetNotSrcIpPortSetIds(), req,
+		req.Request.GetAttributes().GetSource().GetAddress())
+}
+
+// matchIPPortSets returns true if the address is in all of the IP_AND_PORT sets ids and none of the notIDs.  The
+// sets' members have the form "<IP>,(tcp|udp):<port-number>", which the sets build from the address's IP, protocol
+// and port.
+func matchIPPortSets(dir string, ids, notIDs []string, req *requestCache, addr *core.Address) bool {
+	log.WithFields(log.Fields{
+		"ids":    ids,
+		"notIDs": notIDs,
+		"addr":   addr,
+		"dir":    dir,
+	}).Debug("matching IP port sets")
+	return matchIPSetsAll(ids, req, addr) && matchIPSetsNotAny(notIDs, req, addr)
 }
 
 // matchIPSetsAll returns true if the address matches all of the IP set ids, false otherwise.
 func matchIPSetsAll(ids []string, req *requestCache, addr *core.Address) bool {
 	for _, id := range ids {
-		s := req.GetIPSet(id)
-		if !s.ContainsAddress(addr) {
+		if !req.IPSetContains(id, addr) {
 			return false
 		}
 	}
 	return true
 }
 
+// matchIPSetsAddedWithin returns true if the address was added to at least one of the IP sets within the given number of
+// seconds.  Zero seconds matches any address.  IP sets that don't record when their members were added never match.
+func matchIPSetsAddedWithin(ids []string, secs uint32, req *requestCache, addr *core.Address) bool {
+	if secs == 0 {
+		return true
+	}
+	window := time.Duration(secs) * time.Second
+	for _, id := range ids {
+		s, ok := req.GetIPSet(id).(policystore.TimedIPSet)
+		if !ok {
+			continue
+		}
+		if added, ok := s.AddedAt(addr); ok && time.Since(added) <= window {
+			log.WithFields(log.Fields{
+				"ipset": id,
+				"added": added,
+			}).Debug("Address was recently added to IP set")
+			return true
+		}
+	}
+	return false
+}
+
+// matchIPSetsCardinalityAbove returns true if the address is in at least one of the IP sets that has more than the given
+// number of members.  Zero matches any address.
+func matchIPSetsCardinalityAbove(ids []string, n uint32, req *requestCache, addr *core.Address) bool {
+	if n == 0 {
+		return true
+	}
+	for _, id := range ids {
+		members := req.GetIPSet(id).Len()
+		if members > int(n) && req.IPSetContains(id, addr) {
+			log.WithFields(log.Fields{
+				"ipset":   id,
+				"members": members,
+			}).Debug("Address is in IP set above cardinality threshold")
+			return true
+		}
+	}
+	return false
+}
+
 // matchIPSetsNotAny returns true if the address does not match any of the ipset ids, false otherwise.
 func matchIPSetsNotAny(ids []string, req *requestCache, addr *core.Address) bool {
 	for _, id := range ids {
-		s := req.GetIPSet(id)
-		if s.ContainsAddress(addr) {
+		if req.IPSetContains(id, addr) {
 			return false
 		}
 	}
 	return true
 }
 
+// matchSrcPort returns true if the request's source port is in one of the rule's source port ranges or named port IP
+// sets, if it has any, and in none of its negated source port ranges and named port IP sets.
+func matchSrcPort(r *proto.Rule, req *requestCache) bool {
+	addr := req.Request.GetAttributes().GetSource().GetAddress()
+	return matchPort("src", r.GetSrcPorts(), r.GetSrcNamedPortIpSetIds(), req, addr) &&
+		matchNotPort("src", r.GetNotSrcPorts(), r.GetNotSrcNamedPortIpSetIds(), req, addr)
+}
+
+// matchDstPort returns true if the request's destination port is in one of the rule's destination port ranges or
+// named port IP sets, if it has any, and in none of its negated destination port ranges and named port IP sets.
+func matchDstPort(r *proto.Rule, req *requestCache) bool {
+	addr := req.Request.GetAttributes().GetDestination().GetAddress()
+	return matchPort("dst", r.GetDstPorts(), r.GetDstNamedPortIpSetIds(), req, addr) &&
+		matchNotPort("dst", r.GetNotDstPorts(), r.GetNotDstNamedPortIpSetIds(), req, addr)
+}
+
+// matchPort returns true if the address's port is in one of the port ranges or its IP and port are in one of the
+// named port IP sets.  Numeric ranges and named ports are ORed together, so that a rule such as "ports: [80, http]"
+// matches either.  If there are no ranges or named ports, any port matches.
 func matchPort(dir string, ranges []*proto.PortRange, namedPortSets []string, req *requestCache, addr *core.Address) bool {
 	log.WithFields(log.Fields{
 		"ranges":        ranges,
@@ -313,6 +1043,25 @@ func matchPort(dir string, ranges []*proto.PortRange, namedPortSets []string, re
 	if len(ranges) == 0 && len(namedPortSets) == 0 {
 		return true
 	}
+	return portInAny(ranges, namedPortSets, req, addr)
+}
+
+// matchNotPort returns true if the address's port is in none of the port ranges and its IP and port are in none of
+// the named port IP sets.
+func matchNotPort(dir string, ranges []*proto.PortRange, namedPortSets []string, req *requestCache, addr *core.Address) bool {
+	log.WithFields(log.Fields{
+		"ranges":        ranges,
+		"namedPortSets": namedPortSets,
+		"addr":          addr,
+		"dir":           dir,
+	}).Debug("matching not port")
+	if len(ranges) == 0 && len(namedPortSets) == 0 {
+		return true
+	}
+	return !portInAny(ranges, namedPortSets, req, addr)
+}
+
+func portInAny(ranges []*proto.PortRange, namedPortSets []string, req *requestCache, addr *core.Address) bool {
 	p := int32(addr.GetSocketAddress().GetPortValue())
 	for _, r := range ranges {
 		if r.GetFirst() <= p && p <= r.GetLast() {
@@ -320,8 +1069,7 @@ func matchPort(dir string, ranges []*proto.PortRange, namedPortSets []string, re
 		}
 	}
 	for _, id := range namedPortSets {
-		s := req.GetIPSet(id)
-		if s.ContainsAddress(addr) {
+		if req.IPSetContains(id, addr) {
 			return true
 		}
 	}
@@ -344,8 +1092,13 @@ func matchNet(dir string, nets []string, addr *core.Address) bool {
 		log.WithField("ip", addr.GetSocketAddress().GetAddress()).Warn("unable to parse IP")
 		return false
 	}
+	if v4 := ip.To4(); v4 != nil {
+		// Envoy reports IPv4 peers of dual-stack listeners as IPv4-mapped IPv6 addresses, e.g. ::ffff:192.168.1.1;
+		// match them as the IPv4 addresses they are.
+		ip = v4
+	}
 	for _, n := range nets {
-		_, ipn, err := net.ParseCIDR(n)
+		ipn, err := cidrCache.get(n)
 		if err != nil {
 			// Don't match CIDRs if they are malformed. This case should generally be weeded out by validation earlier
 			// in processing before it gets to Dikastes.
@@ -359,6 +1112,454 @@ func matchNet(dir string, nets []string, addr *core.Address) bool {
 	return false
 }
 
+// matchAppProtocol returns true if the application protocol that Envoy detected on the connection is one of the given
+// protocols. An empty list of protocols matches any request, including one without a detected protocol.
+func matchAppProtocol(protocols []string, md *core.Metadata) bool {
+	if len(protocols) == 0 {
+		return true
+	}
+	detected := md.GetFilterMetadata()[appProtocolMetadataNamespace].GetFields()[appProtocolMetadataKey].GetStringValue()
+	log.WithFields(log.Fields{
+		"protocols": protocols,
+		"detected":  detected,
+	}).Debug("Matching application protocol")
+	if detected == "" {
+		return false
+	}
+	for _, p := range protocols {
+		if strings.EqualFold(p, detected) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchJWTAudiences returns true if the "aud" claim of the request's JWT contains at least one of the given audiences.
+// The claim may be a single string or a list of strings. An empty list of audiences matches any request, including
+// one without a JWT.
+func matchJWTAudiences(audiences []string, md *core.Metadata) bool {
+	if len(audiences) == 0 {
+		return true
+	}
+	payload := md.GetFilterMetadata()[jwtMetadataNamespace].GetFields()[jwtPayloadMetadataKey].GetStructValue()
+	aud := payload.GetFields()["aud"]
+	var tokenAudiences []string
+	if list := aud.GetListValue(); list != nil {
+		for _, v := range list.GetValues() {
+			tokenAudiences = append(tokenAudiences, v.GetStringValue())
+		}
+	} else if s := aud.GetStringValue(); s != "" {
+		tokenAudiences = []string{s}
+	}
+	log.WithFields(log.Fields{
+		"audiences":      audiences,
+		"tokenAudiences": tokenAudiences,
+	}).Debug("Matching JWT audiences")
+	for _, a := range audiences {
+		for _, ta := range tokenAudiences {
+			if a == ta {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchRouteName returns true if the name of the Envoy route that the request matched is one of the given names. An
+// empty list of names matches any request, including one without a route name.
+func matchRouteName(names []string, md *core.Metadata) bool {
+	if len(names) == 0 {
+		return true
+	}
+	route := md.GetFilterMetadata()[routeMetadataNamespace].GetFields()[routeNameMetadataKey].GetStringValue()
+	log.WithFields(log.Fields{
+		"names": names,
+		"route": route,
+	}).Debug("Matching route name")
+	return route != "" && matchName(names, route, false)
+}
+
+// matchTLSFingerprints returns true if the request's JA3 or JA4 TLS fingerprint is one of the given fingerprints and
+// neither is one of the given not-fingerprints.  Fingerprints are compared case-insensitively.  A request without a
+// fingerprint fails any non-empty list, so that an allow list can't be bypassed by a client whose fingerprint Envoy
+// couldn't compute, and a deny list fails closed.
+func matchTLSFingerprints(fingerprints, notFingerprints []string, md *core.Metadata) bool {
+	if len(fingerprints) == 0 && len(notFingerprints) == 0 {
+		return true
+	}
+	fields := md.GetFilterMetadata()[tlsFingerprintMetadataNamespace].GetFields()
+	var reqFingerprints []string
+	for _, k := range []string{tlsFingerprintJA3MetadataKey, tlsFingerprintJA4MetadataKey} {
+		if fp := fields[k].GetStringValue(); fp != "" {
+			reqFingerprints = append(reqFingerprints, fp)
+		}
+	}
+	log.WithFields(log.Fields{
+		"fingerprints":    fingerprints,
+		"notFingerprints": notFingerprints,
+		"reqFingerprints": reqFingerprints,
+	}).Debug("Matching TLS fingerprints")
+	if len(reqFingerprints) == 0 {
+		return false
+	}
+	anyMatch := func(list []string) bool {
+		for _, fp := range reqFingerprints {
+			for _, l := range list {
+				if strings.EqualFold(l, fp) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return (len(fingerprints) == 0 || anyMatch(fingerprints)) && !anyMatch(notFingerprints)
+}
+
+// isGRPC returns true if the request is a gRPC call, that is, its content type is "application/grpc" or a variant such
+// as "application/grpc+proto".
+func isGRPC(req *authz.AttributeContext_HttpRequest) bool {
+	contentType := req.GetHeaders()["content-type"]
+	return contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+")
+}
+
+// matchGRPC returns true if a gRPC call is to one of the services and one of the methods of the gRPC match.  gRPC
+// calls are POSTs to "/<service>/<method>", where the service is fully qualified, e.g. "/helloworld.Greeter/SayHello";
+// calls with any other path don't match.  Empty lists of services or methods match any service or method, and requests
+// that aren't gRPC calls match a gRPC match vacuously.
+func matchGRPC(m *proto.GrpcMatch, req *authz.AttributeContext_HttpRequest) bool {
+	if m == nil || !isGRPC(req) {
+		return true
+	}
+	path := req.GetPath()
+	if path == "" {
+		path = req.GetHeaders()[":path"]
+	}
+	log.WithFields(log.Fields{
+		"services": m.GetServices(),
+		"methods":  m.GetMethods(),
+		"path":     path,
+	}).Debug("Matching gRPC service and method")
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" {
+		log.WithField("path", path).Debug("Malformed gRPC path")
+		return false
+	}
+	service, method := parts[1], parts[2]
+	return (len(m.GetServices()) == 0 || slices.Contains(m.GetServices(), service)) &&
+		(len(m.GetMethods()) == 0 || slices.Contains(m.GetMethods(), method))
+}
+
+// matchGRPCCallTypes returns true if the request is a gRPC call of one of the given types.  A request is a gRPC call if
+// its content type is "application/grpc" or a variant such as "application/grpc+proto"; it is streaming if Envoy
+// passes the streaming indicator in the request's metadata, and unary otherwise.  An empty list of types matches any
+// request, including one that isn't a gRPC call.
+func matchGRPCCallTypes(types []string, attr *authz.AttributeContext) bool {
+	if len(types) == 0 {
+		return true
+	}
+	if !isGRPC(attr.GetRequest().GetHttp()) {
+		log.Debug("Request is not a gRPC call")
+		return false
+	}
+	callType := grpcCallTypeUnary
+	streaming := attr.GetMetadataContext().GetFilterMetadata()[grpcMetadataNamespace].GetFields()[grpcStreamingMetadataKey]
+	if streaming.GetBoolValue() {
+		callType = grpcCallTypeStreaming
+	}
+	log.WithFields(log.Fields{
+		"types":    types,
+		"callType": callType,
+	}).Debug("Matching gRPC call type")
+	for _, t := range types {
+		if strings.EqualFold(t, callType) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestLabelsClause evaluates the rule's request label selector against the request-scoped labels in the request's
+// metadata.  It is unknown if Envoy didn't pass any request-scoped labels.
+func requestLabelsClause(selector string, attr *authz.AttributeContext) clauseResult {
+	if selector == "" {
+		return clauseMatch
+	}
+	fields := attr.GetMetadataContext().GetFilterMetadata()[requestLabelsMetadataNamespace]
+	if fields == nil {
+		return clauseUnknown
+	}
+	labels := make(map[string]string, len(fields.GetFields()))
+	for k, v := range fields.GetFields() {
+		labels[k] = v.GetStringValue()
+	}
+	return clauseResultOf(matchLabels(selector, labels))
+}
+
+// tlsTerminated returns true if Envoy terminated TLS on the connection.  Envoy only reports a TLS session, or the
+// principals from the certificates, when it is a TLS endpoint itself; a TLS connection that it passes through to the
+// destination looks to Envoy like any other TCP connection.
+func tlsTerminated(attr *authz.AttributeContext) bool {
+	terminated := attr.GetTlsSession() != nil ||
+		attr.GetSource().GetPrincipal() != "" ||
+		attr.GetDestination().GetPrincipal() != ""
+	log.WithField("terminated", terminated).Debug("Matching TLS termination")
+	return terminated
+}
+
+// matchServicePorts returns true if the request's destination resolves to one of the named Kubernetes service ports.
+// An empty list of names matches any destination.
+func matchServicePorts(names []string, req *requestCache) bool {
+	if len(names) == 0 {
+		return true
+	}
+	ports := req.DestinationServicePorts()
+	log.WithFields(log.Fields{
+		"names": names,
+		"ports": ports,
+	}).Debug("Matching service ports")
+	for _, p := range ports {
+		if matchName(names, p, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPrincipal returns true if the principal has one of the given prefixes, if any, and one of the given suffixes,
+// if any.  The prefixes and suffixes are literal strings.  A peer without a principal, for example because the request
+// is plain text, only matches if there are no prefixes or suffixes.
+func matchPrincipal(dir string, prefixes, suffixes []string, principal string) bool {
+	log.WithFields(log.Fields{
+		"prefixes":  prefixes,
+		"suffixes":  suffixes,
+		"principal": principal,
+		"dir":       dir,
+	}).Debug("Matching principal")
+	hasAny := func(affixes []string, has func(s, affix string) bool) bool {
+		if len(affixes) == 0 {
+			return true
+		}
+		for _, a := range affixes {
+			if principal != "" && has(principal, a) {
+				return true
+			}
+		}
+		return false
+	}
+	return hasAny(prefixes, strings.HasPrefix) && hasAny(suffixes, strings.HasSuffix)
+}
+
+// reverseDNSNamesClause evaluates the rule's destination reverse-DNS names: one of the destination's names must match
+// one of them, and they may contain wildcards as described by matchDNSNameGlob.  An empty list matches any
+// destination, including one without a PTR record.  It is unknown if the destination hasn't been resolved yet.
+func reverseDNSNamesClause(names []string, req *requestCache) clauseResult {
+	if len(names) == 0 {
+		return clauseMatch
+	}
+	ptrNames, ok := req.DestinationReverseDNSNames()
+	if !ok {
+		log.WithField("names", names).Debug("Destination's reverse-DNS names aren't cached yet")
+		return clauseUnknown
+	}
+	log.WithFields(log.Fields{
+		"names":    names,
+		"ptrNames": ptrNames,
+	}).Debug("Matching destination reverse-DNS names")
+	for _, ptr := range ptrNames {
+		for _, n := range names {
+			if matchDNSNameGlob(n, ptr) {
+				return clauseMatch
+			}
+		}
+	}
+	return clauseNoMatch
+}
+
+// matchDNSNameGlob returns true if the DNS name matches the pattern, case-insensitively and ignoring any trailing
+// dots.  A "*" label in the pattern matches exactly one label of the name, except that a leading "*" label matches one
+// or more labels, so "*.example.com" matches any name under example.com.  "*" is only special as a whole label, and no
+// other character is special.
+func matchDNSNameGlob(pattern, name string) bool {
+	patternLabels := strings.Split(strings.ToLower(strings.TrimSuffix(pattern, ".")), ".")
+	nameLabels := strings.Split(strings.ToLower(strings.TrimSuffix(name, ".")), ".")
+	if patternLabels[0] == "*" {
+		if len(nameLabels) < len(patternLabels) {
+			return false
+		}
+		patternLabels = patternLabels[1:]
+		nameLabels = nameLabels[len(nameLabels)-len(patternLabels):]
+	}
+	if len(nameLabels) != len(patternLabels) {
+		return false
+	}
+	for i, l := range patternLabels {
+		if l != "*" && l != nameLabels[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchNodeLabel returns true if the node that the request's source belongs to has the given label, with one of the
+// given values.  An empty list of values matches any source, including one whose node is unknown.
+func matchNodeLabel(label string, values []string, req *requestCache) bool {
+	if len(values) == 0 {
+		return true
+	}
+	value, ok := req.SourceNodeLabels()[label]
+	log.WithFields(log.Fields{
+		"label":  label,
+		"values": values,
+		"value":  value,
+	}).Debug("Matching source node label")
+	return ok && slices.Contains(values, value)
+}
+
+// matchSrcASNumbers returns true if the source's node has one of the given BGP AS numbers.  An empty list matches any
+// source, including one whose AS number is unknown.
+func matchSrcASNumbers(asNumbers []uint32, req *requestCache) bool {
+	if len(asNumbers) == 0 {
+		return true
+	}
+	asNumber, ok := req.SourceASNumber()
+	log.WithFields(log.Fields{
+		"asNumbers": asNumbers,
+		"asNumber":  asNumber,
+		"known":     ok,
+	}).Debug("Matching source AS number")
+	return ok && slices.Contains(asNumbers, asNumber)
+}
+
+// icmpTypeAndCode returns the ICMP type and code of the flow, and whether it is an ICMP flow at all.  A flow with a
+// type but no code has code 0.
+func icmpTypeAndCode(attr *authz.AttributeContext) (icmpType, icmpCode int32, ok bool) {
+	fields := attr.GetMetadataContext().GetFilterMetadata()[icmpMetadataNamespace].GetFields()
+	t, ok := fields[icmpTypeMetadataKey].GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, 0, false
+	}
+	return int32(t.NumberValue), int32(fields[icmpCodeMetadataKey].GetNumberValue()), true
+}
+
+// icmpProtocol returns the canonical name of the protocol of an ICMP flow with the given destination: "icmpv6" if its
+// address is IPv6 and "icmp" otherwise.
+func icmpProtocol(dest *authz.AttributeContext_Peer) string {
+	ip := net.ParseIP(dest.GetAddress().GetSocketAddress().GetAddress())
+	if ip != nil && ip.To4() == nil {
+		return "icmpv6"
+	}
+	return "icmp"
+}
+
+// matchICMP returns true if the flow's ICMP type and code match the rule's icmp and not_icmp clauses.  A clause with
+// a type but no code matches any code.  The clauses match any flow that isn't ICMP.
+func matchICMP(rule *proto.Rule, attr *authz.AttributeContext) bool {
+	if rule.GetIcmp() == nil && rule.GetNotIcmp() == nil {
+		return true
+	}
+	icmpType, icmpCode, ok := icmpTypeAndCode(attr)
+	log.WithFields(log.Fields{
+		"icmp":    rule.GetIcmp(),
+		"notIcmp": rule.GetNotIcmp(),
+		"isICMP":  ok,
+		"type":    icmpType,
+		"code":    icmpCode,
+	}).Debug("Matching ICMP type and code")
+	if !ok {
+		return true
+	}
+	switch icmp := rule.GetIcmp().(type) {
+	case *proto.Rule_IcmpType:
+		if icmp.IcmpType != icmpType {
+			return false
+		}
+	case *proto.Rule_IcmpTypeCode:
+		if icmp.IcmpTypeCode.GetType() != icmpType || icmp.IcmpTypeCode.GetCode() != icmpCode {
+			return false
+		}
+	}
+	switch notIcmp := rule.GetNotIcmp().(type) {
+	case *proto.Rule_NotIcmpType:
+		return notIcmp.NotIcmpType != icmpType
+	case *proto.Rule_NotIcmpTypeCode:
+		return notIcmp.NotIcmpTypeCode.GetType() != icmpType || notIcmp.NotIcmpTypeCode.GetCode() != icmpCode
+	}
+	return true
+}
+
+// endpointReady returns true if the endpoint's state is "active".  An endpoint that isn't in the store, or that has no
+// state, is not ready.
+func endpointReady(ep *proto.WorkloadEndpoint) bool {
+	log.WithField("state", ep.GetState()).Debug("Matching endpoint readiness")
+	return ep.GetState() == endpointStateActive
+}
+
+// endpointListening returns true if the endpoint declares a port with the address's port and protocol.  An endpoint
+// that isn't in the store, or that declares no ports, isn't listening.
+func endpointListening(ep *proto.WorkloadEndpoint, addr *core.SocketAddress) bool {
+	log.WithFields(log.Fields{
+		"ports":    ep.GetPorts(),
+		"port":     addr.GetPortValue(),
+		"protocol": addr.GetProtocol(),
+	}).Debug("Matching listening port")
+	if addr.GetPortValue() == 0 {
+		return false
+	}
+	reqProtocol := strings.ToLower(addr.GetProtocol().String())
+	for _, p := range ep.GetPorts() {
+		if p.GetPort() != addr.GetPortValue() {
+			continue
+		}
+		protocol, err := canonicalProtocol(&proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: p.GetProtocol()}})
+		if err != nil {
+			log.WithError(err).WithField("port", p).Debug("Ignoring endpoint port with unknown protocol")
+			continue
+		}
+		if protocol == reqProtocol {
+			return true
+		}
+	}
+	return false
+}
+
+// matchEncapsulations returns true if the route to the request's destination uses one of the given encapsulations.
+// If the store has no route to the destination, only an empty list matches.
+func matchEncapsulations(encaps []string, req *requestCache) bool {
+	if len(encaps) == 0 {
+		return true
+	}
+	encap := req.DestinationEncapsulation()
+	log.WithFields(log.Fields{
+		"encaps": encaps,
+		"encap":  encap,
+	}).Debug("Matching destination encapsulation")
+	for _, e := range encaps {
+		if encap != "" && strings.EqualFold(e, encap) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIPPools returns true if the address is in one of the named IP pools. An empty list of pools matches any address.
+func matchIPPools(dir string, names []string, pools map[string]*proto.IPAMPool, addr *core.Address) bool {
+	log.WithFields(log.Fields{
+		"pools": names,
+		"addr":  addr,
+		"dir":   dir,
+	}).Debug("matching IP pools")
+	if len(names) == 0 {
+		return true
+	}
+	var cidrs []string
+	for _, pool := range pools {
+		if matchName(names, pool.GetName(), false) {
+			cidrs = append(cidrs, pool.GetCidr())
+		}
+	}
+	return len(cidrs) > 0 && matchNet(dir, cidrs, addr)
+}
+
 func matchL4Protocol(rule *proto.Rule, dest *authz.AttributeContext_Peer) bool {
 	// Extract L4 protocol type of socket address for destination peer context. Match against rules.
 	if dest == nil {
@@ -374,22 +1575,24 @@ func matchL4Protocol(rule *proto.Rule, dest *authz.AttributeContext_Peer) bool {
 		"requestProtocol": reqProtocol,
 	}).Debug("Matching L4 protocol")
 
+	return matchProtocolName(rule, reqProtocol)
+}
+
+// matchProtocolName returns true if the canonical name of the request's protocol, e.g. "tcp", matches the rule's
+// protocol and not_protocol.
+func matchProtocolName(rule *proto.Rule, reqProtocol string) bool {
 	checkStringInRuleProtocol := func(p *proto.Protocol, s string, defaultResult bool) bool {
 		if p == nil {
 			return defaultResult
 		}
 
-		// Check if given protocol string matches what is specified in rule.
-		// Note we compare names in lowercase.
-		if name := p.GetName(); name != "" {
-			return strings.ToLower(name) == s
-		}
-
-		if name, ok := protocolMapL4[p.GetNumber()]; ok {
-			return name == s
+		// Rules may use any alias of the protocol, such as "TCP" or "6", so compare the canonical names.
+		name, err := canonicalProtocol(p)
+		if err != nil {
+			log.WithError(err).Debug("Rule has an unknown protocol")
+			return false
 		}
-
-		return false
+		return name == s
 	}
 
 	return checkStringInRuleProtocol(rule.GetProtocol(), reqProtocol, true) &&
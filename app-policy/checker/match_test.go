@@ -15,11 +15,18 @@
 package checker
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	auth "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	_struct "github.com/golang/protobuf/ptypes/struct"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	log "github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 
 	"github.com/projectcalico/calico/app-policy/policystore"
 	"github.com/projectcalico/calico/felix/proto"
@@ -49,17 +56,31 @@ func TestMatchName(t *testing.T) {
 		title  string
 		names  []string
 		name   string
+		glob   bool
 		result bool
 	}{
-		{"empty", []string{}, "reginald", true},
-		{"match", []string{"susan", "jim", "reginald"}, "reginald", true},
-		{"no match", []string{"susan", "jim", "reginald"}, "steven", false},
+		{"empty", []string{}, "reginald", false, true},
+		{"match", []string{"susan", "jim", "reginald"}, "reginald", false, true},
+		{"no match", []string{"susan", "jim", "reginald"}, "steven", false, false},
+		{"wildcard without glob", []string{"payments-*"}, "payments-7f9c", false, false},
+		{"literal wildcard without glob", []string{"payments-*"}, "payments-*", false, true},
+		{"glob empty", []string{}, "reginald", true, true},
+		{"glob literal match", []string{"susan", "reginald"}, "reginald", true, true},
+		{"glob literal no match", []string{"susan", "reginald"}, "reginal", true, false},
+		{"glob star", []string{"payments-*"}, "payments-7f9c", true, true},
+		{"glob star matches empty", []string{"payments-*"}, "payments-", true, true},
+		{"glob star no match", []string{"payments-*"}, "billing-7f9c", true, false},
+		{"glob star anchored", []string{"payments-*"}, "old-payments-7f9c", true, false},
+		{"glob question mark", []string{"payments-v?"}, "payments-v2", true, true},
+		{"glob question mark single character", []string{"payments-v?"}, "payments-v10", true, false},
+		{"glob regex characters are literal", []string{"a.b-*"}, "axb-1", true, false},
+		{"one of several globs", []string{"billing-*", "payments-*"}, "payments-1", true, true},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.title, func(t *testing.T) {
 			RegisterTestingT(t)
-			result := matchName(tc.names, tc.name)
+			result := matchName(tc.names, tc.name, tc.glob)
 			Expect(result).To(Equal(tc.result))
 		})
 	}
@@ -105,7 +126,38 @@ func TestMatchHTTPMethods(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.title, func(t *testing.T) {
 			RegisterTestingT(t)
-			Expect(matchHTTPMethods(tc.methods, tc.method)).To(Equal(tc.result))
+			Expect(matchHTTPMethods(tc.methods, tc.method, false)).To(Equal(tc.result))
+		})
+	}
+}
+
+// With the case-insensitive option, methods match whatever their case; the wildcard is unchanged.
+func TestMatchHTTPMethodsCaseInsensitive(t *testing.T) {
+	testCases := []struct {
+		title             string
+		methods           []string
+		method            string
+		strictResult      bool
+		insensitiveResult bool
+	}{
+		{"empty", []string{}, "get", true, true},
+		{"same case", []string{"GET", "HEAD"}, "GET", true, true},
+		{"lowercase request", []string{"GET", "HEAD"}, "get", false, true},
+		{"lowercase rule", []string{"get", "HEAD"}, "GET", false, true},
+		{"mixed case", []string{"POST"}, "Post", false, true},
+		{"different method", []string{"GET"}, "post", false, false},
+		{"wildcard", []string{"*"}, "madness", true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPMethods(tc.methods, tc.method, false)).To(Equal(tc.strictResult))
+			Expect(matchHTTPMethods(tc.methods, tc.method, true)).To(Equal(tc.insensitiveResult))
+
+			req := &auth.AttributeContext_HttpRequest{Method: tc.method}
+			Expect(matchHTTP(&proto.HTTPMatch{Methods: tc.methods}, req)).To(Equal(tc.strictResult))
+			Expect(matchHTTP(&proto.HTTPMatch{Methods: tc.methods, CaseInsensitive: true}, req)).To(Equal(tc.insensitiveResult))
 		})
 	}
 }
@@ -134,9 +186,214 @@ func TestMatchHTTPPaths(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.title, func(t *testing.T) {
 			RegisterTestingT(t)
-			Expect(matchHTTPPaths(tc.paths, tc.reqPath)).To(Equal(tc.result))
+			Expect(matchHTTPPaths(tc.paths, tc.reqPath, false)).To(Equal(tc.result))
+		})
+	}
+}
+
+// Regex HTTP paths must match the whole path, without its query string or fragment.
+func TestMatchHTTPPathsRegex(t *testing.T) {
+	regex := func(r string) []*proto.HTTPMatch_PathMatch {
+		return []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: r}}}
+	}
+	testCases := []struct {
+		title   string
+		paths   []*proto.HTTPMatch_PathMatch
+		reqPath string
+		result  bool
+	}{
+		{"dynamic segment", regex("/users/[^/]+/profile"), "/users/42/profile", true},
+		{"dynamic segment missing", regex("/users/[^/]+/profile"), "/users//profile", false},
+		{"implicit start anchor", regex("/users/[^/]+/profile"), "/api/users/42/profile", false},
+		{"implicit end anchor", regex("/users/[^/]+/profile"), "/users/42/profile/photo", false},
+		{"explicit anchors", regex("^/users/[0-9]+$"), "/users/42", true},
+		{"explicit anchors fail", regex("^/users/[0-9]+$"), "/users/bob", false},
+		{"unanchored by wildcard", regex(".*/profile"), "/users/42/profile", true},
+		{"query stripped", regex("/users/[0-9]+"), "/users/42?expand=true", true},
+		{"fragment stripped", regex("/users/[0-9]+"), "/users/42#top", true},
+		{"query not matched", regex(".*expand.*"), "/users/42?expand=true", false},
+		{"one of several", []*proto.HTTPMatch_PathMatch{
+			{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: "/health"}},
+			{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/orders/[0-9]+"}},
+		}, "/orders/7", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPPaths(tc.paths, tc.reqPath, false)).To(Equal(tc.result))
+		})
+	}
+}
+
+// A malformed regex is invalid data from the dataplane, like a malformed request path.
+func TestMatchHTTPPathsBadRegex(t *testing.T) {
+	RegisterTestingT(t)
+	paths := []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/users/(["}}}
+	Expect(func() { matchHTTPPaths(paths, "/users/42", false) }).To(PanicWith(BeAssignableToTypeOf(&InvalidDataFromDataPlane{})))
+
+	// PCRE-only syntax, such as lookahead, is rejected.
+	paths = []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/(?!admin).*"}}}
+	Expect(func() { matchHTTPPaths(paths, "/users", false) }).To(PanicWith(BeAssignableToTypeOf(&InvalidDataFromDataPlane{})))
+}
+
+// Exact HTTP paths only treat a trailing slash as equivalent when the rule asks for it.
+func TestMatchHTTPPathsTrailingSlash(t *testing.T) {
+	exact := func(p string) []*proto.HTTPMatch_PathMatch {
+		return []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: p}}}
+	}
+	testCases := []struct {
+		title        string
+		paths        []*proto.HTTPMatch_PathMatch
+		reqPath      string
+		strictResult bool
+		ignoreResult bool
+	}{
+		{"same path", exact("/foo"), "/foo", true, true},
+		{"request has trailing slash", exact("/foo"), "/foo/", false, true},
+		{"rule has trailing slash", exact("/foo/"), "/foo", false, true},
+		{"trailing slash with query", exact("/foo"), "/foo/?x=1", false, true},
+		{"root", exact("/"), "/", true, true},
+		{"double slash", exact("/foo"), "/foo//", false, false},
+		{"different path", exact("/foo"), "/bar/", false, false},
+		{"prefix unaffected", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: "/foo/"}}}, "/foo", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPPaths(tc.paths, tc.reqPath, false)).To(Equal(tc.strictResult))
+			Expect(matchHTTPPaths(tc.paths, tc.reqPath, true)).To(Equal(tc.ignoreResult))
+
+			req := &auth.AttributeContext_HttpRequest{Path: tc.reqPath}
+			Expect(matchHTTP(&proto.HTTPMatch{Paths: tc.paths}, req)).To(Equal(tc.strictResult))
+			Expect(matchHTTP(&proto.HTTPMatch{Paths: tc.paths, IgnoreTrailingSlash: true}, req)).To(Equal(tc.ignoreResult))
+		})
+	}
+}
+
+// HTTP Content-Types clause matches the media type of the Content-Type header, ignoring parameters.
+func TestMatchHTTPContentTypes(t *testing.T) {
+	testCases := []struct {
+		title        string
+		contentTypes []string
+		reqType      string
+		result       bool
+	}{
+		{"empty", []string{}, "application/json", true},
+		{"empty, no header", []string{}, "", true},
+		{"match", []string{"application/json"}, "application/json", true},
+		{"match with parameters", []string{"application/json"}, "application/json; charset=utf-8", true},
+		{"match without space", []string{"application/json"}, "application/json;charset=utf-8", true},
+		{"case-insensitive", []string{"application/json"}, "Application/JSON", true},
+		{"one of several", []string{"text/plain", "application/json"}, "application/json", true},
+		{"no match", []string{"application/json"}, "text/html; charset=utf-8", false},
+		{"no header", []string{"application/json"}, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPContentTypes(tc.contentTypes, tc.reqType)).To(Equal(tc.result))
+
+			req := &auth.AttributeContext_HttpRequest{Headers: map[string]string{"content-type": tc.reqType}}
+			Expect(matchHTTP(&proto.HTTPMatch{ContentTypes: tc.contentTypes}, req)).To(Equal(tc.result))
+		})
+	}
+}
+
+// Negative method and path clauses exclude requests that the positive clauses would otherwise match.
+func TestMatchHTTPNotMethodsAndPaths(t *testing.T) {
+	exact := func(p string) *proto.HTTPMatch_PathMatch {
+		return &proto.HTTPMatch_PathMatch{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: p}}
+	}
+	prefix := func(p string) *proto.HTTPMatch_PathMatch {
+		return &proto.HTTPMatch_PathMatch{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: p}}
+	}
+	testCases := []struct {
+		title  string
+		rule   *proto.HTTPMatch
+		method string
+		path   string
+		result bool
+	}{
+		{"empty negatives", &proto.HTTPMatch{NotMethods: []string{}, NotPaths: []*proto.HTTPMatch_PathMatch{}}, "GET", "/foo", true},
+		{"not method", &proto.HTTPMatch{NotMethods: []string{"TRACE", "CONNECT"}}, "TRACE", "/foo", false},
+		{"not method, other method", &proto.HTTPMatch{NotMethods: []string{"TRACE", "CONNECT"}}, "GET", "/foo", true},
+		{"not method, case-sensitive", &proto.HTTPMatch{NotMethods: []string{"TRACE"}}, "trace", "/foo", true},
+		{"not method, case-insensitive", &proto.HTTPMatch{NotMethods: []string{"TRACE"}, CaseInsensitive: true}, "trace", "/foo", false},
+		{"not method wildcard", &proto.HTTPMatch{NotMethods: []string{"*"}}, "GET", "/foo", false},
+		{"not path exact", &proto.HTTPMatch{NotPaths: []*proto.HTTPMatch_PathMatch{exact("/admin")}}, "GET", "/admin", false},
+		{"not path exact, longer path", &proto.HTTPMatch{NotPaths: []*proto.HTTPMatch_PathMatch{exact("/admin")}}, "GET", "/admin/users", true},
+		{"not path exact, query", &proto.HTTPMatch{NotPaths: []*proto.HTTPMatch_PathMatch{exact("/admin")}}, "GET", "/admin?x=1", false},
+		{"not path exact, trailing slash", &proto.HTTPMatch{
+			NotPaths:            []*proto.HTTPMatch_PathMatch{exact("/admin")},
+			IgnoreTrailingSlash: true,
+		}, "GET", "/admin/", false},
+		{"not path prefix", &proto.HTTPMatch{NotPaths: []*proto.HTTPMatch_PathMatch{prefix("/admin")}}, "GET", "/admin/users", false},
+		{"positive and negative methods", &proto.HTTPMatch{
+			Methods:    []string{"*"},
+			NotMethods: []string{"TRACE", "CONNECT"},
+		}, "CONNECT", "/foo", false},
+		{"positive path, negative path", &proto.HTTPMatch{
+			Paths:    []*proto.HTTPMatch_PathMatch{prefix("/api/")},
+			NotPaths: []*proto.HTTPMatch_PathMatch{prefix("/api/internal/")},
+		}, "GET", "/api/public/items", true},
+		{"positive path, excluded by negative path", &proto.HTTPMatch{
+			Paths:    []*proto.HTTPMatch_PathMatch{prefix("/api/")},
+			NotPaths: []*proto.HTTPMatch_PathMatch{prefix("/api/internal/")},
+		}, "GET", "/api/internal/items", false},
+		{"negative path doesn't rescue positive mismatch", &proto.HTTPMatch{
+			Paths:    []*proto.HTTPMatch_PathMatch{prefix("/api/")},
+			NotPaths: []*proto.HTTPMatch_PathMatch{prefix("/admin/")},
+		}, "GET", "/static/app.js", false},
+		{"positive method, negative path", &proto.HTTPMatch{
+			Methods:  []string{"GET"},
+			NotPaths: []*proto.HTTPMatch_PathMatch{exact("/debug")},
+		}, "GET", "/debug", false},
+		{"negative method, positive path", &proto.HTTPMatch{
+			Paths:      []*proto.HTTPMatch_PathMatch{prefix("/api/")},
+			NotMethods: []string{"DELETE"},
+		}, "POST", "/api/items", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			req := &auth.AttributeContext_HttpRequest{Method: tc.method, Path: tc.path}
+			Expect(matchHTTP(tc.rule, req)).To(Equal(tc.result))
+		})
+	}
+}
+
+// The body size limit matches requests no larger than the limit, and requests whose size is unknown.
+func TestMatchHTTPBodySize(t *testing.T) {
+	testCases := []struct {
+		title    string
+		maxBytes uint64
+		size     int64
+		result   bool
+	}{
+		{"no limit", 0, 1 << 30, true},
+		{"under limit", 1024, 512, true},
+		{"at limit", 1024, 1024, true},
+		{"over limit", 1024, 1025, false},
+		{"empty body", 1024, 0, true},
+		{"size unknown", 1024, -1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			req := &auth.AttributeContext_HttpRequest{Method: "POST", Path: "/upload", Size: tc.size}
+			Expect(matchHTTP(&proto.HTTPMatch{MaxBodyBytes: tc.maxBytes}, req)).To(Equal(tc.result))
 		})
 	}
+
+	// A request without the size attribute populated has size zero.
+	RegisterTestingT(t)
+	req := &auth.AttributeContext_HttpRequest{Method: "POST", Path: "/upload"}
+	Expect(matchHTTP(&proto.HTTPMatch{MaxBodyBytes: 1024}, req)).To(BeTrue())
 }
 
 // An omitted HTTP Match clause always matches.
@@ -155,7 +412,7 @@ func TestPanicHTTPPaths(t *testing.T) {
 		Expect(recover()).To(BeAssignableToTypeOf(&InvalidDataFromDataPlane{}))
 	}()
 	paths := []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: "/foo"}}}
-	matchHTTPPaths(paths, "foo")
+	matchHTTPPaths(paths, "foo", false)
 }
 
 // Matching a whole rule should require matching all subclauses.
@@ -360,6 +617,54 @@ func TestMatchRuleNamespaceSelectors(t *testing.T) {
 	Expect(match(rule, reqCache, "")).To(BeTrue())
 }
 
+// The source namespace label clause requires the source's namespace, as found in the store, to carry the labels.
+func TestMatchSrcNamespaceLabels(t *testing.T) {
+	testCases := []struct {
+		title     string
+		labels    map[string]string
+		principal string
+		match     bool
+	}{
+		{"no clause", nil, "spiffe://cluster.local/ns/meshed/sa/sam", true},
+		{"label present", map[string]string{"istio-injection": "enabled"}, "spiffe://cluster.local/ns/meshed/sa/sam", true},
+		{"several labels present", map[string]string{"istio-injection": "enabled", "team": "web"},
+			"spiffe://cluster.local/ns/meshed/sa/sam", true},
+		{"label absent", map[string]string{"istio-injection": "enabled"}, "spiffe://cluster.local/ns/plain/sa/sam", false},
+		{"label with another value", map[string]string{"istio-injection": "enabled"},
+			"spiffe://cluster.local/ns/disabled/sa/sam", false},
+		{"one of several labels absent", map[string]string{"istio-injection": "enabled", "team": "api"},
+			"spiffe://cluster.local/ns/meshed/sa/sam", false},
+		{"namespace not in store", map[string]string{"istio-injection": "enabled"},
+			"spiffe://cluster.local/ns/unknown/sa/sam", false},
+		{"plain text", map[string]string{"istio-injection": "enabled"}, "", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	for name, labels := range map[string]map[string]string{
+		"meshed":   {"istio-injection": "enabled", "team": "web"},
+		"plain":    {"team": "web"},
+		"disabled": {"istio-injection": "disabled"},
+	} {
+		id := proto.NamespaceID{Name: name}
+		store.NamespaceByID[id] = &proto.NamespaceUpdate{Id: &id, Labels: labels}
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source:      &auth.AttributeContext_Peer{Principal: tc.principal},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{SrcNamespaceLabels: tc.labels}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
 // Test that rules only match same namespace if pod selector or service account is set
 func TestMatchRulePolicyNamespace(t *testing.T) {
 	RegisterTestingT(t)
@@ -692,6 +997,85 @@ func TestMatchPort(t *testing.T) {
 	}
 }
 
+func TestMatchSrcAndDstPorts(t *testing.T) {
+	testCases := []struct {
+		title string
+		rule  *proto.Rule
+		match bool
+	}{
+		{"no port clauses", &proto.Rule{}, true},
+		{"src port range", &proto.Rule{SrcPorts: []*proto.PortRange{{First: 30000, Last: 40000}}}, true},
+		{"src named port", &proto.Rule{SrcNamedPortIpSetIds: []string{"src-ephemeral"}}, true},
+		{"other src named port", &proto.Rule{SrcNamedPortIpSetIds: []string{"dst-http"}}, false},
+		{"not src port range", &proto.Rule{NotSrcPorts: []*proto.PortRange{{First: 30000, Last: 40000}}}, false},
+		{"not src named port", &proto.Rule{NotSrcNamedPortIpSetIds: []string{"src-ephemeral"}}, false},
+		{"not other src named port", &proto.Rule{NotSrcNamedPortIpSetIds: []string{"dst-http"}}, true},
+		{"dst port", &proto.Rule{DstPorts: []*proto.PortRange{{First: 8080, Last: 8080}}}, true},
+		{"dst named port", &proto.Rule{DstNamedPortIpSetIds: []string{"dst-http"}}, true},
+		{
+			"dst range no match, named port match",
+			&proto.Rule{DstPorts: []*proto.PortRange{{First: 80, Last: 80}}, DstNamedPortIpSetIds: []string{"dst-http"}},
+			true,
+		},
+		{
+			"dst range match, named port no match",
+			&proto.Rule{DstPorts: []*proto.PortRange{{First: 8080, Last: 8080}}, DstNamedPortIpSetIds: []string{"dst-grpc"}},
+			true,
+		},
+		{
+			"dst range and named port no match",
+			&proto.Rule{DstPorts: []*proto.PortRange{{First: 80, Last: 80}}, DstNamedPortIpSetIds: []string{"dst-grpc"}},
+			false,
+		},
+		{"not dst port", &proto.Rule{NotDstPorts: []*proto.PortRange{{First: 8080, Last: 8080}}}, false},
+		{"not dst named port", &proto.Rule{NotDstNamedPortIpSetIds: []string{"dst-http"}}, false},
+		{
+			"not dst range and named port no match",
+			&proto.Rule{NotDstPorts: []*proto.PortRange{{First: 80, Last: 80}}, NotDstNamedPortIpSetIds: []string{"dst-grpc"}},
+			true,
+		},
+		{
+			"dst named port match, excluded by not range",
+			&proto.Rule{DstNamedPortIpSetIds: []string{"dst-http"}, NotDstPorts: []*proto.PortRange{{First: 8000, Last: 9000}}},
+			false,
+		},
+	}
+
+	store := policystore.NewPolicyStore()
+	for id, member := range map[string]string{
+		"src-ephemeral": "10.0.0.1,tcp:35000",
+		"dst-http":      "10.0.0.2,tcp:8080",
+		"dst-grpc":      "10.0.0.2,tcp:9090",
+	} {
+		s := policystore.NewIPSet(proto.IPSetUpdate_IP_AND_PORT)
+		s.AddString(member)
+		store.IPSetByID[id] = s
+	}
+	req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+		Source: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+			SocketAddress: &core.SocketAddress{
+				Address:       "10.0.0.1",
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: 35000},
+			},
+		}}},
+		Destination: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+			SocketAddress: &core.SocketAddress{
+				Address:       "10.0.0.2",
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: 8080},
+			},
+		}}},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			Expect(match(tc.rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
 func TestMatchNet(t *testing.T) {
 	testCases := []struct {
 		title string
@@ -753,6 +1137,36 @@ func TestMatchNet(t *testing.T) {
 			ip:    "85ab:0023::abcd",
 			match: false,
 		},
+		{
+			title: "v4-mapped v6 ip v4 net match",
+			nets:  []string{"192.168.0.0/16"},
+			ip:    "::ffff:192.168.1.1",
+			match: true,
+		},
+		{
+			title: "v4-mapped v6 ip v4 net no match",
+			nets:  []string{"10.0.0.0/8"},
+			ip:    "::ffff:192.168.1.1",
+			match: false,
+		},
+		{
+			title: "v4-mapped v6 ip v6 net no match",
+			nets:  []string{"::/0"},
+			ip:    "::ffff:192.168.1.1",
+			match: false,
+		},
+		{
+			title: "mixed v4-mapped v6 ip match",
+			nets:  []string{"45ab:0023::/32", "192.168.0.0/16"},
+			ip:    "::ffff:c0a8:101",
+			match: true,
+		},
+		{
+			title: "v6 ip with v4 suffix v4 net no match",
+			nets:  []string{"192.168.0.0/16"},
+			ip:    "64:ff9b::192.168.1.1",
+			match: false,
+		},
 		{
 			title: "multiple nets no match",
 			nets:  []string{"45.81.99.128/25", "10.0.0.0/8", "13.12.0.0/16"},
@@ -795,3 +1209,2015 @@ func TestMatchNetBadCIDR(t *testing.T) {
 	nets := []string{"192.168.0.0.0/16"}
 	Expect(matchNet("test", nets, addr)).To(BeFalse())
 }
+
+// The destination annotations clause matches annotations on the destination endpoint resolved from the store.
+func TestMatchDstAnnotations(t *testing.T) {
+	testCases := []struct {
+		title       string
+		annotations map[string]string
+		dstIP       string
+		match       bool
+	}{
+		{"empty", nil, "10.0.0.9", true},
+		{"key and value", map[string]string{"team": "payments"}, "10.0.0.1", true},
+		{"all required", map[string]string{"team": "payments", "tier": "gold"}, "10.0.0.1", true},
+		{"wrong value", map[string]string{"team": "billing"}, "10.0.0.1", false},
+		{"missing key", map[string]string{"owner": "alice"}, "10.0.0.1", false},
+		{"unknown endpoint", map[string]string{"team": "payments"}, "10.0.0.9", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.EndpointByIP["10.0.0.1"] = &proto.WorkloadEndpoint{
+		Name:        "wep",
+		Annotations: map[string]string{"team": "payments", "tier": "gold"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstAnnotations: tc.annotations}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The local IPAM block clause matches destinations within a block that is affine to this host.
+func TestMatchDstInLocalIPAMBlock(t *testing.T) {
+	testCases := []struct {
+		title   string
+		inBlock bool
+		dstIP   string
+		match   bool
+	}{
+		{"not required", false, "10.65.2.7", true},
+		{"inside local block", true, "10.65.1.7", true},
+		{"inside remote block", true, "10.65.2.7", false},
+		{"outside any block", true, "192.168.0.1", false},
+		{"no destination IP", true, "", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.LocalIPAMBlocks["10.65.1.0/26"] = &proto.RouteUpdate{Type: proto.RouteType_LOCAL_WORKLOAD, Dst: "10.65.1.0/26"}
+	store.RouteByDst["10.65.1.0/26"] = store.LocalIPAMBlocks["10.65.1.0/26"]
+	store.RouteByDst["10.65.2.0/26"] = &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: "10.65.2.0/26"}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstInLocalIpamBlock: tc.inBlock}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The application protocol clause matches the protocol Envoy detected, as passed in the request metadata.
+func TestMatchAppProtocols(t *testing.T) {
+	withProtocol := func(p string) *core.Metadata {
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			appProtocolMetadataNamespace: {Fields: map[string]*_struct.Value{
+				appProtocolMetadataKey: {Kind: &_struct.Value_StringValue{StringValue: p}},
+			}},
+		}}
+	}
+	testCases := []struct {
+		title     string
+		protocols []string
+		metadata  *core.Metadata
+		match     bool
+	}{
+		{"no clause, no metadata", nil, nil, true},
+		{"no clause, detected protocol", nil, withProtocol("mysql"), true},
+		{"detected protocol", []string{"mysql"}, withProtocol("mysql"), true},
+		{"one of several", []string{"smtp", "mysql"}, withProtocol("mysql"), true},
+		{"case insensitive", []string{"MySQL"}, withProtocol("mysql"), true},
+		{"other protocol", []string{"smtp"}, withProtocol("mysql"), false},
+		{"no metadata", []string{"mysql"}, nil, false},
+		{"other filter metadata", []string{"mysql"}, &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			"envoy.filters.http.jwt_authn": {Fields: map[string]*_struct.Value{
+				appProtocolMetadataKey: {Kind: &_struct.Value_StringValue{StringValue: "mysql"}},
+			}},
+		}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination:     &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{AppProtocols: tc.protocols}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The SrcIsLocalNode clause matches requests from the local node's address, but not from the addresses of the other
+// nodes that Felix sends host metadata for.
+func TestMatchSrcIsLocalNode(t *testing.T) {
+	testCases := []struct {
+		title     string
+		localNode bool
+		srcIP     string
+		match     bool
+	}{
+		{"no clause, node source", false, "192.168.0.1", true},
+		{"no clause, other source", false, "10.0.0.5", true},
+		{"node source", true, "192.168.0.1", true},
+		{"other source", true, "10.0.0.5", false},
+		{"remote node source", true, "192.168.0.2", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.LocalHostname = "node1"
+	store.NodeIPByHostname["node1"] = "192.168.0.1"
+	store.NodeIPByHostname["node2"] = "192.168.0.2"
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.srcIP},
+					}},
+				},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{SrcIsLocalNode: tc.localNode}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The destination service account match falls back to the service account of the destination endpoint in the store
+// when the request has no destination principal.
+func TestMatchDstServiceAccountFromStore(t *testing.T) {
+	testCases := []struct {
+		title string
+		names []string
+		dstIP string
+		match bool
+	}{
+		{"store endpoint", []string{"ham"}, "10.0.0.1", true},
+		{"store endpoint, other account", []string{"eggs"}, "10.0.0.1", false},
+		{"unknown endpoint", []string{"eggs"}, "10.0.0.2", true},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.EndpointByIP["10.0.0.1"] = &proto.WorkloadEndpoint{
+		Name:       "ham-pod",
+		ProfileIds: []string{"kns.sub", "ksa.sub.ham"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstServiceAccountMatch: &proto.ServiceAccountMatch{Names: tc.names}}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// Service account matches with trust domains only match peers whose SPIFFE ID was issued by one of the trust domains.
+func TestMatchServiceAccountTrustDomains(t *testing.T) {
+	testCases := []struct {
+		title        string
+		trustDomains []string
+		srcPrincipal string
+		match        bool
+	}{
+		{"no trust domains", nil, "spiffe://staging.example.com/ns/default/sa/web", true},
+		{"trust domain match", []string{"prod.example.com"}, "spiffe://prod.example.com/ns/default/sa/web", true},
+		{"trust domain mismatch", []string{"prod.example.com"}, "spiffe://staging.example.com/ns/default/sa/web", false},
+		{"one of several", []string{"a.example.com", "prod.example.com"}, "spiffe://prod.example.com/ns/default/sa/web", true},
+		{"case-insensitive", []string{"Prod.Example.com"}, "spiffe://prod.example.com/ns/default/sa/web", true},
+		{"plain text", []string{"prod.example.com"}, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source:      &auth.AttributeContext_Peer{Principal: tc.srcPrincipal},
+				Destination: &auth.AttributeContext_Peer{Principal: "spiffe://prod.example.com/ns/default/sa/db"},
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{SrcServiceAccountMatch: &proto.ServiceAccountMatch{
+				Names:        []string{"web"},
+				TrustDomains: tc.trustDomains,
+			}}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// A destination whose service account comes from its endpoint in the store has no trust domain, so it doesn't match a
+// service account match with trust domains.
+func TestMatchServiceAccountTrustDomainsFromStore(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.EndpointByIP["10.0.0.1"] = &proto.WorkloadEndpoint{
+		Name:       "ham-pod",
+		ProfileIds: []string{"kns.sub", "ksa.sub.ham"},
+	}
+	req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+		Destination: &auth.AttributeContext_Peer{
+			Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{Address: "10.0.0.1"},
+			}},
+		},
+	}}
+	reqCache, err := NewRequestCache(store, req)
+	Expect(err).To(Succeed())
+	rule := &proto.Rule{DstServiceAccountMatch: &proto.ServiceAccountMatch{Names: []string{"ham"}}}
+	Expect(match(rule, reqCache, "")).To(BeTrue())
+	rule.DstServiceAccountMatch.TrustDomains = []string{"cluster.local"}
+	Expect(match(rule, reqCache, "")).To(BeFalse())
+}
+
+// The JWT audiences clause matches if the token's "aud" claim, a string or a list, contains one of the audiences.
+func TestMatchJWTAudiences(t *testing.T) {
+	withAud := func(aud *_struct.Value) *core.Metadata {
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			jwtMetadataNamespace: {Fields: map[string]*_struct.Value{
+				jwtPayloadMetadataKey: {Kind: &_struct.Value_StructValue{StructValue: &_struct.Struct{
+					Fields: map[string]*_struct.Value{
+						"iss": {Kind: &_struct.Value_StringValue{StringValue: "https://issuer.example.com"}},
+						"aud": aud,
+					},
+				}}},
+			}},
+		}}
+	}
+	str := func(s string) *_struct.Value {
+		return &_struct.Value{Kind: &_struct.Value_StringValue{StringValue: s}}
+	}
+	list := func(ss ...string) *_struct.Value {
+		l := &_struct.ListValue{}
+		for _, s := range ss {
+			l.Values = append(l.Values, str(s))
+		}
+		return &_struct.Value{Kind: &_struct.Value_ListValue{ListValue: l}}
+	}
+	testCases := []struct {
+		title     string
+		audiences []string
+		metadata  *core.Metadata
+		match     bool
+	}{
+		{"no clause, no JWT", nil, nil, true},
+		{"string aud", []string{"api"}, withAud(str("api")), true},
+		{"string aud, no intersection", []string{"api"}, withAud(str("billing")), false},
+		{"array aud", []string{"api"}, withAud(list("billing", "api")), true},
+		{"array aud, one of several required", []string{"web", "billing"}, withAud(list("billing", "api")), true},
+		{"array aud, no intersection", []string{"web", "mobile"}, withAud(list("billing", "api")), false},
+		{"empty array aud", []string{"api"}, withAud(list()), false},
+		{"no aud", []string{"api"}, withAud(nil), false},
+		{"no JWT", []string{"api"}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination:     &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{JwtAudiences: tc.audiences}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The route names clause matches the name of the Envoy route, as passed in the request metadata.
+func TestMatchRouteNames(t *testing.T) {
+	withRoute := func(name string) *core.Metadata {
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			routeMetadataNamespace: {Fields: map[string]*_struct.Value{
+				routeNameMetadataKey: {Kind: &_struct.Value_StringValue{StringValue: name}},
+			}},
+		}}
+	}
+	testCases := []struct {
+		title    string
+		names    []string
+		metadata *core.Metadata
+		match    bool
+	}{
+		{"no clause, no metadata", nil, nil, true},
+		{"no clause, route name", nil, withRoute("payments-v1"), true},
+		{"route name", []string{"payments-v1"}, withRoute("payments-v1"), true},
+		{"one of several", []string{"payments-v2", "payments-v1"}, withRoute("payments-v1"), true},
+		{"other route", []string{"payments-v2"}, withRoute("payments-v1"), false},
+		{"no metadata", []string{"payments-v1"}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination:     &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{RouteNames: tc.names}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The response clauses match the outcome of a completed request, which Envoy passes in the metadata of checks made in
+// the response phase.
+func TestMatchResponse(t *testing.T) {
+	completed := func(code, durationMs float64) *core.Metadata {
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			responseMetadataNamespace: {Fields: map[string]*_struct.Value{
+				responseCodeMetadataKey:       {Kind: &_struct.Value_NumberValue{NumberValue: code}},
+				responseDurationMsMetadataKey: {Kind: &_struct.Value_NumberValue{NumberValue: durationMs}},
+			}},
+		}}
+	}
+	testCases := []struct {
+		title         string
+		match         *proto.HTTPMatch
+		responsePhase bool
+		metadata      *core.Metadata
+		matched       bool
+	}{
+		{"no clause, request phase", &proto.HTTPMatch{}, false, nil, true},
+		{"response code", &proto.HTTPMatch{ResponseCodes: []uint32{500, 503}}, true, completed(503, 20), true},
+		{"other response code", &proto.HTTPMatch{ResponseCodes: []uint32{500, 503}}, true, completed(200, 20), false},
+		{"slow request", &proto.HTTPMatch{MinDurationMs: 1000}, true, completed(200, 1500), true},
+		{"fast request", &proto.HTTPMatch{MinDurationMs: 1000}, true, completed(200, 20), false},
+		{"within max duration", &proto.HTTPMatch{MaxDurationMs: 100}, true, completed(200, 100), true},
+		{"over max duration", &proto.HTTPMatch{MaxDurationMs: 100}, true, completed(200, 101), false},
+		{
+			"code and duration",
+			&proto.HTTPMatch{ResponseCodes: []uint32{504}, MinDurationMs: 1000},
+			true, completed(504, 30000), true,
+		},
+		{"request phase", &proto.HTTPMatch{ResponseCodes: []uint32{200}}, false, completed(200, 20), false},
+		{"no outcome metadata", &proto.HTTPMatch{ResponseCodes: []uint32{200}}, true, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Request: &auth.AttributeContext_Request{
+					Http: &auth.AttributeContext_HttpRequest{Method: "GET", Path: "/"},
+				},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			reqCache.config.responsePhase = tc.responsePhase
+			rule := &proto.Rule{HttpMatch: tc.match}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.matched))
+		})
+	}
+}
+
+// Outside the response phase, the response clauses are unknown, so they're resolved by the unknown clause behavior.
+func TestMatchResponseUnknown(t *testing.T) {
+	RegisterTestingT(t)
+
+	req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+		Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+		Request: &auth.AttributeContext_Request{
+			Http: &auth.AttributeContext_HttpRequest{Method: "GET", Path: "/"},
+		},
+	}}
+	reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+	Expect(err).To(Succeed())
+	reqCache.config.unknownClauseBehavior = UnknownClauseFailClosed
+	deny := &proto.Rule{Action: "Deny", HttpMatch: &proto.HTTPMatch{ResponseCodes: []uint32{500}}}
+	allow := &proto.Rule{Action: "Allow", HttpMatch: &proto.HTTPMatch{ResponseCodes: []uint32{200}}}
+	Expect(match(deny, reqCache, "")).To(BeTrue())
+	Expect(match(allow, reqCache, "")).To(BeFalse())
+}
+
+// The connection reused clause matches requests on connections for which Envoy reused an earlier decision.  If Envoy
+// doesn't say, the clause is unknown.
+func TestMatchConnectionReused(t *testing.T) {
+	request := func(md map[string]*_struct.Struct) *auth.CheckRequest {
+		return &auth.CheckRequest{Attributes: &auth.AttributeContext{
+			Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			Request: &auth.AttributeContext_Request{
+				Http: &auth.AttributeContext_HttpRequest{Method: "GET", Path: "/"},
+			},
+			MetadataContext: &core.Metadata{FilterMetadata: md},
+		}}
+	}
+	reuse := func(reused bool) map[string]*_struct.Struct {
+		return map[string]*_struct.Struct{connectionMetadataNamespace: {Fields: map[string]*_struct.Value{
+			connectionReusedMetadataKey: {Kind: &_struct.Value_BoolValue{BoolValue: reused}},
+		}}}
+	}
+
+	testCases := []struct {
+		title      string
+		rule       *proto.Rule
+		md         map[string]*_struct.Struct
+		noMatch    bool
+		failClosed bool
+	}{
+		{"no clause, reused", &proto.Rule{Action: "Allow"}, reuse(true), true, true},
+		{"no clause, indicator unset", &proto.Rule{Action: "Allow"}, nil, true, true},
+		{"reused", &proto.Rule{Action: "Allow", ConnectionReused: true}, reuse(true), true, true},
+		{"not reused", &proto.Rule{Action: "Allow", ConnectionReused: true}, reuse(false), false, false},
+		{"indicator unset", &proto.Rule{Action: "Allow", ConnectionReused: true}, nil, false, false},
+		{"indicator not a bool", &proto.Rule{Action: "Allow", ConnectionReused: true},
+			map[string]*_struct.Struct{connectionMetadataNamespace: {Fields: map[string]*_struct.Value{
+				connectionReusedMetadataKey: {Kind: &_struct.Value_StringValue{StringValue: "true"}},
+			}}}, false, false},
+		{"deny, indicator unset", &proto.Rule{Action: "Deny", ConnectionReused: true}, nil, false, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			for behavior, expected := range map[UnknownClauseBehavior]bool{
+				UnknownClauseNoMatch:    tc.noMatch,
+				UnknownClauseFailClosed: tc.failClosed,
+			} {
+				reqCache, err := NewRequestCache(policystore.NewPolicyStore(), request(tc.md))
+				Expect(err).To(Succeed())
+				reqCache.config.unknownClauseBehavior = behavior
+				Expect(match(tc.rule, reqCache, "")).To(Equal(expected), string(behavior))
+			}
+		})
+	}
+}
+
+// The ICMP clauses match the type and code of ICMP flows, which are passed in the request's metadata.
+func TestMatchICMP(t *testing.T) {
+	icmpFlow := func(dstIP string, icmpType, icmpCode float64) *auth.CheckRequest {
+		return &auth.CheckRequest{Attributes: &auth.AttributeContext{
+			Destination: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{Address: dstIP},
+			}}},
+			MetadataContext: &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+				icmpMetadataNamespace: {Fields: map[string]*_struct.Value{
+					icmpTypeMetadataKey: {Kind: &_struct.Value_NumberValue{NumberValue: icmpType}},
+					icmpCodeMetadataKey: {Kind: &_struct.Value_NumberValue{NumberValue: icmpCode}},
+				}},
+			}},
+		}}
+	}
+	echoRequest := icmpFlow("10.0.0.2", 8, 0)
+	portUnreachable := icmpFlow("10.0.0.2", 3, 3)
+	echoRequestV6 := icmpFlow("fd00::2", 128, 0)
+	tcpFlow := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+		Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+	}}
+
+	testCases := []struct {
+		title string
+		rule  *proto.Rule
+		req   *auth.CheckRequest
+		match bool
+	}{
+		{"ICMP protocol", &proto.Rule{Protocol: protocolNumber(1)}, echoRequest, true},
+		{"ICMP protocol name", &proto.Rule{Protocol: protocolName("ICMP")}, echoRequest, true},
+		{"ICMPv6 flow, ICMP protocol", &proto.Rule{Protocol: protocolNumber(1)}, echoRequestV6, false},
+		{"ICMPv6 protocol", &proto.Rule{Protocol: protocolNumber(58)}, echoRequestV6, true},
+		{"TCP protocol", &proto.Rule{Protocol: protocolNumber(6)}, echoRequest, false},
+		{"not ICMP protocol", &proto.Rule{NotProtocol: protocolNumber(1)}, echoRequest, false},
+		{
+			"type, any code",
+			&proto.Rule{Protocol: protocolNumber(1), Icmp: &proto.Rule_IcmpType{IcmpType: 3}},
+			portUnreachable, true,
+		},
+		{
+			"other type",
+			&proto.Rule{Protocol: protocolNumber(1), Icmp: &proto.Rule_IcmpType{IcmpType: 3}},
+			echoRequest, false,
+		},
+		{
+			"type and code",
+			&proto.Rule{Icmp: &proto.Rule_IcmpTypeCode{IcmpTypeCode: &proto.IcmpTypeAndCode{Type: 3, Code: 3}}},
+			portUnreachable, true,
+		},
+		{
+			"type and other code",
+			&proto.Rule{Icmp: &proto.Rule_IcmpTypeCode{IcmpTypeCode: &proto.IcmpTypeAndCode{Type: 3, Code: 1}}},
+			portUnreachable, false,
+		},
+		{"not type", &proto.Rule{NotIcmp: &proto.Rule_NotIcmpType{NotIcmpType: 8}}, echoRequest, false},
+		{"not other type", &proto.Rule{NotIcmp: &proto.Rule_NotIcmpType{NotIcmpType: 8}}, portUnreachable, true},
+		{
+			"not type and code",
+			&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypeCode{NotIcmpTypeCode: &proto.IcmpTypeAndCode{Type: 3, Code: 3}}},
+			portUnreachable, false,
+		},
+		{
+			"not type and other code",
+			&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypeCode{NotIcmpTypeCode: &proto.IcmpTypeAndCode{Type: 3, Code: 1}}},
+			portUnreachable, true,
+		},
+		{
+			"type and not code",
+			&proto.Rule{
+				Icmp:    &proto.Rule_IcmpType{IcmpType: 3},
+				NotIcmp: &proto.Rule_NotIcmpTypeCode{NotIcmpTypeCode: &proto.IcmpTypeAndCode{Type: 3, Code: 3}},
+			},
+			portUnreachable, false,
+		},
+		{"non-ICMP flow, type", &proto.Rule{Icmp: &proto.Rule_IcmpType{IcmpType: 8}}, tcpFlow, true},
+		{"non-ICMP flow, not type", &proto.Rule{NotIcmp: &proto.Rule_NotIcmpType{NotIcmpType: 8}}, tcpFlow, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), tc.req)
+			Expect(err).To(Succeed())
+			Expect(match(tc.rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The TLS fingerprint clauses match the JA3 or JA4 fingerprint that Envoy passes in the request's metadata.
+func TestMatchTLSFingerprints(t *testing.T) {
+	const (
+		ja3      = "771,4865-4866-4867,0-23-65281,29-23-24,0"
+		ja3Other = "769,47-53-5-10,0-10-11,23-24-25,0"
+		ja4      = "t13d1516h2_8daaf6152771_b186095e22b6"
+	)
+	withFingerprints := func(ja3, ja4 string) *core.Metadata {
+		fields := map[string]*_struct.Value{}
+		if ja3 != "" {
+			fields[tlsFingerprintJA3MetadataKey] = &_struct.Value{Kind: &_struct.Value_StringValue{StringValue: ja3}}
+		}
+		if ja4 != "" {
+			fields[tlsFingerprintJA4MetadataKey] = &_struct.Value{Kind: &_struct.Value_StringValue{StringValue: ja4}}
+		}
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			tlsFingerprintMetadataNamespace: {Fields: fields},
+		}}
+	}
+	testCases := []struct {
+		title           string
+		fingerprints    []string
+		notFingerprints []string
+		metadata        *core.Metadata
+		match           bool
+	}{
+		{"no clause, no metadata", nil, nil, nil, true},
+		{"no clause, fingerprint", nil, nil, withFingerprints(ja3, ja4), true},
+		{"ja3 allowed", []string{ja3}, nil, withFingerprints(ja3, ""), true},
+		{"ja4 allowed", []string{ja4}, nil, withFingerprints(ja3, ja4), true},
+		{"ja4 case-insensitive", []string{strings.ToUpper(ja4)}, nil, withFingerprints("", ja4), true},
+		{"one of several", []string{ja3Other, ja3}, nil, withFingerprints(ja3, ""), true},
+		{"not allowed", []string{ja3Other}, nil, withFingerprints(ja3, ja4), false},
+		{"allowed, no metadata", []string{ja3}, nil, nil, false},
+		{"denied", nil, []string{ja3}, withFingerprints(ja3, ja4), false},
+		{"not denied", nil, []string{ja3Other}, withFingerprints(ja3, ja4), true},
+		{"denied, no metadata", nil, []string{ja3Other}, nil, false},
+		{"allowed and denied", []string{ja4}, []string{ja3}, withFingerprints(ja3, ja4), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination:     &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{TlsFingerprints: tc.fingerprints, NotTlsFingerprints: tc.notFingerprints}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The gRPC call type clause distinguishes unary calls from streaming ones, and matches no request that isn't gRPC.
+func TestMatchGRPCCallTypes(t *testing.T) {
+	streaming := func(s bool) *core.Metadata {
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			grpcMetadataNamespace: {Fields: map[string]*_struct.Value{
+				grpcStreamingMetadataKey: {Kind: &_struct.Value_BoolValue{BoolValue: s}},
+			}},
+		}}
+	}
+	testCases := []struct {
+		title       string
+		types       []string
+		contentType string
+		metadata    *core.Metadata
+		match       bool
+	}{
+		{"no clause, not gRPC", nil, "application/json", nil, true},
+		{"unary", []string{"Unary"}, "application/grpc", nil, true},
+		{"unary, explicit indicator", []string{"Unary"}, "application/grpc", streaming(false), true},
+		{"unary, content type variant", []string{"unary"}, "application/grpc+proto", nil, true},
+		{"unary, not streaming", []string{"Streaming"}, "application/grpc", nil, false},
+		{"streaming", []string{"Streaming"}, "application/grpc", streaming(true), true},
+		{"streaming, not unary", []string{"Unary"}, "application/grpc", streaming(true), false},
+		{"either", []string{"Unary", "Streaming"}, "application/grpc", streaming(true), true},
+		{"not gRPC", []string{"Unary"}, "application/json", nil, false},
+		{"gRPC-web is not gRPC", []string{"Unary"}, "application/grpc-web", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Request: &auth.AttributeContext_Request{Http: &auth.AttributeContext_HttpRequest{
+					Method:  "POST",
+					Headers: map[string]string{"content-type": tc.contentType},
+				}},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{GrpcCallTypes: tc.types}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The gRPC match restricts gRPC calls by the service and method in their path, and ignores other requests.
+func TestMatchGRPC(t *testing.T) {
+	greeter := &proto.GrpcMatch{Services: []string{"helloworld.Greeter"}, Methods: []string{"SayHello", "SayHelloStream"}}
+	testCases := []struct {
+		title       string
+		grpcMatch   *proto.GrpcMatch
+		contentType string
+		path        string
+		metadata    *core.Metadata
+		match       bool
+	}{
+		{"no clause", nil, "application/grpc", "/helloworld.Greeter/SayHello", nil, true},
+		{"fully-qualified", greeter, "application/grpc", "/helloworld.Greeter/SayHello", nil, true},
+		{"unqualified service", greeter, "application/grpc", "/Greeter/SayHello", nil, false},
+		{"other service", greeter, "application/grpc", "/helloworld.Farewell/SayHello", nil, false},
+		{"other method", greeter, "application/grpc", "/helloworld.Greeter/SayGoodbye", nil, false},
+		{"streaming", greeter, "application/grpc+proto", "/helloworld.Greeter/SayHelloStream", &core.Metadata{
+			FilterMetadata: map[string]*_struct.Struct{grpcMetadataNamespace: {Fields: map[string]*_struct.Value{
+				grpcStreamingMetadataKey: {Kind: &_struct.Value_BoolValue{BoolValue: true}},
+			}}},
+		}, true},
+		{"any method", &proto.GrpcMatch{Services: []string{"helloworld.Greeter"}}, "application/grpc", "/helloworld.Greeter/Anything", nil, true},
+		{"any service", &proto.GrpcMatch{Methods: []string{"Check"}}, "application/grpc", "/grpc.health.v1.Health/Check", nil, true},
+		{"malformed, no method", greeter, "application/grpc", "/helloworld.Greeter", nil, false},
+		{"malformed, empty method", greeter, "application/grpc", "/helloworld.Greeter/", nil, false},
+		{"malformed, extra segment", greeter, "application/grpc", "/helloworld.Greeter/SayHello/x", nil, false},
+		{"malformed, empty service", greeter, "application/grpc", "//SayHello", nil, false},
+		{"not gRPC", greeter, "application/json", "/api/users", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Request: &auth.AttributeContext_Request{Http: &auth.AttributeContext_HttpRequest{
+					Method:  "POST",
+					Path:    tc.path,
+					Headers: map[string]string{"content-type": tc.contentType},
+				}},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{GrpcMatch: tc.grpcMatch}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The gRPC match falls back to the :path pseudo-header if the request's path isn't set.
+func TestMatchGRPCPathHeader(t *testing.T) {
+	RegisterTestingT(t)
+	req := &auth.AttributeContext_HttpRequest{Headers: map[string]string{
+		"content-type": "application/grpc",
+		":path":        "/helloworld.Greeter/SayHello",
+	}}
+	Expect(matchGRPC(&proto.GrpcMatch{Services: []string{"helloworld.Greeter"}}, req)).To(BeTrue())
+	Expect(matchGRPC(&proto.GrpcMatch{Services: []string{"helloworld.Farewell"}}, req)).To(BeFalse())
+}
+
+// The request label selector is evaluated against the request-scoped labels that Envoy passes in the metadata.
+func TestMatchRequestLabels(t *testing.T) {
+	labels := func(kv map[string]string) *core.Metadata {
+		fields := map[string]*_struct.Value{}
+		for k, v := range kv {
+			fields[k] = &_struct.Value{Kind: &_struct.Value_StringValue{StringValue: v}}
+		}
+		return &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			requestLabelsMetadataNamespace: {Fields: fields},
+		}}
+	}
+	testCases := []struct {
+		title    string
+		selector string
+		metadata *core.Metadata
+		match    bool
+	}{
+		{"no clause, no labels", "", nil, true},
+		{"equality", "cohort == 'beta'", labels(map[string]string{"cohort": "beta"}), true},
+		{"equality, different value", "cohort == 'beta'", labels(map[string]string{"cohort": "stable"}), false},
+		{"has", "has(canary)", labels(map[string]string{"canary": "", "cohort": "beta"}), true},
+		{"not has", "!has(canary)", labels(map[string]string{"cohort": "beta"}), true},
+		{"set membership", "cohort in {'beta', 'alpha'}", labels(map[string]string{"cohort": "alpha"}), true},
+		{"no labels", "cohort == 'beta'", nil, false},
+		{"bad selector", "cohort ==", labels(map[string]string{"cohort": "beta"}), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination:     &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				MetadataContext: tc.metadata,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{RequestLabelSelector: tc.selector}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// A clause whose label selector fails to compile matches only when failing open, and each evaluation is counted.
+func TestMatchBadSelectorFailureBehavior(t *testing.T) {
+	RegisterTestingT(t)
+	defer SetSelectorFailureBehavior(SelectorFailClosed)
+
+	_, err := ParseSelectorFailureBehavior("sideways")
+	Expect(err).To(HaveOccurred())
+	Expect(ParseSelectorFailureBehavior("Fail-Open")).To(Equal(SelectorFailOpen))
+
+	req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+		Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+		MetadataContext: &core.Metadata{FilterMetadata: map[string]*_struct.Struct{
+			requestLabelsMetadataNamespace: {Fields: map[string]*_struct.Value{
+				"cohort": {Kind: &_struct.Value_StringValue{StringValue: "beta"}},
+			}},
+		}},
+	}}
+	reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+	Expect(err).To(Succeed())
+	allow := &proto.Rule{Action: "allow", RequestLabelSelector: "cohort =="}
+	failures := testutil.ToFloat64(selectorCompileFailures)
+
+	for _, tc := range []struct {
+		behavior SelectorFailureBehavior
+		match    bool
+	}{
+		{SelectorFailClosed, false},
+		{SelectorFailOpen, true},
+	} {
+		SetSelectorFailureBehavior(tc.behavior)
+		Expect(matchLabels("not.a.real.selector", map[string]string{"app": "foo"})).To(Equal(tc.match), string(tc.behavior))
+		Expect(match(allow, reqCache, "")).To(Equal(tc.match), string(tc.behavior))
+		// A good selector is unaffected.
+		Expect(matchLabels("app == 'bar'", map[string]string{"app": "foo"})).To(BeFalse(), string(tc.behavior))
+	}
+	Expect(testutil.ToFloat64(selectorCompileFailures) - failures).To(Equal(4.0))
+}
+
+// Clauses that need attributes missing from the request are unknown, and the configured UnknownClauseBehavior decides
+// whether a rule with an unknown clause matches.
+func TestMatchUnknownClauses(t *testing.T) {
+	tcp := &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "TCP"}}
+	udp := &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "UDP"}}
+	testCases := []struct {
+		title      string
+		rule       *proto.Rule
+		noDest     bool
+		noMatch    bool
+		match      bool
+		failClosed bool
+	}{
+		{"no clauses", &proto.Rule{Action: "allow"}, false, true, true, true},
+		{"HTTP, no HTTP attributes",
+			&proto.Rule{Action: "allow", HttpMatch: &proto.HTTPMatch{Methods: []string{"GET"}}}, false, false, true, false},
+		{"HTTP deny, no HTTP attributes",
+			&proto.Rule{Action: "deny", HttpMatch: &proto.HTTPMatch{Methods: []string{"GET"}}}, false, false, true, true},
+		{"app protocol, not detected", &proto.Rule{Action: "allow", AppProtocols: []string{"mysql"}}, false, false, true, false},
+		{"JWT audiences, no JWT", &proto.Rule{Action: "deny", JwtAudiences: []string{"api"}}, false, false, true, true},
+		{"route names, no route", &proto.Rule{Action: "allow", RouteNames: []string{"r1"}}, false, false, true, false},
+		{"request labels, no labels",
+			&proto.Rule{Action: "allow", RequestLabelSelector: "cohort == 'beta'"}, false, false, true, false},
+		{"gRPC call types, no HTTP attributes",
+			&proto.Rule{Action: "deny", GrpcCallTypes: []string{"Unary"}}, false, false, true, true},
+		{"protocol, no destination", &proto.Rule{Action: "allow", Protocol: tcp}, true, false, true, false},
+		{"no protocol, no destination", &proto.Rule{Action: "allow"}, true, true, true, true},
+		{"unknown and known mismatch",
+			&proto.Rule{Action: "deny", Protocol: udp, RouteNames: []string{"r1"}}, false, false, false, false},
+		{"unknown and known match",
+			&proto.Rule{Action: "deny", Protocol: tcp, RouteNames: []string{"r1"}}, false, false, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			attrs := &auth.AttributeContext{}
+			if !tc.noDest {
+				attrs.Destination = &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP}
+			}
+			req := &auth.CheckRequest{Attributes: attrs}
+			for behavior, expected := range map[UnknownClauseBehavior]bool{
+				"":                      tc.noMatch,
+				UnknownClauseNoMatch:    tc.noMatch,
+				UnknownClauseMatch:      tc.match,
+				UnknownClauseFailClosed: tc.failClosed,
+			} {
+				reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+				Expect(err).To(Succeed())
+				reqCache.config.unknownClauseBehavior = behavior
+				Expect(match(tc.rule, reqCache, "")).To(Equal(expected), fmt.Sprintf("behavior %q", behavior))
+			}
+		})
+	}
+}
+
+// The IP set recency clauses match addresses that were added to one of the rule's IP sets within the window.
+func TestMatchIPSetAddedWithin(t *testing.T) {
+	testCases := []struct {
+		title string
+		rule  *proto.Rule
+		match bool
+	}{
+		{"no clause", &proto.Rule{SrcIpSetIds: []string{"quarantine"}}, true},
+		{"src recently added", &proto.Rule{SrcIpSetIds: []string{"quarantine"}, SrcIpSetAddedWithinSecs: 300}, true},
+		{"src added too long ago", &proto.Rule{SrcIpSetIds: []string{"quarantine"}, SrcIpSetAddedWithinSecs: 30}, false},
+		{"src recently added to one of several",
+			&proto.Rule{SrcIpSetIds: []string{"all", "quarantine"}, SrcIpSetAddedWithinSecs: 300}, true},
+		{"src only in an old set", &proto.Rule{SrcIpSetIds: []string{"all"}, SrcIpSetAddedWithinSecs: 300}, false},
+		{"src with no IP sets", &proto.Rule{SrcIpSetAddedWithinSecs: 300}, false},
+		{"dst recently added", &proto.Rule{DstIpSetIds: []string{"dst-recent"}, DstIpSetAddedWithinSecs: 300}, true},
+		{"dst added too long ago", &proto.Rule{DstIpSetIds: []string{"dst-old"}, DstIpSetAddedWithinSecs: 300}, false},
+		{"untimed set", &proto.Rule{DstIpSetIds: []string{"untimed"}, DstIpSetAddedWithinSecs: 300}, false},
+	}
+
+	now := time.Now()
+	clock := now
+	timedSet := func(member string, age time.Duration) policystore.IPSet {
+		clock = now.Add(-age)
+		s := policystore.NewTimedIPSet(proto.IPSetUpdate_IP, func() time.Time { return clock })
+		s.AddString(member)
+		return s
+	}
+	store := policystore.NewPolicyStore()
+	store.IPSetByID["quarantine"] = timedSet("10.0.0.1", time.Minute)
+	store.IPSetByID["all"] = timedSet("10.0.0.1", time.Hour)
+	store.IPSetByID["dst-recent"] = timedSet("10.0.0.2", time.Minute)
+	store.IPSetByID["dst-old"] = timedSet("10.0.0.2", time.Hour)
+	store.IPSetByID["untimed"] = policystore.NewIPSet(proto.IPSetUpdate_IP)
+	store.IPSetByID["untimed"].AddString("10.0.0.2")
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{Address: "10.0.0.1"},
+				}}},
+				Destination: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{Address: "10.0.0.2"},
+				}}},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			Expect(match(tc.rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The cardinality clause matches sources in one of the rule's source IP sets that has more than the threshold number
+// of members.
+func TestMatchIPSetCardinalityAbove(t *testing.T) {
+	testCases := []struct {
+		title string
+		rule  *proto.Rule
+		match bool
+	}{
+		{"no clause", &proto.Rule{SrcIpSetIds: []string{"small"}}, true},
+		{"set over threshold", &proto.Rule{SrcIpSetIds: []string{"large"}, SrcIpSetCardinalityAbove: 3}, true},
+		{"set at threshold", &proto.Rule{SrcIpSetIds: []string{"large"}, SrcIpSetCardinalityAbove: 4}, false},
+		{"set under threshold", &proto.Rule{SrcIpSetIds: []string{"small"}, SrcIpSetCardinalityAbove: 3}, false},
+		{"one of several over threshold",
+			&proto.Rule{SrcIpSetIds: []string{"small", "large"}, SrcIpSetCardinalityAbove: 3}, true},
+		{"net set over threshold", &proto.Rule{SrcIpSetIds: []string{"nets"}, SrcIpSetCardinalityAbove: 2}, true},
+		{"source not in large set", &proto.Rule{SrcIpSetIds: []string{"others"}, SrcIpSetCardinalityAbove: 3}, false},
+		{"no IP sets", &proto.Rule{SrcIpSetCardinalityAbove: 3}, false},
+	}
+
+	ipSet := func(t proto.IPSetUpdate_IPSetType, members ...string) policystore.IPSet {
+		s := policystore.NewIPSet(t)
+		for _, m := range members {
+			s.AddString(m)
+		}
+		return s
+	}
+	store := policystore.NewPolicyStore()
+	store.IPSetByID["small"] = ipSet(proto.IPSetUpdate_IP, "10.0.0.1", "10.0.0.2")
+	store.IPSetByID["large"] = ipSet(proto.IPSetUpdate_IP, "10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4")
+	store.IPSetByID["nets"] = ipSet(proto.IPSetUpdate_NET, "10.0.0.1/32", "10.0.1.0/24", "10.0.2.0/24")
+	store.IPSetByID["others"] = ipSet(proto.IPSetUpdate_IP, "10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4")
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{Address: "10.0.0.1"},
+				}}},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			Expect(match(tc.rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+func TestMatchDstIPPortSets(t *testing.T) {
+	testCases := []struct {
+		title string
+		rule  *proto.Rule
+		addr  string
+		port  uint32
+		match bool
+	}{
+		{"no clause", &proto.Rule{}, "10.0.0.1", 80, true},
+		{"in set", &proto.Rule{DstIpPortSetIds: []string{"web"}}, "10.0.0.1", 80, true},
+		{"port not in set", &proto.Rule{DstIpPortSetIds: []string{"web"}}, "10.0.0.1", 443, false},
+		{"IP not in set", &proto.Rule{DstIpPortSetIds: []string{"web"}}, "10.0.0.9", 80, false},
+		{"UDP member doesn't match TCP", &proto.Rule{DstIpPortSetIds: []string{"dns"}}, "10.0.0.2", 53, false},
+		{"in all sets", &proto.Rule{DstIpPortSetIds: []string{"web", "tls"}}, "10.0.0.1", 80, false},
+		{"not in negated set", &proto.Rule{NotDstIpPortSetIds: []string{"web"}}, "10.0.0.1", 443, true},
+		{"in negated set", &proto.Rule{NotDstIpPortSetIds: []string{"web"}}, "10.0.0.1", 80, false},
+		{"in one of several negated sets", &proto.Rule{NotDstIpPortSetIds: []string{"tls", "web"}}, "10.0.0.1", 80, false},
+		{"UDP negated member doesn't match TCP", &proto.Rule{NotDstIpPortSetIds: []string{"dns"}}, "10.0.0.2", 53, true},
+		{"in set and not in negated set",
+			&proto.Rule{DstIpPortSetIds: []string{"web"}, NotDstIpPortSetIds: []string{"tls"}}, "10.0.0.1", 80, true},
+		{"in set and negated set",
+			&proto.Rule{DstIpPortSetIds: []string{"web"}, NotDstIpPortSetIds: []string{"web"}}, "10.0.0.1", 80, false},
+	}
+
+	store := policystore.NewPolicyStore()
+	for id, members := range map[string][]string{
+		"web": {"10.0.0.1,tcp:80", "10.0.0.2,tcp:80"},
+		"tls": {"10.0.0.1,tcp:443"},
+		"dns": {"10.0.0.2,udp:53"},
+	} {
+		s := policystore.NewIPSet(proto.IPSetUpdate_IP_AND_PORT)
+		for _, m := range members {
+			s.AddString(m)
+		}
+		store.IPSetByID[id] = s
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Destination: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{
+						Address:       tc.addr,
+						Protocol:      core.SocketAddress_TCP,
+						PortSpecifier: &core.SocketAddress_PortValue{PortValue: tc.port},
+					},
+				}}},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			Expect(match(tc.rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// Source IP/port sets use the same member format as destination ones, built from the source address.
+func TestMatchSrcIPPortSets(t *testing.T) {
+	testCases := []struct {
+		title string
+		rule  *proto.Rule
+		addr  string
+		port  uint32
+		match bool
+	}{
+		{"no clause", &proto.Rule{}, "10.0.0.1", 80, true},
+		{"in set", &proto.Rule{SrcIpPortSetIds: []string{"web"}}, "10.0.0.1", 80, true},
+		{"port not in set", &proto.Rule{SrcIpPortSetIds: []string{"web"}}, "10.0.0.1", 443, false},
+		{"IP not in set", &proto.Rule{SrcIpPortSetIds: []string{"web"}}, "10.0.0.9", 80, false},
+		{"UDP member doesn't match TCP", &proto.Rule{SrcIpPortSetIds: []string{"dns"}}, "10.0.0.2", 53, false},
+		{"in all sets", &proto.Rule{SrcIpPortSetIds: []string{"web", "tls"}}, "10.0.0.1", 80, false},
+		{"not in negated set", &proto.Rule{NotSrcIpPortSetIds: []string{"web"}}, "10.0.0.1", 443, true},
+		{"in negated set", &proto.Rule{NotSrcIpPortSetIds: []string{"web"}}, "10.0.0.1", 80, false},
+		{"in one of several negated sets", &proto.Rule{NotSrcIpPortSetIds: []string{"tls", "web"}}, "10.0.0.1", 80, false},
+		{"UDP negated member doesn't match TCP", &proto.Rule{NotSrcIpPortSetIds: []string{"dns"}}, "10.0.0.2", 53, true},
+		{"in set and not in negated set",
+			&proto.Rule{SrcIpPortSetIds: []string{"web"}, NotSrcIpPortSetIds: []string{"tls"}}, "10.0.0.1", 80, true},
+		{"in set and negated set",
+			&proto.Rule{SrcIpPortSetIds: []string{"web"}, NotSrcIpPortSetIds: []string{"web"}}, "10.0.0.1", 80, false},
+	}
+
+	store := policystore.NewPolicyStore()
+	for id, members := range map[string][]string{
+		"web": {"10.0.0.1,tcp:80", "10.0.0.2,tcp:80"},
+		"tls": {"10.0.0.1,tcp:443"},
+		"dns": {"10.0.0.2,udp:53"},
+	} {
+		s := policystore.NewIPSet(proto.IPSetUpdate_IP_AND_PORT)
+		for _, m := range members {
+			s.AddString(m)
+		}
+		store.IPSetByID[id] = s
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{
+						Address:       tc.addr,
+						Protocol:      core.SocketAddress_TCP,
+						PortSpecifier: &core.SocketAddress_PortValue{PortValue: tc.port},
+					},
+				}}},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			Expect(match(tc.rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The TLS terminated clause matches connections on which Envoy terminated TLS, not those it passed through.
+func TestMatchTLSTerminated(t *testing.T) {
+	testCases := []struct {
+		title      string
+		terminated bool
+		tlsSession *auth.AttributeContext_TLSSession
+		principal  string
+		match      bool
+	}{
+		{"no clause, passthrough", false, nil, "", true},
+		{"no clause, terminated", false, &auth.AttributeContext_TLSSession{Sni: "web.example.com"}, "", true},
+		{"terminated with TLS session", true, &auth.AttributeContext_TLSSession{Sni: "web.example.com"}, "", true},
+		{"terminated with mTLS", true, nil, "spiffe://cluster.local/ns/default/sa/web", true},
+		{"passthrough", true, nil, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP, Principal: tc.principal},
+				TlsSession:  tc.tlsSession,
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{TlsTerminated: tc.terminated}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The source IP pools clause matches sources within one of the named pools in the store.
+func TestMatchSrcIPPools(t *testing.T) {
+	testCases := []struct {
+		title string
+		pools []string
+		srcIP string
+		match bool
+	}{
+		{"no clause", nil, "192.168.0.1", true},
+		{"in pool", []string{"pool-a"}, "10.10.1.1", true},
+		{"in one of several pools", []string{"pool-b", "pool-a"}, "10.10.1.1", true},
+		{"in other pool", []string{"pool-b"}, "10.10.1.1", false},
+		{"in no pool", []string{"pool-a", "pool-b"}, "192.168.0.1", false},
+		{"unknown pool", []string{"pool-c"}, "10.10.1.1", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.IPPoolByID["10.10.0.0-16"] = &proto.IPAMPool{Name: "pool-a", Cidr: "10.10.0.0/16"}
+	store.IPPoolByID["10.20.0.0-16"] = &proto.IPAMPool{Name: "pool-b", Cidr: "10.20.0.0/16"}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.srcIP},
+					}},
+				},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{SrcIpPools: tc.pools}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+func TestMatchDstServicePorts(t *testing.T) {
+	testCases := []struct {
+		title    string
+		names    []string
+		dstIP    string
+		port     uint32
+		protocol core.SocketAddress_Protocol
+		match    bool
+	}{
+		{"no clause", nil, "192.168.0.1", 443, core.SocketAddress_TCP, true},
+		{"cluster IP", []string{"https"}, "10.96.0.10", 443, core.SocketAddress_TCP, true},
+		{"external IP", []string{"https"}, "1.2.3.4", 443, core.SocketAddress_TCP, true},
+		{"one of several names", []string{"grpc", "http"}, "10.96.0.10", 80, core.SocketAddress_TCP, true},
+		{"other port name", []string{"http"}, "10.96.0.10", 443, core.SocketAddress_TCP, false},
+		{"other protocol", []string{"https"}, "10.96.0.10", 443, core.SocketAddress_UDP, false},
+		{"unnamed port", []string{"https"}, "10.96.0.10", 8080, core.SocketAddress_TCP, false},
+		{"not a service IP", []string{"https"}, "192.168.0.1", 443, core.SocketAddress_TCP, false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.ServiceByID["default/web"] = &proto.ServiceUpdate{
+		Name:        "web",
+		Namespace:   "default",
+		ClusterIp:   "10.96.0.10",
+		ExternalIps: []string{"1.2.3.4"},
+		Ports: []*proto.ServicePort{
+			{Name: "https", Protocol: "TCP", Port: 443},
+			{Name: "http", Protocol: "TCP", Port: 80},
+			{Protocol: "TCP", Port: 8080},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{
+							Address:       tc.dstIP,
+							Protocol:      tc.protocol,
+							PortSpecifier: &core.SocketAddress_PortValue{PortValue: tc.port},
+						},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstServicePorts: tc.names}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The destination ready clause requires a destination endpoint that is reported active.
+func TestMatchDstReady(t *testing.T) {
+	testCases := []struct {
+		title string
+		ready bool
+		dstIP string
+		match bool
+	}{
+		{"no clause, unready", false, "10.65.0.2", true},
+		{"no clause, unknown", false, "192.168.0.1", true},
+		{"ready", true, "10.65.0.1", true},
+		{"unready", true, "10.65.0.2", false},
+		{"no state", true, "10.65.0.3", false},
+		{"unknown endpoint", true, "192.168.0.1", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.EndpointByIP["10.65.0.1"] = &proto.WorkloadEndpoint{Name: "ready", State: "active"}
+	store.EndpointByIP["10.65.0.2"] = &proto.WorkloadEndpoint{Name: "unready", State: "inactive"}
+	store.EndpointByIP["10.65.0.3"] = &proto.WorkloadEndpoint{Name: "no-state"}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstReady: tc.ready}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The destination listening clause requires the destination port to be one that the destination endpoint declares.
+func TestMatchDstListening(t *testing.T) {
+	testCases := []struct {
+		title     string
+		listening bool
+		dstIP     string
+		port      uint32
+		protocol  core.SocketAddress_Protocol
+		match     bool
+	}{
+		{"no clause, not listening", false, "10.65.0.1", 9090, core.SocketAddress_TCP, true},
+		{"listening", true, "10.65.0.1", 8080, core.SocketAddress_TCP, true},
+		{"listening, protocol number", true, "10.65.0.1", 53, core.SocketAddress_UDP, true},
+		{"not listening", true, "10.65.0.1", 9090, core.SocketAddress_TCP, false},
+		{"wrong protocol", true, "10.65.0.1", 8080, core.SocketAddress_UDP, false},
+		{"no port", true, "10.65.0.1", 0, core.SocketAddress_TCP, false},
+		{"no declared ports", true, "10.65.0.2", 8080, core.SocketAddress_TCP, false},
+		{"unknown endpoint", true, "192.168.0.1", 8080, core.SocketAddress_TCP, false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.EndpointByIP["10.65.0.1"] = &proto.WorkloadEndpoint{Name: "web", Ports: []*proto.EndpointPort{
+		{Name: "http", Protocol: "TCP", Port: 8080},
+		{Name: "dns", Protocol: "17", Port: 53},
+	}}
+	store.EndpointByIP["10.65.0.2"] = &proto.WorkloadEndpoint{Name: "no-ports"}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{
+							Address:       tc.dstIP,
+							Protocol:      tc.protocol,
+							PortSpecifier: &core.SocketAddress_PortValue{PortValue: tc.port},
+						},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstListening: tc.listening}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// Principal prefixes and suffixes are matched literally against the peers' principals.
+func TestMatchPrincipal(t *testing.T) {
+	const payments = "spiffe://cluster.local/ns/payments/sa/ledger"
+	testCases := []struct {
+		title     string
+		prefixes  []string
+		suffixes  []string
+		principal string
+		match     bool
+	}{
+		{"no clauses", nil, nil, payments, true},
+		{"no clauses, empty principal", nil, nil, "", true},
+		{"prefix", []string{"spiffe://cluster.local/ns/payments/"}, nil, payments, true},
+		{"prefix mismatch", []string{"spiffe://cluster.local/ns/orders/"}, nil, payments, false},
+		{"one of several prefixes", []string{"spiffe://cluster.local/ns/orders/", "spiffe://cluster.local/ns/payments/"}, nil, payments, true},
+		{"prefix is literal", []string{"spiffe://cluster.local/ns/.*/"}, nil, payments, false},
+		{"suffix", nil, []string{"/sa/ledger"}, payments, true},
+		{"suffix mismatch", nil, []string{"/sa/api"}, payments, false},
+		{"prefix and suffix", []string{"spiffe://cluster.local/"}, []string{"/sa/ledger"}, payments, true},
+		{"prefix but not suffix", []string{"spiffe://cluster.local/"}, []string{"/sa/api"}, payments, false},
+		{"empty principal, prefix", []string{"spiffe://"}, nil, "", false},
+		{"empty principal, empty prefix", []string{""}, nil, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			Expect(matchPrincipal("src", tc.prefixes, tc.suffixes, tc.principal)).To(Equal(tc.match))
+
+			// The clauses are evaluated against the source and destination principals respectively.
+			other := "spiffe://cluster.local/ns/other/sa/other"
+			for _, src := range []bool{true, false} {
+				req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+					Source:      &auth.AttributeContext_Peer{Principal: other},
+					Destination: &auth.AttributeContext_Peer{Principal: other},
+				}}
+				rule := &proto.Rule{}
+				if src {
+					req.Attributes.Source.Principal = tc.principal
+					rule.SrcPrincipalPrefixes, rule.SrcPrincipalSuffixes = tc.prefixes, tc.suffixes
+				} else {
+					req.Attributes.Destination.Principal = tc.principal
+					rule.DstPrincipalPrefixes, rule.DstPrincipalSuffixes = tc.prefixes, tc.suffixes
+				}
+				reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+				Expect(err).To(Succeed())
+				Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+			}
+		})
+	}
+}
+
+func TestMatchDstEncapsulations(t *testing.T) {
+	testCases := []struct {
+		title  string
+		encaps []string
+		dstIP  string
+		match  bool
+	}{
+		{"no clause", nil, "192.168.0.1", true},
+		{"via IPIP", []string{"IPIP"}, "10.65.1.5", true},
+		{"via IPIP, case insensitive", []string{"ipip"}, "10.65.1.5", true},
+		{"via IPIP, not no-encap", []string{"None"}, "10.65.1.5", false},
+		{"no-encap", []string{"None"}, "10.65.2.5", true},
+		{"no-encap, not IPIP", []string{"IPIP"}, "10.65.2.5", false},
+		{"one of several", []string{"VXLAN", "None"}, "10.65.2.5", true},
+		{"most specific route wins", []string{"None"}, "10.65.1.130", true},
+		{"no route", []string{"None"}, "192.168.0.1", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	store.RouteByDst["10.65.1.0/24"] = &proto.RouteUpdate{
+		Type:       proto.RouteType_REMOTE_WORKLOAD,
+		Dst:        "10.65.1.0/24",
+		TunnelType: &proto.TunnelType{Ipip: true},
+	}
+	store.RouteByDst["10.65.1.128/26"] = &proto.RouteUpdate{
+		Type: proto.RouteType_REMOTE_WORKLOAD,
+		Dst:  "10.65.1.128/26",
+	}
+	store.RouteByDst["10.65.2.0/24"] = &proto.RouteUpdate{
+		Type:       proto.RouteType_REMOTE_WORKLOAD,
+		Dst:        "10.65.2.0/24",
+		SameSubnet: true,
+		TunnelType: &proto.TunnelType{},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{DstEncapsulations: tc.encaps}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+func TestMatchSrcZonesAndRegions(t *testing.T) {
+	testCases := []struct {
+		title   string
+		zones   []string
+		regions []string
+		srcIP   string
+		match   bool
+	}{
+		{"no clause, unknown node", nil, nil, "192.168.0.1", true},
+		{"same zone", []string{"us-east-1a"}, nil, "10.65.1.5", true},
+		{"different zone", []string{"us-east-1a"}, nil, "10.65.2.5", false},
+		{"one of several zones", []string{"us-east-1a", "us-east-1b"}, nil, "10.65.2.5", true},
+		{"host address", []string{"us-east-1b"}, nil, "172.16.0.2", true},
+		{"region", nil, []string{"us-east-1"}, "10.65.2.5", true},
+		{"other region", nil, []string{"eu-west-1"}, "10.65.1.5", false},
+		{"zone and region", []string{"us-east-1a"}, []string{"us-east-1"}, "10.65.1.5", true},
+		{"node without topology labels", []string{"us-east-1a"}, nil, "10.65.3.5", false},
+		{"node without host metadata", []string{"us-east-1a"}, nil, "10.65.4.5", false},
+		{"no route", []string{"us-east-1a"}, nil, "192.168.0.1", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	for dst, node := range map[string]string{
+		"10.65.1.0/24":  "node-a",
+		"10.65.2.0/24":  "node-b",
+		"172.16.0.2/32": "node-b",
+		"10.65.3.0/24":  "node-c",
+		"10.65.4.0/24":  "node-d",
+	} {
+		store.RouteByDst[dst] = &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: dst, DstNodeName: node}
+	}
+	store.NodeLabelsByHostname["node-a"] = map[string]string{
+		"topology.kubernetes.io/zone":   "us-east-1a",
+		"topology.kubernetes.io/region": "us-east-1",
+	}
+	store.NodeLabelsByHostname["node-b"] = map[string]string{
+		"topology.kubernetes.io/zone":   "us-east-1b",
+		"topology.kubernetes.io/region": "us-east-1",
+	}
+	store.NodeLabelsByHostname["node-c"] = map[string]string{"kubernetes.io/hostname": "node-c"}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.srcIP},
+					}},
+				},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{SrcZones: tc.zones, SrcRegions: tc.regions}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// The AS number clause matches sources whose route points to a node with the AS number.
+func TestMatchSrcASNumbers(t *testing.T) {
+	testCases := []struct {
+		title     string
+		asNumbers []uint32
+		srcIP     string
+		match     bool
+	}{
+		{"no clause, non-BGP source", nil, "192.168.0.1", true},
+		{"node's AS", []uint32{64512}, "10.65.1.5", true},
+		{"other AS", []uint32{64513}, "10.65.1.5", false},
+		{"one of several", []uint32{64513, 64512}, "10.65.1.5", true},
+		{"dotted AS number", []uint32{65546}, "10.65.2.5", true},
+		{"node with default AS", []uint32{64512}, "10.65.3.5", false},
+		{"node with invalid AS", []uint32{64512}, "10.65.4.5", false},
+		{"non-BGP source", []uint32{64512}, "192.168.0.1", false},
+	}
+
+	store := policystore.NewPolicyStore()
+	for dst, node := range map[string]string{
+		"10.65.1.0/24": "node-a",
+		"10.65.2.0/24": "node-b",
+		"10.65.3.0/24": "node-c",
+		"10.65.4.0/24": "node-d",
+	} {
+		store.RouteByDst[dst] = &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: dst, DstNodeName: node}
+	}
+	store.NodeASNumberByHostname["node-a"] = "64512"
+	store.NodeASNumberByHostname["node-b"] = "1.10"
+	store.NodeASNumberByHostname["node-d"] = "not-a-number"
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.srcIP},
+					}},
+				},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{SrcAsNumbers: tc.asNumbers}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// staticReverseDNS gives the reverse-DNS names of addresses from a fixed map.  Addresses that aren't in the map
+// haven't been resolved yet.
+type staticReverseDNS map[string][]string
+
+func (r staticReverseDNS) CachedLookupAddr(addr string) ([]string, bool) {
+	names, ok := r[addr]
+	return names, ok
+}
+
+func TestMatchDstReverseDNSNames(t *testing.T) {
+	ptrs := staticReverseDNS{
+		"10.0.0.1": {"API.example.com"},
+		"10.0.0.2": {"host-10-0-0-2.compute.internal", "db.eu.example.com"},
+		"10.0.0.9": nil,
+	}
+	testCases := []struct {
+		title      string
+		names      []string
+		dstIP      string
+		reverseDNS policystore.ReverseDNSCache
+		unknown    UnknownClauseBehavior
+		match      bool
+	}{
+		{"no clause, no PTR", nil, "10.0.0.9", ptrs, UnknownClauseNoMatch, true},
+		{"exact name", []string{"api.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, true},
+		{"exact name with trailing dot", []string{"api.example.com."}, "10.0.0.1", ptrs, UnknownClauseNoMatch, true},
+		{"other name", []string{"web.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, false},
+		{"wildcard", []string{"*.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, true},
+		{"wildcard spans labels", []string{"*.example.com"}, "10.0.0.2", ptrs, UnknownClauseNoMatch, true},
+		{"wildcard in the middle", []string{"db.*.example.com"}, "10.0.0.2", ptrs, UnknownClauseNoMatch, true},
+		{"wildcard other domain", []string{"*.example.org"}, "10.0.0.2", ptrs, UnknownClauseNoMatch, false},
+		{"wildcard needs a label", []string{"*.api.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, false},
+		{"inner wildcard is one label", []string{"*.compute.*"}, "10.0.0.2", ptrs, UnknownClauseNoMatch, true},
+		{"question mark is literal", []string{"ap?.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, false},
+		{"bracket is literal", []string{"[a]pi.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, false},
+		{"partial-label wildcard is literal", []string{"a*.example.com"}, "10.0.0.1", ptrs, UnknownClauseNoMatch, false},
+		{"no PTR", []string{"*.example.com"}, "10.0.0.9", ptrs, UnknownClauseNoMatch, false},
+		{"no resolver", []string{"*.example.com"}, "10.0.0.1", nil, UnknownClauseNoMatch, false},
+		{"not resolved yet", []string{"*.example.com"}, "10.0.0.8", ptrs, UnknownClauseNoMatch, false},
+		{"not resolved yet is unknown", []string{"*.example.com"}, "10.0.0.8", ptrs, UnknownClauseMatch, true},
+		{"no clause, not resolved yet", nil, "10.0.0.8", ptrs, UnknownClauseNoMatch, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Destination: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+					}},
+				},
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			reqCache.config.reverseDNS = tc.reverseDNS
+			reqCache.config.unknownClauseBehavior = tc.unknown
+			rule := &proto.Rule{DstReverseDnsNames: tc.names}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// matchAllFixture returns a store and request, along with a set of rules that between them exercise the lookups that
+// the request cache shares between rules.
+func matchAllFixture() (*policystore.PolicyStore, *auth.CheckRequest, []*proto.Rule) {
+	store := policystore.NewPolicyStore()
+	srcSet := policystore.NewIPSet(proto.IPSetUpdate_IP)
+	for i := 0; i < 250; i++ {
+		srcSet.AddString(fmt.Sprintf("10.0.%d.%d", i/100, i%100))
+	}
+	store.IPSetByID["src"] = srcSet
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("svc-%d", i)
+		store.ServiceByID["default/"+name] = &proto.ServiceUpdate{
+			Name:      name,
+			Namespace: "default",
+			ClusterIp: fmt.Sprintf("10.96.0.%d", i),
+			Ports:     []*proto.ServicePort{{Name: "https", Protocol: "TCP", Port: 443}},
+		}
+	}
+	store.LocalHostname = "node1"
+	store.NodeIPByHostname["node1"] = "192.168.0.1"
+
+	req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+		Source: &auth.AttributeContext_Peer{
+			Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{Address: "10.0.1.50", Protocol: core.SocketAddress_TCP},
+			}},
+		},
+		Destination: &auth.AttributeContext_Peer{
+			Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{
+					Address:       "10.96.0.99",
+					Protocol:      core.SocketAddress_TCP,
+					PortSpecifier: &core.SocketAddress_PortValue{PortValue: 443},
+				},
+			}},
+		},
+	}}
+
+	var rules []*proto.Rule
+	for i := 0; i < 20; i++ {
+		rules = append(rules,
+			&proto.Rule{SrcIpSetIds: []string{"src"}, DstServicePorts: []string{"http"}},
+			&proto.Rule{SrcIsLocalNode: true, DstServicePorts: []string{"https"}},
+			&proto.Rule{NotSrcIpSetIds: []string{"src"}},
+		)
+	}
+	rules = append(rules, &proto.Rule{SrcIpSetIds: []string{"src"}, DstServicePorts: []string{"https"}})
+	return store, req, rules
+}
+
+func TestMatchAll(t *testing.T) {
+	RegisterTestingT(t)
+
+	store, req, rules := matchAllFixture()
+	reqCache, err := NewRequestCache(store, req)
+	Expect(err).To(Succeed())
+	matches := MatchAll(rules, reqCache, "")
+	Expect(matches).To(HaveLen(len(rules)))
+
+	// Each result should be the same as evaluating the rule against a fresh request cache.
+	for i, r := range rules {
+		freshCache, err := NewRequestCache(store, req)
+		Expect(err).To(Succeed())
+		Expect(matches[i]).To(Equal(match(r, freshCache, "")), fmt.Sprintf("rule %d", i))
+	}
+	Expect(matches[len(rules)-1]).To(BeTrue())
+	Expect(matches[:len(rules)-1]).NotTo(ContainElement(BeTrue()))
+}
+
+func BenchmarkMatchPerRule(b *testing.B) {
+	store, req, rules := matchAllFixture()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range rules {
+			reqCache, err := NewRequestCache(store, req)
+			if err != nil {
+				b.Fatal(err)
+			}
+			match(r, reqCache, "")
+		}
+	}
+}
+
+func BenchmarkMatchAll(b *testing.B) {
+	store, req, rules := matchAllFixture()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		reqCache, err := NewRequestCache(store, req)
+		if err != nil {
+			b.Fatal(err)
+		}
+		MatchAll(rules, reqCache, "")
+	}
+}
+
+// The direct remote address clause constrains the immediate peer, even when the request was forwarded on behalf of
+// another client.
+func TestMatchDirectRemoteNet(t *testing.T) {
+	testCases := []struct {
+		title string
+		nets  []string
+		match bool
+	}{
+		{"no clause", nil, true},
+		{"proxy address", []string{"10.0.0.0/24"}, true},
+		{"one of several", []string{"192.168.0.0/16", "10.0.0.5/32"}, true},
+		{"forwarded client address", []string{"172.16.0.9/32"}, false},
+	}
+
+	store := policystore.NewPolicyStore()
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			// A request from the client at 172.16.0.9, forwarded by the proxy at 10.0.0.5.
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{
+					Principal: "spiffe://cluster.local/ns/default/sa/client",
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: "10.0.0.5"},
+					}},
+				},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Request: &auth.AttributeContext_Request{Http: &auth.AttributeContext_HttpRequest{
+					Headers: map[string]string{"x-forwarded-for": "172.16.0.9"},
+				}},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			rule := &proto.Rule{
+				SrcServiceAccountMatch: &proto.ServiceAccountMatch{Names: []string{"client"}},
+				DirectRemoteNet:        tc.nets,
+			}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// With trusted proxies configured, the source net clause matches the client address found by walking the
+// X-Forwarded-For chain right-to-left past the trusted hops.
+func TestMatchSrcNetTrustedProxies(t *testing.T) {
+	testCases := []struct {
+		title   string
+		trusted []string
+		peer    string
+		xff     string
+		client  string
+	}{
+		{"no trusted proxies", nil, "10.0.0.5", "172.16.0.9", "10.0.0.5"},
+		{"untrusted peer", []string{"10.1.0.0/16"}, "10.0.0.5", "172.16.0.9", "10.0.0.5"},
+		{"trusted peer", []string{"10.0.0.0/16"}, "10.0.0.5", "172.16.0.9", "172.16.0.9"},
+		{"trusted peer, no header", []string{"10.0.0.0/16"}, "10.0.0.5", "", "10.0.0.5"},
+		{"trusted hops skipped", []string{"10.0.0.0/16"}, "10.0.0.5", "172.16.0.9, 10.0.1.1, 10.0.2.2", "172.16.0.9"},
+		{"untrusted hop stops the walk", []string{"10.0.0.0/16"}, "10.0.0.5", "172.16.0.9, 192.168.3.3, 10.0.1.1", "192.168.3.3"},
+		{"all hops trusted", []string{"10.0.0.0/16"}, "10.0.0.5", "10.0.1.1, 10.0.2.2", "10.0.1.1"},
+		{"several trusted CIDRs", []string{"10.0.0.0/16", "192.168.0.0/16"}, "10.0.0.5", "172.16.0.9, 192.168.3.3", "172.16.0.9"},
+		{"malformed header", []string{"10.0.0.0/16"}, "10.0.0.5", "not-an-ip", "10.0.0.5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			store := policystore.NewPolicyStore()
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{
+					Address: &core.Address{Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{Address: tc.peer},
+					}},
+				},
+				Destination: &auth.AttributeContext_Peer{Address: socketAddressProtocolTCP},
+				Request: &auth.AttributeContext_Request{Http: &auth.AttributeContext_HttpRequest{
+					Headers: map[string]string{"x-forwarded-for": tc.xff},
+				}},
+			}}
+			reqCache, err := NewRequestCache(store, req)
+			Expect(err).To(Succeed())
+			reqCache.config.trustedProxyCIDRs = tc.trusted
+			Expect(match(&proto.Rule{SrcNet: []string{tc.client + "/32"}}, reqCache, "")).To(BeTrue())
+			if tc.client != tc.peer {
+				Expect(match(&proto.Rule{SrcNet: []string{tc.peer + "/32"}}, reqCache, "")).To(BeFalse())
+				// The direct remote clause still sees the immediate peer.
+				Expect(match(&proto.Rule{DirectRemoteNet: []string{tc.peer + "/32"}}, reqCache, "")).To(BeTrue())
+			}
+		})
+	}
+}
+
+// Query parameter clauses must all match the parsed query string.
+func TestMatchHTTPQueryParams(t *testing.T) {
+	present := func(name string) *proto.HTTPMatch_QueryParamMatch {
+		return &proto.HTTPMatch_QueryParamMatch{Name: name}
+	}
+	exact := func(name, value string) *proto.HTTPMatch_QueryParamMatch {
+		return &proto.HTTPMatch_QueryParamMatch{
+			Name:       name,
+			ValueMatch: &proto.HTTPMatch_QueryParamMatch_Exact{Exact: value},
+		}
+	}
+	testCases := []struct {
+		title   string
+		params  []*proto.HTTPMatch_QueryParamMatch
+		reqPath string
+		result  bool
+	}{
+		{"empty", nil, "/foo?a=1", true},
+		{"empty, no query", nil, "/foo", true},
+		{"exists", []*proto.HTTPMatch_QueryParamMatch{present("a")}, "/foo?a=1", true},
+		{"exists, explicit", []*proto.HTTPMatch_QueryParamMatch{{
+			Name:       "a",
+			ValueMatch: &proto.HTTPMatch_QueryParamMatch_Present{Present: true},
+		}}, "/foo?b=2&a", true},
+		{"exists, no query", []*proto.HTTPMatch_QueryParamMatch{present("a")}, "/foo", false},
+		{"exists, other param", []*proto.HTTPMatch_QueryParamMatch{present("a")}, "/foo?ab=1", false},
+		{"exact value", []*proto.HTTPMatch_QueryParamMatch{exact("a", "1")}, "/foo?a=1", true},
+		{"exact value mismatch", []*proto.HTTPMatch_QueryParamMatch{exact("a", "1")}, "/foo?a=10", false},
+		{"exact empty value", []*proto.HTTPMatch_QueryParamMatch{exact("a", "")}, "/foo?a=", true},
+		{"repeated param", []*proto.HTTPMatch_QueryParamMatch{exact("a", "2")}, "/foo?a=1&a=2", true},
+		{"repeated param mismatch", []*proto.HTTPMatch_QueryParamMatch{exact("a", "3")}, "/foo?a=1&a=2", false},
+		{"url-decoded value", []*proto.HTTPMatch_QueryParamMatch{exact("q", "a b/c")}, "/foo?q=a+b%2Fc", true},
+		{"url-decoded name", []*proto.HTTPMatch_QueryParamMatch{exact("x y", "1")}, "/foo?x%20y=1", true},
+		{"all must match", []*proto.HTTPMatch_QueryParamMatch{exact("a", "1"), present("b")}, "/foo?a=1", false},
+		{"all match", []*proto.HTTPMatch_QueryParamMatch{exact("a", "1"), present("b")}, "/foo?b&a=1", true},
+		{"fragment ignored", []*proto.HTTPMatch_QueryParamMatch{exact("a", "1")}, "/foo?a=1#frag", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPQueryParams(tc.params, tc.reqPath)).To(Equal(tc.result))
+
+			req := &auth.AttributeContext_HttpRequest{Path: tc.reqPath}
+			Expect(matchHTTP(&proto.HTTPMatch{QueryParams: tc.params}, req)).To(Equal(tc.result))
+		})
+	}
+}
+
+// HTTP header matches must all match, comparing names case-insensitively.
+func TestMatchHTTPHeaders(t *testing.T) {
+	present := func(name string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name}
+	}
+	exact := func(name, value string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, ValueMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: value}}
+	}
+	prefix := func(name, value string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, ValueMatch: &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: value}}
+	}
+	regex := func(name, value string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, ValueMatch: &proto.HTTPMatch_HeaderMatch_Regex{Regex: value}}
+	}
+	headers := map[string]string{
+		"x-tenant-id":   "acme",
+		"authorization": "Bearer abc.def",
+		"accept":        "text/html, application/json",
+	}
+	testCases := []struct {
+		title   string
+		matches []*proto.HTTPMatch_HeaderMatch
+		result  bool
+	}{
+		{"empty", nil, true},
+		{"present", []*proto.HTTPMatch_HeaderMatch{present("authorization")}, true},
+		{"present, explicit", []*proto.HTTPMatch_HeaderMatch{{
+			Name:       "Authorization",
+			ValueMatch: &proto.HTTPMatch_HeaderMatch_Present{Present: true},
+		}}, true},
+		{"missing", []*proto.HTTPMatch_HeaderMatch{present("x-user")}, false},
+		{"missing, exact", []*proto.HTTPMatch_HeaderMatch{exact("x-user", "")}, false},
+		{"name case-insensitive", []*proto.HTTPMatch_HeaderMatch{exact("X-Tenant-ID", "acme")}, true},
+		{"value case-sensitive", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant-id", "ACME")}, false},
+		{"prefix", []*proto.HTTPMatch_HeaderMatch{prefix("authorization", "Bearer ")}, true},
+		{"prefix mismatch", []*proto.HTTPMatch_HeaderMatch{prefix("authorization", "Basic ")}, false},
+		{"regex", []*proto.HTTPMatch_HeaderMatch{regex("x-tenant-id", "[a-z]+")}, true},
+		{"regex anchored", []*proto.HTTPMatch_HeaderMatch{regex("x-tenant-id", "ac")}, false},
+		{"multi-valued, whole", []*proto.HTTPMatch_HeaderMatch{exact("accept", "text/html, application/json")}, true},
+		{"multi-valued, one value", []*proto.HTTPMatch_HeaderMatch{exact("accept", "application/json")}, true},
+		{"multi-valued, prefix of one value", []*proto.HTTPMatch_HeaderMatch{prefix("accept", "application/")}, true},
+		{"multi-valued, no value", []*proto.HTTPMatch_HeaderMatch{exact("accept", "text/plain")}, false},
+		{"all match", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant-id", "acme"), present("authorization")}, true},
+		{"all must match", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant-id", "acme"), present("x-user")}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHeaders(tc.matches, headers)).To(Equal(tc.result))
+
+			req := &auth.AttributeContext_HttpRequest{Path: "/", Headers: headers}
+			Expect(matchHTTP(&proto.HTTPMatch{Headers: tc.matches}, req)).To(Equal(tc.result))
+		})
+	}
+}
+
+// Header names are compared case-insensitively even if the request's header names aren't lowercase.
+func TestMatchHTTPHeadersMixedCaseRequest(t *testing.T) {
+	RegisterTestingT(t)
+	m := []*proto.HTTPMatch_HeaderMatch{{Name: "x-tenant-id", ValueMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: "acme"}}}
+	Expect(matchHTTPHeaders(m, map[string]string{"X-Tenant-Id": "acme"})).To(BeTrue())
+}
+
+// The upgrades clause matches requests that upgrade the connection, e.g. to establish a websocket.
+func TestMatchHTTPUpgrades(t *testing.T) {
+	testCases := []struct {
+		title    string
+		upgrades []string
+		method   string
+		headers  map[string]string
+		result   bool
+	}{
+		{"empty, no upgrade", nil, "GET", nil, true},
+		{"empty, upgrade", nil, "GET", map[string]string{"upgrade": "websocket"}, true},
+		{"websocket", []string{"websocket"}, "GET", map[string]string{"upgrade": "websocket"}, true},
+		{"websocket, case-insensitive", []string{"WebSocket"}, "GET", map[string]string{"upgrade": "websocket"}, true},
+		{"no upgrade header", []string{"websocket"}, "GET", map[string]string{"connection": "keep-alive"}, false},
+		{"other protocol", []string{"websocket"}, "GET", map[string]string{"upgrade": "h2c"}, false},
+		{"one of several offered", []string{"h2c"}, "GET", map[string]string{"upgrade": "websocket, h2c"}, true},
+		{"version omitted from rule", []string{"http"}, "GET", map[string]string{"upgrade": "HTTP/2.0"}, true},
+		{"version in rule", []string{"http/2.0"}, "GET", map[string]string{"upgrade": "HTTP/2.0"}, true},
+		{"extended CONNECT", []string{"websocket"}, "CONNECT", map[string]string{":protocol": "websocket"}, true},
+		{"protocol without CONNECT", []string{"websocket"}, "GET", map[string]string{":protocol": "websocket"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			req := &auth.AttributeContext_HttpRequest{Method: tc.method, Path: "/", Headers: tc.headers}
+			Expect(matchHTTPUpgrades(tc.upgrades, req)).To(Equal(tc.result))
+			Expect(matchHTTP(&proto.HTTPMatch{Upgrades: tc.upgrades}, req)).To(Equal(tc.result))
+		})
+	}
+}
+
+// With hairpin traffic denied, hairpin requests only match Allow rules that allow hairpin traffic.
+func TestMatchHairpin(t *testing.T) {
+	testCases := []struct {
+		title        string
+		denyHairpin  bool
+		action       string
+		allowHairpin bool
+		srcIP        string
+		dstIP        string
+		match        bool
+	}{
+		{"hairpin allowed by default", false, "Allow", false, "10.0.0.1", "10.0.0.1", true},
+		{"hairpin denied", true, "Allow", false, "10.0.0.1", "10.0.0.1", false},
+		{"hairpin denied, rule allows hairpin", true, "Allow", true, "10.0.0.1", "10.0.0.1", true},
+		{"hairpin denied, IPv6", true, "Allow", false, "fd00::1", "fd00:0:0::1", false},
+		{"hairpin denied, IPv4-mapped IPv6", true, "Allow", false, "10.0.0.1", "::ffff:10.0.0.1", false},
+		{"hairpin denied, deny rule still matches", true, "Deny", false, "10.0.0.1", "10.0.0.1", true},
+		{"hairpin denied, other destination", true, "Allow", false, "10.0.0.1", "10.0.0.2", true},
+		{"hairpin denied, no source address", true, "Allow", false, "", "10.0.0.1", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := &auth.CheckRequest{Attributes: &auth.AttributeContext{
+				Source: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{Address: tc.srcIP},
+				}}},
+				Destination: &auth.AttributeContext_Peer{Address: &core.Address{Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{Address: tc.dstIP},
+				}}},
+			}}
+			reqCache, err := NewRequestCache(policystore.NewPolicyStore(), req)
+			Expect(err).To(Succeed())
+			reqCache.config.denyHairpin = tc.denyHairpin
+			rule := &proto.Rule{Action: tc.action, AllowHairpin: tc.allowHairpin}
+			Expect(match(rule, reqCache, "")).To(Equal(tc.match))
+		})
+	}
+}
+
+// withoutIPSets returns a deep copy, so removing IP sets from it leaves the rule in the store untouched.
+func TestWithoutIPSets(t *testing.T) {
+	RegisterTestingT(t)
+
+	rule := &proto.Rule{
+		Action:      "Allow",
+		SrcIpSetIds: []string{"present", "missing"},
+		DstIpSetIds: []string{"missing"},
+		HttpMatch:   &proto.HTTPMatch{Methods: []string{"GET"}},
+	}
+	r := withoutIPSets(rule, []string{"missing"})
+	Expect(r.SrcIpSetIds).To(Equal([]string{"present"}))
+	Expect(r.DstIpSetIds).To(BeEmpty())
+	Expect(r.HttpMatch).NotTo(BeIdenticalTo(rule.HttpMatch))
+	Expect(rule.SrcIpSetIds).To(Equal([]string{"present", "missing"}))
+	Expect(rule.DstIpSetIds).To(Equal([]string{"missing"}))
+}
+
+// Each missing IP set is only warned about the first time a rule that refers to it is resolved.
+func TestResolveMissingDataWarnsOncePerIPSet(t *testing.T) {
+	RegisterTestingT(t)
+	hook := logtest.NewGlobal()
+	defer log.StandardLogger().ReplaceHooks(make(log.LevelHooks))
+
+	warnings := func() int {
+		n := 0
+		for _, e := range hook.AllEntries() {
+			if e.Level == log.WarnLevel {
+				n++
+			}
+		}
+		return n
+	}
+	rule := &proto.Rule{Action: "Deny"}
+
+	Expect(resolveMissingData(FailClosed, rule, []string{"warn-once-a"})).To(BeTrue())
+	Expect(warnings()).To(Equal(1))
+	Expect(resolveMissingData(FailClosed, rule, []string{"warn-once-a"})).To(BeTrue())
+	Expect(warnings()).To(Equal(1))
+	Expect(resolveMissingData(FailClosed, rule, []string{"warn-once-a", "warn-once-b"})).To(BeTrue())
+	Expect(warnings()).To(Equal(2))
+}
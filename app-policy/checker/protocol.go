@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+var (
+	protocolAliasesLock sync.RWMutex
+	// protocolAliases maps the (lowercase) names and numbers that rules may use for an L4 protocol to the canonical
+	// name that matchProtocolName compares with the request's protocol.
+	protocolAliases = map[string]string{
+		"tcp":    "tcp",
+		"6":      "tcp",
+		"udp":    "udp",
+		"17":     "udp",
+		"icmp":   "icmp",
+		"1":      "icmp",
+		"icmpv6": "icmpv6",
+		"58":     "icmpv6",
+	}
+)
+
+// RegisterProtocolAlias makes rules that use the given (case-insensitive) protocol name or number match requests
+// with the canonical protocol, e.g. "tcp".
+func RegisterProtocolAlias(alias, canonical string) {
+	protocolAliasesLock.Lock()
+	defer protocolAliasesLock.Unlock()
+	protocolAliases[strings.ToLower(strings.TrimSpace(alias))] = strings.ToLower(canonical)
+}
+
+// canonicalProtocol returns the canonical name of a rule's protocol, or an error if it isn't a known alias.
+func canonicalProtocol(p *proto.Protocol) (string, error) {
+	alias := strings.ToLower(strings.TrimSpace(p.GetName()))
+	if _, ok := p.GetNumberOrName().(*proto.Protocol_Number); ok {
+		alias = strconv.Itoa(int(p.GetNumber()))
+	}
+	protocolAliasesLock.RLock()
+	defer protocolAliasesLock.RUnlock()
+	canonical, ok := protocolAliases[alias]
+	if !ok {
+		return "", fmt.Errorf("unknown protocol %q", alias)
+	}
+	return canonical, nil
+}
+
+// ValidateRule returns an error if the rule uses values that the checker can't match, such as an unknown protocol.
+func ValidateRule(r *proto.Rule) error {
+	if p := r.GetProtocol(); p != nil {
+		if _, err := canonicalProtocol(p); err != nil {
+			return fmt.Errorf("invalid protocol: %w", err)
+		}
+	}
+	if p := r.GetNotProtocol(); p != nil {
+		if _, err := canonicalProtocol(p); err != nil {
+			return fmt.Errorf("invalid not_protocol: %w", err)
+		}
+	}
+	return nil
+}
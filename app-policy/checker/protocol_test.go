@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"testing"
+
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func protocolName(n string) *proto.Protocol {
+	return &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: n}}
+}
+
+func protocolNumber(n int32) *proto.Protocol {
+	return &proto.Protocol{NumberOrName: &proto.Protocol_Number{Number: n}}
+}
+
+// Rules may give the protocol as any of its aliases.
+func TestProtocolAliases(t *testing.T) {
+	testCases := []struct {
+		title    string
+		protocol *proto.Protocol
+		valid    bool
+		matchTCP bool
+	}{
+		{"lowercase name", protocolName("tcp"), true, true},
+		{"uppercase name", protocolName("TCP"), true, true},
+		{"numeric string", protocolName("6"), true, true},
+		{"number", protocolNumber(6), true, true},
+		{"other protocol", protocolName("udp"), true, false},
+		{"invalid alias", protocolName("tcpp"), false, false},
+	}
+
+	tcp := &authz.AttributeContext_Peer{Address: socketAddressProtocolTCP}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			rule := &proto.Rule{Protocol: tc.protocol}
+			if tc.valid {
+				Expect(ValidateRule(rule)).To(Succeed())
+			} else {
+				Expect(ValidateRule(rule)).ToNot(Succeed())
+			}
+			Expect(matchL4Protocol(rule, tcp)).To(Equal(tc.matchTCP))
+
+			rule = &proto.Rule{NotProtocol: tc.protocol}
+			if !tc.valid {
+				Expect(ValidateRule(rule)).ToNot(Succeed())
+			}
+			Expect(matchL4Protocol(rule, tcp)).To(Equal(!tc.matchTCP))
+		})
+	}
+}
+
+func TestRegisterProtocolAlias(t *testing.T) {
+	RegisterTestingT(t)
+
+	rule := &proto.Rule{Protocol: protocolName("stream")}
+	Expect(ValidateRule(rule)).ToNot(Succeed())
+
+	RegisterProtocolAlias("Stream", "TCP")
+	defer func() {
+		protocolAliasesLock.Lock()
+		defer protocolAliasesLock.Unlock()
+		delete(protocolAliases, "stream")
+	}()
+	Expect(ValidateRule(rule)).To(Succeed())
+	Expect(matchL4Protocol(rule, &authz.AttributeContext_Peer{Address: socketAddressProtocolTCP})).To(BeTrue())
+}
@@ -15,15 +15,18 @@
 package checker
 
 import (
-	"fmt"
-	"regexp"
-	"sync"
+	"net"
+	"strings"
 
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
 	"github.com/projectcalico/calico/app-policy/policystore"
 	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
 )
 
 // requestCache contains the CheckRequest and cached copies of computed information about the request
@@ -34,14 +37,42 @@ type requestCache struct {
 	destination          *peer
 	sourceNamespace      *namespace
 	destinationNamespace *namespace
+
+	// The following are looked up in the store on first use and then reused for every rule evaluated against the
+	// request.  The flags record whether the lookup has been done, since nil is a valid result.
+	destinationEndpoint          *proto.WorkloadEndpoint
+	destinationEndpointKnown     bool
+	destinationServicePorts      []string
+	destinationServicePortsKnown bool
+	sourceIsLocalNode            bool
+	sourceIsLocalNodeKnown       bool
+	sourceClientAddress          *core.Address
+	destinationEncap             string
+	destinationEncapKnown        bool
+	sourceNodeLabels             map[string]string
+	sourceNodeLabelsKnown        bool
+	destinationPTRNames          []string
+	destinationPTRNamesKnown     bool
+	destinationPTRNamesCached    bool
+	ipSetMembership              map[ipSetMembershipKey]bool
+
+	// config holds the settings for the check that the request is part of.
+	config checkConfig
+}
+
+// ipSetMembershipKey identifies a check of whether one of the request's addresses is in an IP set.
+type ipSetMembershipKey struct {
+	ipSetID string
+	addr    *core.Address
 }
 
 // peer is derived from the request Service Account and any label information we have about the account
 // in the PolicyStore
 type peer struct {
-	Name      string
-	Namespace string
-	Labels    map[string]string
+	Name        string
+	Namespace   string
+	TrustDomain string
+	Labels      map[string]string
 }
 
 type namespace struct {
@@ -49,12 +80,6 @@ type namespace struct {
 	Labels map[string]string
 }
 
-// SPIFFE_ID_PATTERN is a regular expression to match SPIFFE ID URIs, e.g. spiffe://cluster.local/ns/default/sa/foo
-const SPIFFE_ID_PATTERN = "^spiffe://[^/]+/ns/([^/]+)/sa/([^/]+)$"
-
-var spiffeIdRegExp *regexp.Regexp
-var spiffeIdRegExpOnce = sync.Once{}
-
 func NewRequestCache(store *policystore.PolicyStore, req *authz.CheckRequest) (*requestCache, error) {
 	r := &requestCache{Request: req, store: store}
 	err := r.initPeers()
@@ -74,6 +99,265 @@ func (r *requestCache) DestinationPeer() peer {
 	return *r.destination
 }
 
+// SourceIsLocalNode returns true if the request's source IP address is one of the local node's addresses.
+func (r *requestCache) SourceIsLocalNode() bool {
+	if !r.sourceIsLocalNodeKnown {
+		r.sourceIsLocalNode = r.lookupSourceIsLocalNode()
+		r.sourceIsLocalNodeKnown = true
+	}
+	return r.sourceIsLocalNode
+}
+
+func (r *requestCache) lookupSourceIsLocalNode() bool {
+	ip := net.ParseIP(r.Request.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress())
+	if ip == nil || r.store.LocalHostname == "" {
+		return false
+	}
+	nodeIP, ok := r.store.NodeIPByHostname[r.store.LocalHostname]
+	return ok && ip.Equal(net.ParseIP(nodeIP))
+}
+
+// DestinationServicePorts returns the names of the Kubernetes service ports that the request's destination IP address
+// and port belong to.  The IP address may be any of the service's cluster, load balancer or external IPs.
+func (r *requestCache) DestinationServicePorts() []string {
+	if !r.destinationServicePortsKnown {
+		r.destinationServicePorts = r.lookupDestinationServicePorts()
+		r.destinationServicePortsKnown = true
+	}
+	return r.destinationServicePorts
+}
+
+func (r *requestCache) lookupDestinationServicePorts() []string {
+	sa := r.Request.GetAttributes().GetDestination().GetAddress().GetSocketAddress()
+	ip := net.ParseIP(sa.GetAddress())
+	if ip == nil {
+		return nil
+	}
+	port := int32(sa.GetPortValue())
+	protocol := sa.GetProtocol().String()
+
+	var names []string
+	for _, svc := range r.store.ServiceByID {
+		if !serviceHasIP(svc, ip) {
+			continue
+		}
+		for _, p := range svc.GetPorts() {
+			if p.GetName() != "" && p.GetPort() == port && strings.EqualFold(p.GetProtocol(), protocol) {
+				names = append(names, p.GetName())
+			}
+		}
+	}
+	return names
+}
+
+func serviceHasIP(svc *proto.ServiceUpdate, ip net.IP) bool {
+	if ip.Equal(net.ParseIP(svc.GetClusterIp())) || ip.Equal(net.ParseIP(svc.GetLoadbalancerIp())) {
+		return true
+	}
+	for _, extIP := range svc.GetExternalIps() {
+		if ip.Equal(net.ParseIP(extIP)) {
+			return true
+		}
+	}
+	return false
+}
+
+// SourceClientAddress returns the address of the client that originated the request.  This is the source address of
+// the connection unless it is one of the store's trusted proxies, in which case it is the rightmost untrusted address
+// in the X-Forwarded-For header.  If every address in the header is trusted, it is the leftmost one.
+func (r *requestCache) SourceClientAddress() *core.Address {
+	if r.sourceClientAddress == nil {
+		r.sourceClientAddress = r.lookupSourceClientAddress()
+	}
+	return r.sourceClientAddress
+}
+
+func (r *requestCache) lookupSourceClientAddress() *core.Address {
+	addr := r.Request.GetAttributes().GetSource().GetAddress()
+	trusted := r.config.trustedProxyCIDRs
+	if len(trusted) == 0 || !r.isTrustedProxy(addr.GetSocketAddress().GetAddress()) {
+		return addr
+	}
+	xff := r.Request.GetAttributes().GetRequest().GetHttp().GetHeaders()["x-forwarded-for"]
+	if xff == "" {
+		return addr
+	}
+	hops := strings.Split(xff, ",")
+	client := ""
+	for i := len(hops) - 1; i >= 0; i-- {
+		client = strings.TrimSpace(hops[i])
+		if !r.isTrustedProxy(client) {
+			break
+		}
+	}
+	if net.ParseIP(client) == nil {
+		log.WithField("xff", xff).Warn("Malformed X-Forwarded-For header, using the connection's source address")
+		return addr
+	}
+	return &core.Address{Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+		Address:  client,
+		Protocol: addr.GetSocketAddress().GetProtocol(),
+	}}}
+}
+
+func (r *requestCache) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range r.config.trustedProxyCIDRs {
+		ipn, err := cidrCache.get(cidr)
+		if err != nil {
+			log.WithField("cidr", cidr).Warn("unable to parse trusted proxy CIDR")
+			continue
+		}
+		if ipn.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Encapsulations that a route to a destination may use, as matched by a rule's dst_encapsulations.
+const (
+	encapIPIP  = "IPIP"
+	encapVXLAN = "VXLAN"
+	encapNone  = "None"
+)
+
+// DestinationEncapsulation returns the encapsulation used by the most specific route in the store that contains the
+// request's destination IP address, or "" if the store has no such route.
+func (r *requestCache) DestinationEncapsulation() string {
+	if !r.destinationEncapKnown {
+		r.destinationEncap = r.lookupDestinationEncapsulation()
+		r.destinationEncapKnown = true
+	}
+	return r.destinationEncap
+}
+
+func (r *requestCache) lookupDestinationEncapsulation() string {
+	best := r.routeTo(r.Request.GetAttributes().GetDestination().GetAddress().GetSocketAddress().GetAddress())
+	if best == nil {
+		return ""
+	}
+	switch {
+	case best.GetTunnelType().GetIpip():
+		return encapIPIP
+	case best.GetTunnelType().GetVxlan():
+		return encapVXLAN
+	}
+	return encapNone
+}
+
+// routeTo returns the most specific route in the store that contains the given IP address, or nil if there is none.
+func (r *requestCache) routeTo(addr string) *proto.RouteUpdate {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil
+	}
+	var best *proto.RouteUpdate
+	bestLen := -1
+	for dst, route := range r.store.RouteByDst {
+		_, cidr, err := net.ParseCIDR(dst)
+		if err != nil || !cidr.Contains(ip) {
+			continue
+		}
+		if ones, _ := cidr.Mask.Size(); ones > bestLen {
+			best, bestLen = route, ones
+		}
+	}
+	return best
+}
+
+// SourceNodeLabels returns the labels of the node that the request's source IP address belongs to, which is found
+// from the route to the address.  It returns nil if the store has no such route, or no host metadata for its node.
+func (r *requestCache) SourceNodeLabels() map[string]string {
+	if !r.sourceNodeLabelsKnown {
+		if node := r.sourceNodeName(); node != "" {
+			r.sourceNodeLabels = r.store.NodeLabelsByHostname[node]
+		}
+		r.sourceNodeLabelsKnown = true
+	}
+	return r.sourceNodeLabels
+}
+
+// SourceASNumber returns the BGP AS number of the node that the request's source IP address belongs to, which is found
+// from the route to the address.  ok is false if the store has no such route, or its node has no AS number of its own.
+func (r *requestCache) SourceASNumber() (asNumber uint32, ok bool) {
+	node := r.sourceNodeName()
+	s, ok := r.store.NodeASNumberByHostname[node]
+	if node == "" || !ok {
+		return 0, false
+	}
+	as, err := numorstring.ASNumberFromString(s)
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{"node": node, "asNumber": s}).Warn("Invalid node AS number")
+		return 0, false
+	}
+	return uint32(as), true
+}
+
+// sourceNodeName returns the name of the node that the route to the request's source IP address targets, or "" if
+// there is no such route.
+func (r *requestCache) sourceNodeName() string {
+	addr := r.Request.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress()
+	return r.routeTo(addr).GetDstNodeName()
+}
+
+// DestinationReverseDNSNames returns the reverse-DNS names of the request's destination IP address, without any
+// trailing dots, from the configured cache.  It never waits for DNS: ok is false if the cache hasn't resolved the
+// address yet.  Without a cache, reverse-DNS lookups aren't enabled and no address has a name.
+func (r *requestCache) DestinationReverseDNSNames() (names []string, ok bool) {
+	if !r.destinationPTRNamesKnown {
+		r.destinationPTRNames, r.destinationPTRNamesCached = r.lookupDestinationReverseDNSNames()
+		r.destinationPTRNamesKnown = true
+	}
+	return r.destinationPTRNames, r.destinationPTRNamesCached
+}
+
+func (r *requestCache) lookupDestinationReverseDNSNames() ([]string, bool) {
+	if r.config.reverseDNS == nil {
+		return nil, true
+	}
+	addr := r.Request.GetAttributes().GetDestination().GetAddress().GetSocketAddress().GetAddress()
+	return r.config.reverseDNS.CachedLookupAddr(addr)
+}
+
+// IsHairpin returns true if the request's source and destination are the same IP address, for example a pod that
+// connects to itself through a service.
+func (r *requestCache) IsHairpin() bool {
+	src := net.ParseIP(r.Request.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress())
+	dst := net.ParseIP(r.Request.GetAttributes().GetDestination().GetAddress().GetSocketAddress().GetAddress())
+	return src != nil && src.Equal(dst)
+}
+
+// DestinationInLocalIPAMBlock returns true if the request's destination IP address is within one of the IPAM blocks
+// that are affine to the local host.
+func (r *requestCache) DestinationInLocalIPAMBlock() bool {
+	ip := net.ParseIP(r.Request.GetAttributes().GetDestination().GetAddress().GetSocketAddress().GetAddress())
+	if ip == nil {
+		return false
+	}
+	for dst := range r.store.LocalIPAMBlocks {
+		_, cidr, err := net.ParseCIDR(dst)
+		if err == nil && cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// DestinationEndpoint returns the workload endpoint in the store with the request's destination IP address, or nil
+// if the store has no such endpoint.
+func (r *requestCache) DestinationEndpoint() *proto.WorkloadEndpoint {
+	if !r.destinationEndpointKnown {
+		addr := r.Request.GetAttributes().GetDestination().GetAddress().GetSocketAddress().GetAddress()
+		r.destinationEndpoint = r.store.EndpointByIP[addr]
+		r.destinationEndpointKnown = true
+	}
+	return r.destinationEndpoint
+}
+
 func (r *requestCache) SourceNamespace() namespace {
 	if r.sourceNamespace != nil {
 		return *r.sourceNamespace
@@ -94,12 +378,15 @@ func (r *requestCache) DestinationNamespace() namespace {
 
 // initPeers initializes the source and destination peers.
 func (r *requestCache) initPeers() error {
-	src, err := r.initPeer(r.Request.GetAttributes().GetSource())
+	extractor := currentIdentityExtractor()
+	src, err := r.initPeer(extractor, r.Request.GetAttributes().GetSource(), nil)
 	if err != nil {
 		return err
 	}
 	r.source = src
-	dst, err := r.initPeer(r.Request.GetAttributes().GetDestination())
+	// Envoy doesn't always know the destination's principal, for example for plain text requests, so fall back to
+	// the service account of the destination endpoint, if it's in the store.
+	dst, err := r.initPeer(extractor, r.Request.GetAttributes().GetDestination(), r.DestinationEndpoint())
 	if err != nil {
 		return err
 	}
@@ -107,11 +394,19 @@ func (r *requestCache) initPeers() error {
 	return nil
 }
 
-func (r *requestCache) initPeer(aPeer *authz.AttributeContext_Peer) (*peer, error) {
-	peer, err := parseSpiffeID(aPeer.GetPrincipal())
+// initPeer initializes a peer from the identity that the extractor finds for it.  If the peer's identity is unknown,
+// its service account is taken from the given endpoint, if any.
+func (r *requestCache) initPeer(
+	extractor IdentityExtractor, aPeer *authz.AttributeContext_Peer, ep *proto.WorkloadEndpoint,
+) (*peer, error) {
+	identity, err := extractor.Extract(r.Request, aPeer)
 	if err != nil {
 		return nil, err
 	}
+	peer := peer{Name: identity.Name, Namespace: identity.Namespace, TrustDomain: identity.TrustDomain}
+	if peer.Name == "" && ep != nil {
+		peer.Name, peer.Namespace = serviceAccountFromProfiles(ep.GetProfileIds())
+	}
 	// Copy any labels from the request.
 	peer.Labels = make(map[string]string)
 	for k, v := range aPeer.GetLabels() {
@@ -152,23 +447,32 @@ func (r *requestCache) GetIPSet(ipset string) policystore.IPSet {
 	return s
 }
 
-// parseSpiffeId parses an Istio SPIFFE ID and extracts the service account name and namespace.
-func parseSpiffeID(id string) (peer peer, err error) {
-	if id == "" {
-		log.Debug("empty spiffe/plain text request.")
-		// Assume this is plain text.
-		return peer, nil
-	}
-	// Init the regexp the first time this is called, and store it in the package namespace.
-	spiffeIdRegExpOnce.Do(func() {
-		spiffeIdRegExp, _ = regexp.Compile(SPIFFE_ID_PATTERN)
-	})
-	match := spiffeIdRegExp.FindStringSubmatch(id)
-	if match == nil {
-		err = fmt.Errorf("expected match %s, got %s", SPIFFE_ID_PATTERN, id)
-	} else {
-		peer.Name = match[2]
-		peer.Namespace = match[1]
-	}
-	return
+// IPSetContains returns true if the given IP set contains the address, which must be one of the request's addresses.
+func (r *requestCache) IPSetContains(ipset string, addr *core.Address) bool {
+	key := ipSetMembershipKey{ipSetID: ipset, addr: addr}
+	if contains, ok := r.ipSetMembership[key]; ok {
+		return contains
+	}
+	contains := r.GetIPSet(ipset).ContainsAddress(addr)
+	if r.ipSetMembership == nil {
+		r.ipSetMembership = make(map[ipSetMembershipKey]bool)
+	}
+	r.ipSetMembership[key] = contains
+	return contains
+}
+
+// serviceAccountFromProfiles returns the name and namespace of the service account of an endpoint with the given
+// profiles.  Kubernetes endpoints have a profile named "ksa.<namespace>.<name>" for their service account.
+func serviceAccountFromProfiles(profileIDs []string) (name, namespace string) {
+	for _, id := range profileIDs {
+		if !strings.HasPrefix(id, conversion.ServiceAccountProfileNamePrefix) {
+			continue
+		}
+		// Namespace names can't contain dots, so the first dot separates the namespace from the name.
+		parts := strings.SplitN(strings.TrimPrefix(id, conversion.ServiceAccountProfileNamePrefix), ".", 2)
+		if len(parts) == 2 {
+			return parts[1], parts[0]
+		}
+	}
+	return "", ""
 }
@@ -3,6 +3,7 @@ package checker
 import (
 	"testing"
 
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	. "github.com/onsi/gomega"
 
@@ -18,6 +19,7 @@ func TestParseSpiffeIdOk(t *testing.T) {
 	peer, err := parseSpiffeID(id)
 	Expect(peer.Name).To(Equal("bacon"))
 	Expect(peer.Namespace).To(Equal("sandwich"))
+	Expect(peer.TrustDomain).To(Equal("foo.bar.com"))
 	Expect(err).To(BeNil())
 
 	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
@@ -45,6 +47,39 @@ func TestParseSpiffeIdFail(t *testing.T) {
 	Expect(err).ToNot(BeNil())
 }
 
+// Malformed SPIFFE IDs are rejected for both peers, rather than being matched with a partial identity.
+func TestParseSpiffeIdMalformed(t *testing.T) {
+	for _, id := range []string{
+		"spiffe:///ns/sandwich/sa/bacon",
+		"spiffe://foo.bar.com",
+		"spiffe://foo.bar.com/ns/sandwich",
+		"spiffe://foo.bar.com/ns/sandwich/sa/",
+		"spiffe://foo.bar.com/ns/sandwich/sa/bacon/extra",
+		"spiffe://foo.bar.com/sa/bacon/ns/sandwich",
+	} {
+		t.Run(id, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			_, err := parseSpiffeID(id)
+			Expect(err).To(HaveOccurred())
+
+			for _, malformedSrc := range []bool{true, false} {
+				req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+					Source:      &authz.AttributeContext_Peer{Principal: "spiffe://foo.bar.com/ns/sub/sa/ham"},
+					Destination: &authz.AttributeContext_Peer{Principal: "spiffe://foo.bar.com/ns/sub/sa/ham"},
+				}}
+				if malformedSrc {
+					req.Attributes.Source.Principal = id
+				} else {
+					req.Attributes.Destination.Principal = id
+				}
+				_, err = NewRequestCache(policystore.NewPolicyStore(), req)
+				Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestInitSourceBadSpiffe(t *testing.T) {
 	RegisterTestingT(t)
 
@@ -151,6 +186,52 @@ func TestInitPeerBothLabels(t *testing.T) {
 	Expect(uut.DestinationPeer().Labels).To(Equal(map[string]string{"k3": "v3", "k4": "v4", "k7": "v7", "k8": "v8"}))
 }
 
+// Without a destination principal, the destination service account is resolved from the store by IP.
+func TestInitDestinationPeerFromStore(t *testing.T) {
+	RegisterTestingT(t)
+
+	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{
+			Principal: "spiffe://foo.bar.com/ns/sandwich/sa/bacon",
+		},
+		Destination: &authz.AttributeContext_Peer{
+			Address: &core.Address{Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{Address: "10.0.0.1"},
+			}},
+		},
+	}}
+	store := policystore.NewPolicyStore()
+	store.EndpointByIP["10.0.0.1"] = &proto.WorkloadEndpoint{
+		Name:       "ham-pod",
+		ProfileIds: []string{"kns.sub", "ksa.sub.ham"},
+	}
+	id := proto.ServiceAccountID{Name: "ham", Namespace: "sub"}
+	store.ServiceAccountByID[id] = &proto.ServiceAccountUpdate{
+		Id:     &id,
+		Labels: map[string]string{"k7": "v7"},
+	}
+	uut, err := NewRequestCache(store, req)
+	Expect(err).To(Succeed())
+	Expect(uut.DestinationPeer().Name).To(Equal("ham"))
+	Expect(uut.DestinationPeer().Namespace).To(Equal("sub"))
+	Expect(uut.DestinationPeer().Labels).To(Equal(map[string]string{"k7": "v7"}))
+	Expect(uut.DestinationNamespace().Name).To(Equal("sub"))
+
+	// The principal takes precedence over the store.
+	req.Attributes.Destination.Principal = "spiffe://foo.bar.com/ns/other/sa/eggs"
+	uut, err = NewRequestCache(store, req)
+	Expect(err).To(Succeed())
+	Expect(uut.DestinationPeer().Name).To(Equal("eggs"))
+	Expect(uut.DestinationPeer().Namespace).To(Equal("other"))
+
+	// Unknown destinations have no service account.
+	req.Attributes.Destination.Principal = ""
+	req.Attributes.Destination.Address.GetSocketAddress().Address = "10.0.0.2"
+	uut, err = NewRequestCache(store, req)
+	Expect(err).To(Succeed())
+	Expect(uut.DestinationPeer().Name).To(Equal(""))
+}
+
 func TestInitDestinationBadSpiffe(t *testing.T) {
 	RegisterTestingT(t)
 
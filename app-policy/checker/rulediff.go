@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+// RuleDiff summarizes the differences between two lists of rules, for tooling that shows the effect of a policy change
+// on the checker.  Rules are compared by position, since the order of rules is significant.
+type RuleDiff struct {
+	// Added holds the rules at the end of the new list that have no counterpart in the old list.
+	Added []*proto.Rule
+	// Removed holds the rules at the end of the old list that have no counterpart in the new list.
+	Removed []*proto.Rule
+	// Changed holds the rules present in both lists whose clauses differ, in order.
+	Changed []RuleChange
+}
+
+// Empty returns true if the two lists of rules are the same.
+func (d RuleDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// RuleChange describes the differences between the rules at the same index in two lists of rules.
+type RuleChange struct {
+	Index   int
+	Clauses []ClauseChange
+}
+
+// ClauseChange describes a clause of a rule that has been added, removed or changed.  Clause is the name of the
+// clause's field in the Rule protobuf, for example "dst_ports".  Old and New hold the values of the field, which are
+// nil if the clause is absent from that version of the rule.
+type ClauseChange struct {
+	Clause string
+	Old    interface{}
+	New    interface{}
+}
+
+// DiffRules compares two lists of rules, such as the inbound rules of a policy before and after a change, and returns
+// the rules that have been added and removed and the clauses that have changed in the rest.
+func DiffRules(old, new []*proto.Rule) RuleDiff {
+	var d RuleDiff
+	common := min(len(old), len(new))
+	for i := 0; i < common; i++ {
+		if clauses := diffRuleClauses(old[i], new[i]); len(clauses) > 0 {
+			d.Changed = append(d.Changed, RuleChange{Index: i, Clauses: clauses})
+		}
+	}
+	d.Added = new[common:]
+	d.Removed = old[common:]
+	if len(d.Added) == 0 {
+		d.Added = nil
+	}
+	if len(d.Removed) == 0 {
+		d.Removed = nil
+	}
+	return d
+}
+
+// diffRuleClauses returns the clauses that differ between two rules, in the order of the Rule protobuf's fields.
+func diffRuleClauses(old, new *proto.Rule) []ClauseChange {
+	if old == nil {
+		old = &proto.Rule{}
+	}
+	if new == nil {
+		new = &proto.Rule{}
+	}
+	oldV := reflect.ValueOf(old).Elem()
+	newV := reflect.ValueOf(new).Elem()
+	var changes []ClauseChange
+	for i := 0; i < oldV.NumField(); i++ {
+		name := ruleClauseName(oldV.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		o, n := clauseValue(oldV.Field(i)), clauseValue(newV.Field(i))
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		changes = append(changes, ClauseChange{Clause: name, Old: o, New: n})
+	}
+	return changes
+}
+
+// ruleClauseName returns the protobuf name of a field of the Rule struct, or "" if the field isn't part of the
+// protobuf.
+func ruleClauseName(f reflect.StructField) string {
+	if name := f.Tag.Get("protobuf_oneof"); name != "" {
+		return name
+	}
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(opt, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// clauseValue returns the value of a field of a rule, or nil if the field is unset.  Empty lists and zero values are
+// treated as unset, in the same way as the protobuf encoding treats them.
+func clauseValue(v reflect.Value) interface{} {
+	if v.IsZero() || ((v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.Len() == 0) {
+		return nil
+	}
+	return v.Interface()
+}
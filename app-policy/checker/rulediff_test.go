@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func TestDiffRules(t *testing.T) {
+	RegisterTestingT(t)
+
+	oldRules := []*proto.Rule{
+		{
+			Action:   "allow",
+			SrcNet:   []string{"10.0.0.0/8"},
+			DstPorts: []*proto.PortRange{{First: 80, Last: 80}},
+		},
+		{
+			Action: "deny",
+			DstNet: []string{"192.168.0.0/16"},
+		},
+		{
+			Action: "allow",
+			Icmp:   &proto.Rule_IcmpType{IcmpType: 8},
+		},
+		{
+			Action: "log",
+		},
+	}
+	newRules := []*proto.Rule{
+		{
+			Action:   "allow",
+			SrcNet:   []string{"10.0.0.0/16"},
+			DstPorts: []*proto.PortRange{{First: 80, Last: 80}, {First: 443, Last: 443}},
+		},
+		{
+			Action:   "deny",
+			SrcPorts: []*proto.PortRange{{First: 1024, Last: 65535}},
+		},
+		{
+			Action: "allow",
+			Icmp:   &proto.Rule_IcmpType{IcmpType: 8},
+			DstNet: []string{},
+		},
+	}
+
+	diff := DiffRules(oldRules, newRules)
+	Expect(diff.Empty()).To(BeFalse())
+	Expect(diff.Added).To(BeEmpty())
+	Expect(diff.Removed).To(Equal([]*proto.Rule{{Action: "log"}}))
+	Expect(diff.Changed).To(Equal([]RuleChange{
+		{
+			Index: 0,
+			Clauses: []ClauseChange{
+				{Clause: "src_net", Old: []string{"10.0.0.0/8"}, New: []string{"10.0.0.0/16"}},
+				{
+					Clause: "dst_ports",
+					Old:    []*proto.PortRange{{First: 80, Last: 80}},
+					New:    []*proto.PortRange{{First: 80, Last: 80}, {First: 443, Last: 443}},
+				},
+			},
+		},
+		{
+			Index: 1,
+			Clauses: []ClauseChange{
+				// A clause that is only in the new rule has been added, and one only in the old rule removed.
+				{Clause: "src_ports", New: []*proto.PortRange{{First: 1024, Last: 65535}}},
+				{Clause: "dst_net", Old: []string{"192.168.0.0/16"}},
+			},
+		},
+	}))
+
+	diff = DiffRules(newRules[:1], newRules)
+	Expect(diff.Changed).To(BeEmpty())
+	Expect(diff.Removed).To(BeEmpty())
+	Expect(diff.Added).To(Equal(newRules[1:]))
+
+	Expect(DiffRules(oldRules, oldRules).Empty()).To(BeTrue())
+}
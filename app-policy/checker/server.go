@@ -26,17 +26,100 @@ import (
 	type_v2 "github.com/envoyproxy/go-control-plane/envoy/type"
 	_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/genproto/googleapis/rpc/status"
 )
 
 type authServer struct {
 	stores <-chan *policystore.PolicyStore
 	Store  *policystore.PolicyStore
+
+	malformedRequestAction MalformedRequestAction
+	tracer                 trace.Tracer
+	decisionLogger         DecisionLogger
+	config                 checkConfig
+}
+
+// ServerOption configures an authServer.
+type ServerOption func(*authServer)
+
+// WithMalformedRequestAction sets the action to take for CheckRequests that are missing required attributes. The
+// default is to deny them.
+func WithMalformedRequestAction(a MalformedRequestAction) ServerOption {
+	return func(s *authServer) {
+		s.malformedRequestAction = a
+	}
+}
+
+// WithAllowedHTTPMethods sets a global allowlist of HTTP methods.  Any HTTP request whose method is not in the list is
+// denied before any policy is evaluated.  By default, or if the list is empty, all methods are allowed.
+func WithAllowedHTTPMethods(methods []string) ServerOption {
+	return func(s *authServer) {
+		s.config.allowedHTTPMethods = methods
+	}
+}
+
+// WithTrustedProxyCIDRs sets the addresses of the proxies that are trusted to report the client address in the
+// X-Forwarded-For header.  Source net clauses then match the client address that the header reports, rather than the
+// address of the immediate peer, when the peer is one of those proxies.
+func WithTrustedProxyCIDRs(cidrs []string) ServerOption {
+	return func(s *authServer) {
+		s.config.trustedProxyCIDRs = cidrs
+	}
+}
+
+// WithUnknownClauseBehavior sets how rule clauses are treated when the request lacks the data needed to evaluate them.
+// The default is UnknownClauseNoMatch.
+func WithUnknownClauseBehavior(b UnknownClauseBehavior) ServerOption {
+	return func(s *authServer) {
+		s.config.unknownClauseBehavior = b
+	}
+}
+
+// WithMissingDataBehavior sets how rules that refer to data missing from the store, such as an IP set that hasn't been
+// synced yet, are treated.  The default is FailClosed.
+func WithMissingDataBehavior(b MissingDataBehavior) ServerOption {
+	return func(s *authServer) {
+		s.config.missingDataBehavior = b
+	}
+}
+
+// WithDenyHairpin restricts hairpin requests, whose source and destination are the same IP, to the Allow rules that
+// set allow_hairpin.
+func WithDenyHairpin() ServerOption {
+	return func(s *authServer) {
+		s.config.denyHairpin = true
+	}
+}
+
+// WithResponsePhase indicates that checks are made after requests complete, so that rules can match on the response
+// code and request duration that Envoy passes in the metadata.
+func WithResponsePhase() ServerOption {
+	return func(s *authServer) {
+		s.config.responsePhase = true
+	}
+}
+
+// WithReverseDNS enables rules that match on the reverse-DNS names of the destination, reading them from the given
+// cache, for example a policystore.CachingReverseDNSResolver.  Checks never wait for DNS: until the cache has resolved
+// a destination, a rule's reverse-DNS names clause is unknown, and is treated as configured by
+// WithUnknownClauseBehavior.  Without this option, no destination has a reverse-DNS name.
+func WithReverseDNS(cache policystore.ReverseDNSCache) ServerOption {
+	return func(s *authServer) {
+		s.config.reverseDNS = cache
+	}
 }
 
 // NewServer creates a new authServer and returns a pointer to it.
-func NewServer(ctx context.Context, stores <-chan *policystore.PolicyStore) *authServer {
-	s := &authServer{stores, nil}
+func NewServer(ctx context.Context, stores <-chan *policystore.PolicyStore, opts ...ServerOption) *authServer {
+	s := &authServer{
+		stores:                 stores,
+		malformedRequestAction: MalformedRequestDeny,
+		decisionLogger:         NoOpDecisionLogger{},
+	}
+	for _, o := range opts {
+		o(s)
+	}
 	go s.updateStores(ctx)
 	return s
 }
@@ -53,6 +136,12 @@ func (as *authServer) Check(ctx context.Context, req *authz.CheckRequest) (*auth
 	}).Debug("Check start")
 	resp := authz.CheckResponse{Status: &status.Status{Code: INTERNAL}}
 	var st status.Status
+	matched := NoMatch
+	endSpan := as.startCheckSpan(ctx, req)
+	defer func() {
+		endSpan(resp.Status, matched.String())
+		as.decisionLogger.LogDecision(newDecision(req, resp.Status, matched))
+	}()
 
 	// Ensure that we only access as.Store once per Check call. The authServer can be updated to point to a different
 	// store asynchronously with this call, so we use a local variable to reference the PolicyStore for the duration of
@@ -63,7 +152,12 @@ func (as *authServer) Check(ctx context.Context, req *authz.CheckRequest) (*auth
 		resp.Status.Code = UNAVAILABLE
 		return &resp, nil
 	}
-	store.Read(func(ps *policystore.PolicyStore) { st = checkStore(ps, req) })
+	if err := validateCheckRequest(req); err != nil {
+		log.WithError(err).WithField("action", as.malformedRequestAction).Warn("Malformed CheckRequest.")
+		resp.Status.Code = as.malformedRequestAction.statusCode()
+		return &resp, nil
+	}
+	store.Read(func(ps *policystore.PolicyStore) { st, matched = checkStoreWithConfig(ps, req, as.config) })
 	resp.Status = &st
 	log.WithFields(log.Fields{
 		"Req.Method":               req.GetAttributes().GetRequest().GetHttp().GetMethod(),
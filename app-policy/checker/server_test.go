@@ -18,8 +18,12 @@ import (
 	"context"
 	"testing"
 
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/genproto/googleapis/rpc/status"
 
 	"github.com/projectcalico/calico/app-policy/policystore"
@@ -75,3 +79,187 @@ func TestCheckStore(t *testing.T) {
 	}
 	Eventually(chk).Should(Equal(&authz.CheckResponse{Status: &status.Status{Code: OK}}))
 }
+
+// Malformed requests get the configured action, without evaluating policy.
+func TestCheckMalformedRequest(t *testing.T) {
+	testCases := []struct {
+		title  string
+		opts   []ServerOption
+		req    *authz.CheckRequest
+		result int32
+	}{
+		{"default, empty request", nil, &authz.CheckRequest{}, PERMISSION_DENIED},
+		{"deny, empty request", []ServerOption{WithMalformedRequestAction(MalformedRequestDeny)}, &authz.CheckRequest{}, PERMISSION_DENIED},
+		{"allow, empty request", []ServerOption{WithMalformedRequestAction(MalformedRequestAllow)}, &authz.CheckRequest{}, OK},
+		{"error, empty request", []ServerOption{WithMalformedRequestAction(MalformedRequestError)}, &authz.CheckRequest{}, INVALID_ARGUMENT},
+		{"error, no destination", []ServerOption{WithMalformedRequestAction(MalformedRequestError)}, &authz.CheckRequest{
+			Attributes: &authz.AttributeContext{Source: &authz.AttributeContext_Peer{}},
+		}, INVALID_ARGUMENT},
+		{"allow, no source", []ServerOption{WithMalformedRequestAction(MalformedRequestAllow)}, &authz.CheckRequest{
+			Attributes: &authz.AttributeContext{Destination: &authz.AttributeContext_Peer{}},
+		}, OK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stores := make(chan *policystore.PolicyStore)
+			uut := NewServer(ctx, stores, tc.opts...)
+			// A store whose policy denies everything, so an OK can only come from the malformed request action.
+			store := policystore.NewPolicyStore()
+			store.Endpoint = &proto.WorkloadEndpoint{}
+			stores <- store
+			Eventually(func() int32 {
+				rsp, err := uut.Check(ctx, tc.req)
+				Expect(err).ToNot(HaveOccurred())
+				return rsp.GetStatus().GetCode()
+			}).Should(Equal(tc.result))
+		})
+	}
+}
+
+func TestParseMalformedRequestAction(t *testing.T) {
+	RegisterTestingT(t)
+
+	for s, a := range map[string]MalformedRequestAction{
+		"deny":  MalformedRequestDeny,
+		"Allow": MalformedRequestAllow,
+		"ERROR": MalformedRequestError,
+	} {
+		parsed, err := ParseMalformedRequestAction(s)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(a))
+	}
+	_, err := ParseMalformedRequestAction("drop")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestParseUnknownClauseBehavior(t *testing.T) {
+	RegisterTestingT(t)
+
+	for s, b := range map[string]UnknownClauseBehavior{
+		"no-match":    UnknownClauseNoMatch,
+		"Match":       UnknownClauseMatch,
+		"FAIL-CLOSED": UnknownClauseFailClosed,
+	} {
+		parsed, err := ParseUnknownClauseBehavior(s)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(b))
+	}
+	_, err := ParseUnknownClauseBehavior("fail-open")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestParseMissingDataBehavior(t *testing.T) {
+	RegisterTestingT(t)
+
+	for s, b := range map[string]MissingDataBehavior{
+		"fail-closed": FailClosed,
+		"Fail-Open":   FailOpen,
+	} {
+		parsed, err := ParseMissingDataBehavior(s)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(b))
+	}
+	_, err := ParseMissingDataBehavior("no-match")
+	Expect(err).To(HaveOccurred())
+}
+
+// A rule that refers to an IP set missing from the store is treated as the server's missing data behavior says.
+func TestCheckMissingDataBehavior(t *testing.T) {
+	testCases := []struct {
+		title  string
+		opts   []ServerOption
+		result int32
+	}{
+		{"default", nil, PERMISSION_DENIED},
+		{"fail closed", []ServerOption{WithMissingDataBehavior(FailClosed)}, PERMISSION_DENIED},
+		{"fail open", []ServerOption{WithMissingDataBehavior(FailOpen)}, OK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stores := make(chan *policystore.PolicyStore)
+			uut := NewServer(ctx, stores, tc.opts...)
+			store := policystore.NewPolicyStore()
+			store.Endpoint = &proto.WorkloadEndpoint{ProfileIds: []string{"default"}}
+			store.ProfileByID[proto.ProfileID{Name: "default"}] = &proto.Profile{
+				InboundRules: []*proto.Rule{{Action: "Allow", SrcIpSetIds: []string{"unsynced"}}},
+			}
+			stores <- store
+
+			req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+				Source:      &authz.AttributeContext_Peer{},
+				Destination: &authz.AttributeContext_Peer{},
+			}}
+			Eventually(func() int32 {
+				rsp, err := uut.Check(ctx, req)
+				Expect(err).ToNot(HaveOccurred())
+				return rsp.GetStatus().GetCode()
+			}).Should(Equal(tc.result))
+		})
+	}
+}
+
+// With a tracer, each check emits a span recording the decision, the matched policy and the flow tuple.
+func TestCheckTracing(t *testing.T) {
+	RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	stores := make(chan *policystore.PolicyStore)
+	uut := NewServer(ctx, stores, WithTracer(tp.Tracer("test")))
+
+	store := policystore.NewPolicyStore()
+	store.Write(func(s *policystore.PolicyStore) {
+		s.Endpoint = &proto.WorkloadEndpoint{
+			ProfileIds: []string{"default"},
+		}
+		s.ProfileByID[proto.ProfileID{Name: "default"}] = &proto.Profile{
+			InboundRules: []*proto.Rule{{Action: "Allow"}},
+		}
+	})
+	stores <- store
+	Eventually(func() *policystore.PolicyStore { return uut.Store }).Should(Equal(store))
+
+	addr := func(ip string, port uint32) *core.Address {
+		return &core.Address{Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+			Address:       ip,
+			Protocol:      core.SocketAddress_TCP,
+			PortSpecifier: &core.SocketAddress_PortValue{PortValue: port},
+		}}}
+	}
+	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source:      &authz.AttributeContext_Peer{Address: addr("10.0.0.1", 34567)},
+		Destination: &authz.AttributeContext_Peer{Address: addr("10.0.0.2", 8080)},
+	}}
+	for i := 0; i < 2; i++ {
+		rsp, err := uut.Check(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rsp.GetStatus().GetCode()).To(Equal(OK))
+	}
+
+	spans := exporter.GetSpans()
+	Expect(spans).To(HaveLen(2))
+	for _, span := range spans {
+		Expect(span.Name).To(Equal("dikastes.Check"))
+		Expect(span.Attributes).To(ConsistOf(
+			attribute.String(spanAttrDecision, "OK"),
+			attribute.String(spanAttrMatchedPolicy, "profile/default"),
+			attribute.String(spanAttrSrcIP, "10.0.0.1"),
+			attribute.Int64(spanAttrSrcPort, 34567),
+			attribute.String(spanAttrDstIP, "10.0.0.2"),
+			attribute.Int64(spanAttrDstPort, 8080),
+			attribute.String(spanAttrProtocol, "TCP"),
+		))
+	}
+}
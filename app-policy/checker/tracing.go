@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Attributes recorded on the span emitted for each check.
+const (
+	spanAttrDecision      = "calico.authz.decision"
+	spanAttrMatchedPolicy = "calico.authz.matched_policy"
+	spanAttrSrcIP         = "calico.flow.src_ip"
+	spanAttrSrcPort       = "calico.flow.src_port"
+	spanAttrDstIP         = "calico.flow.dst_ip"
+	spanAttrDstPort       = "calico.flow.dst_port"
+	spanAttrProtocol      = "calico.flow.protocol"
+)
+
+// WithTracer makes the server emit a span for each check, recording the decision, the policy that determined it and
+// the flow tuple.  By default, no spans are emitted.
+func WithTracer(t trace.Tracer) ServerOption {
+	return func(s *authServer) {
+		s.tracer = t
+	}
+}
+
+// startCheckSpan starts the span for a check, if the server has a tracer.  The returned function ends the span, after
+// recording the decision; it is safe to call even if there is no tracer.
+func (as *authServer) startCheckSpan(ctx context.Context, req *authz.CheckRequest) func(st *status.Status, matched string) {
+	if as.tracer == nil {
+		return func(*status.Status, string) {}
+	}
+	src := req.GetAttributes().GetSource().GetAddress().GetSocketAddress()
+	dst := req.GetAttributes().GetDestination().GetAddress().GetSocketAddress()
+	_, span := as.tracer.Start(ctx, "dikastes.Check", trace.WithAttributes(
+		attribute.String(spanAttrSrcIP, src.GetAddress()),
+		attribute.Int64(spanAttrSrcPort, int64(src.GetPortValue())),
+		attribute.String(spanAttrDstIP, dst.GetAddress()),
+		attribute.Int64(spanAttrDstPort, int64(dst.GetPortValue())),
+		attribute.String(spanAttrProtocol, dst.GetProtocol().String()),
+	))
+	return func(st *status.Status, matched string) {
+		span.SetAttributes(
+			attribute.String(spanAttrDecision, code.Code(st.GetCode()).String()),
+			attribute.String(spanAttrMatchedPolicy, matched),
+		)
+		span.End()
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -54,6 +55,19 @@ Options:
   -h --help              Show this screen.
   -l --listen <port>     Unix domain socket path [default: /var/run/dikastes/dikastes.sock]
   -d --dial <target>     Target to dial. [default: localhost:50051]
+  --compile-cache-size <n>  Maximum number of compiled selectors and CIDRs to cache. [default: 1000]
+  --malformed-request-action <action>  Action for requests missing a source or destination: deny, allow or error. [default: deny]
+  --selector-failure-behavior <behavior>  How to treat a rule clause whose label selector fails to compile: fail-closed or fail-open. [default: fail-closed]
+  --missing-data-behavior <behavior>  How to treat a rule that refers to IP sets that haven't been synced: fail-closed or fail-open. [default: fail-closed]
+  --unknown-clause-behavior <behavior>  How to treat a rule clause that can't be evaluated because the request lacks the data it needs: no-match, match or fail-closed. [default: no-match]
+  --identity-extractor <name>  How to find the service accounts of the peers of a request. [default: spiffe]
+  --allowed-http-methods <methods>  Comma-separated list of HTTP methods to allow; requests with any other method are denied before policy is evaluated. By default, all methods are allowed.
+  --trusted-proxy-cidrs <cidrs>  Comma-separated list of CIDRs of the proxies that are trusted to report the client address in the X-Forwarded-For header.
+  --deny-hairpin         Deny requests whose source and destination are the same IP unless an Allow rule sets allow_hairpin.
+  --response-phase       Checks are made after requests complete, so rules can match on the response code and duration.
+  --cluster-state        Ask Felix for the cluster's hosts, IP pools, services and routes, so that rules can match on them.
+  --reverse-dns-cache-ttl <duration>  Enable rules that match on the reverse-DNS names of the destination, caching lookups for the given duration, for example 5m.  Names are resolved in the background; until a destination has been resolved, its reverse-DNS names clause is treated as set by --unknown-clause-behavior.
+  --decision-log <path>  Write a JSON record of each decision to the given file, or to stdout if the path is "-".
   --debug                Log at Debug level.`
 
 var VERSION string
@@ -77,7 +91,77 @@ func main() {
 func runServer(arguments map[string]interface{}) {
 	filePath := arguments["--listen"].(string)
 	dial := arguments["--dial"].(string)
-	_, err := os.Stat(filePath)
+	cacheSize, err := strconv.Atoi(arguments["--compile-cache-size"].(string))
+	if err != nil || cacheSize <= 0 {
+		log.WithField("compile-cache-size", arguments["--compile-cache-size"]).Fatal("Invalid compile cache size.")
+	}
+	checker.SetCompileCacheSize(cacheSize)
+	malformedAction, err := checker.ParseMalformedRequestAction(arguments["--malformed-request-action"].(string))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid malformed request action.")
+	}
+	selectorFailureBehavior, err := checker.ParseSelectorFailureBehavior(arguments["--selector-failure-behavior"].(string))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid selector failure behavior.")
+	}
+	checker.SetSelectorFailureBehavior(selectorFailureBehavior)
+	missingDataBehavior, err := checker.ParseMissingDataBehavior(arguments["--missing-data-behavior"].(string))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid missing data behavior.")
+	}
+	identityExtractor, err := checker.IdentityExtractorByName(arguments["--identity-extractor"].(string))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid identity extractor.")
+	}
+	checker.SetIdentityExtractor(identityExtractor)
+	unknownClauseBehavior, err := checker.ParseUnknownClauseBehavior(arguments["--unknown-clause-behavior"].(string))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid unknown clause behavior.")
+	}
+	serverOpts := []checker.ServerOption{
+		checker.WithMalformedRequestAction(malformedAction),
+		checker.WithUnknownClauseBehavior(unknownClauseBehavior),
+		checker.WithMissingDataBehavior(missingDataBehavior),
+	}
+	if methods, ok := arguments["--allowed-http-methods"].(string); ok && methods != "" {
+		serverOpts = append(serverOpts, checker.WithAllowedHTTPMethods(strings.Split(methods, ",")))
+	}
+	if cidrs, ok := arguments["--trusted-proxy-cidrs"].(string); ok && cidrs != "" {
+		trusted := strings.Split(cidrs, ",")
+		for _, cidr := range trusted {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				log.WithError(err).WithField("trusted-proxy-cidrs", cidrs).Fatal("Invalid trusted proxy CIDR.")
+			}
+		}
+		serverOpts = append(serverOpts, checker.WithTrustedProxyCIDRs(trusted))
+	}
+	if arguments["--deny-hairpin"].(bool) {
+		serverOpts = append(serverOpts, checker.WithDenyHairpin())
+	}
+	if arguments["--response-phase"].(bool) {
+		serverOpts = append(serverOpts, checker.WithResponsePhase())
+	}
+	if ttl, ok := arguments["--reverse-dns-cache-ttl"].(string); ok {
+		d, err := time.ParseDuration(ttl)
+		if err != nil || d <= 0 {
+			log.WithField("reverse-dns-cache-ttl", ttl).Fatal("Invalid reverse-DNS cache TTL.")
+		}
+		resolver := policystore.NewCachingReverseDNSResolver(net.DefaultResolver, d)
+		serverOpts = append(serverOpts, checker.WithReverseDNS(resolver))
+	}
+	if path, ok := arguments["--decision-log"].(string); ok {
+		var w io.Writer = os.Stdout
+		if path != "-" {
+			f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				log.WithError(err).WithField("decision-log", path).Fatal("Unable to open decision log.")
+			}
+			defer f.Close()
+			w = f
+		}
+		serverOpts = append(serverOpts, checker.WithDecisionLogger(checker.NewJSONDecisionLogger(w)))
+	}
+	_, err = os.Stat(filePath)
 	if !os.IsNotExist(err) {
 		// file exists, try to delete it.
 		err := os.Remove(filePath)
@@ -107,7 +191,7 @@ func runServer(arguments map[string]interface{}) {
 	// Check server
 	gs := grpc.NewServer()
 	stores := make(chan *policystore.PolicyStore)
-	checkServer := checker.NewServer(ctx, stores)
+	checkServer := checker.NewServer(ctx, stores, serverOpts...)
 	authz.RegisterAuthorizationServer(gs, checkServer)
 	checkServerV2 := checkServer.V2Compat()
 	authz_v2alpha.RegisterAuthorizationServer(gs, checkServerV2)
@@ -115,7 +199,11 @@ func runServer(arguments map[string]interface{}) {
 
 	// Synchronize the policy store
 	opts := uds.GetDialOptions()
-	syncClient := syncher.NewClient(dial, opts)
+	var clientOpts []syncher.ClientOption
+	if arguments["--cluster-state"].(bool) {
+		clientOpts = append(clientOpts, syncher.WithClusterState())
+	}
+	syncClient := syncher.NewClient(dial, opts, clientOpts...)
 
 	// Register the health check service, which reports the syncClient's inSync status.
 	proto.RegisterHealthzServer(gs, health.NewHealthCheckService(syncClient))
@@ -163,6 +251,11 @@ func runServer(arguments map[string]interface{}) {
 
 func runClient(arguments map[string]interface{}) {
 	dial := arguments["--dial"].(string)
+	cacheSize, err := strconv.Atoi(arguments["--compile-cache-size"].(string))
+	if err != nil || cacheSize <= 0 {
+		log.WithField("compile-cache-size", arguments["--compile-cache-size"]).Fatal("Invalid compile cache size.")
+	}
+	checker.SetCompileCacheSize(cacheSize)
 	namespace := arguments["<namespace>"].(string)
 	account := arguments["<account>"].(string)
 	useMethod := arguments["--method"].(bool)
@@ -16,9 +16,12 @@ package policystore
 
 import (
 	"fmt"
+	"math/bits"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	syncapi "github.com/projectcalico/calico/felix/proto"
 
@@ -45,6 +48,13 @@ type IPSet interface {
 
 	// Test if the address is contained in the set.
 	ContainsAddress(addr *envoyapi.Address) bool
+
+	// Members returns the members of the set, sorted, in the same format as AddString.  For NET sets, individual IPs
+	// are returned as full-length prefixes.
+	Members() []string
+
+	// Len returns the number of members of the set.
+	Len() int
 }
 
 // We'll use golang's map type under the covers here because it is simple to implement.
@@ -64,6 +74,99 @@ func NewIPSet(t syncapi.IPSetUpdate_IPSetType) IPSet {
 	panic("Unrecognized IPSet type")
 }
 
+// TimedIPSet is an IPSet that records when each of its members was added.
+type TimedIPSet interface {
+	IPSet
+
+	// Type returns the type of the set.
+	Type() syncapi.IPSetUpdate_IPSetType
+
+	// AddedAt returns the time at which the address became a member of the set, that is, the earliest time at which
+	// one of the members that contains it was added.  It returns false if the set doesn't contain the address.
+	AddedAt(addr *envoyapi.Address) (time.Time, bool)
+
+	// ReplaceMembers replaces the members of the set with the given ones.  Members that were already in the set keep
+	// the time at which they were added.
+	ReplaceMembers(members []string)
+}
+
+// timedIPSet wraps an IPSet, recording the time at which each member was added, keyed by the member as it was added.
+type timedIPSet struct {
+	IPSet
+	t       syncapi.IPSetUpdate_IPSetType
+	now     func() time.Time
+	addedAt map[string]time.Time
+}
+
+// NewTimedIPSet creates a TimedIPSet of the type given by t, which uses now to find the time at which each member is
+// added.
+func NewTimedIPSet(t syncapi.IPSetUpdate_IPSetType, now func() time.Time) TimedIPSet {
+	return &timedIPSet{IPSet: NewIPSet(t), t: t, now: now, addedAt: map[string]time.Time{}}
+}
+
+func (s *timedIPSet) Type() syncapi.IPSetUpdate_IPSetType {
+	return s.t
+}
+
+func (s *timedIPSet) AddString(member string) {
+	if _, ok := s.addedAt[member]; !ok {
+		s.addedAt[member] = s.now()
+	}
+	s.IPSet.AddString(member)
+}
+
+func (s *timedIPSet) RemoveString(member string) {
+	delete(s.addedAt, member)
+	s.IPSet.RemoveString(member)
+}
+
+func (s *timedIPSet) ReplaceMembers(members []string) {
+	wanted := make(map[string]bool, len(members))
+	for _, m := range members {
+		wanted[m] = true
+	}
+	for m := range s.addedAt {
+		if !wanted[m] {
+			s.RemoveString(m)
+		}
+	}
+	for _, m := range members {
+		s.AddString(m)
+	}
+}
+
+func (s *timedIPSet) AddedAt(addr *envoyapi.Address) (time.Time, bool) {
+	switch s.t {
+	case syncapi.IPSetUpdate_IP:
+		t, ok := s.addedAt[addr.GetSocketAddress().GetAddress()]
+		return t, ok
+	case syncapi.IPSetUpdate_IP_AND_PORT:
+		t, ok := s.addedAt[ipPortKey(addr)]
+		return t, ok
+	}
+
+	// For NET sets, the address may be contained in several members.  We expect recency clauses to be used with
+	// small sets, so we simply check each member.
+	if !s.ContainsAddress(addr) {
+		return time.Time{}, false
+	}
+	ip := net.ParseIP(addr.GetSocketAddress().GetAddress())
+	var earliest time.Time
+	found := false
+	for member, t := range s.addedAt {
+		memberIP, mask := parseCIDR(member)
+		bits := 8 * net.IPv6len
+		if memberIP.To4() != nil && mask <= 32 {
+			memberIP, bits = memberIP.To4(), 8*net.IPv4len
+		}
+		ipNet := net.IPNet{IP: memberIP, Mask: net.CIDRMask(int(mask), bits)}
+		if ipNet.Contains(ip) && (!found || t.Before(earliest)) {
+			earliest, found = t, true
+		}
+	}
+	return earliest, found
+}
+
 func (m ipMapSet) AddString(ip string) {
 	m[ip] = true
 }
@@ -82,6 +185,14 @@ func (m ipMapSet) ContainsAddress(addr *envoyapi.Address) bool {
 	return m[key]
 }
 
+func (m ipMapSet) Members() []string {
+	return sortedKeys(m)
+}
+
+func (m ipMapSet) Len() int {
+	return len(m)
+}
+
 func (m ipPortMapSet) AddString(ip string) {
 	m[ip] = true
 }
@@ -91,9 +202,7 @@ func (m ipPortMapSet) RemoveString(ip string) {
 }
 
 func (m ipPortMapSet) ContainsAddress(addr *envoyapi.Address) bool {
-	sck := addr.GetSocketAddress()
-	p := strings.ToLower(sck.GetProtocol().String())
-	key := fmt.Sprintf("%v,%v:%d", sck.GetAddress(), p, sck.GetPortValue())
+	key := ipPortKey(addr)
 	log.WithFields(log.Fields{
 		"proto": addr.String(),
 		"key":   key,
@@ -101,6 +210,30 @@ func (m ipPortMapSet) ContainsAddress(addr *envoyapi.Address) bool {
 	return m[key]
 }
 
+func (m ipPortMapSet) Members() []string {
+	return sortedKeys(m)
+}
+
+func (m ipPortMapSet) Len() int {
+	return len(m)
+}
+
+// ipPortKey returns the IP_AND_PORT set member that corresponds to the address.
+func ipPortKey(addr *envoyapi.Address) string {
+	sck := addr.GetSocketAddress()
+	p := strings.ToLower(sck.GetProtocol().String())
+	return fmt.Sprintf("%v,%v:%d", sck.GetAddress(), p, sck.GetPortValue())
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // ipNetSet implements an IPSet of type NET, where the members are CIDRs.  These sets are a combination of endpoint IPs
 // and CIDRs from network sets. We expect at scale for there to be a large number of endpoint IPs and relatively few
 // network set entries.
@@ -168,6 +301,67 @@ func (m ipNetSet) ContainsAddress(addr *envoyapi.Address) bool {
 	}
 }
 
+func (m ipNetSet) Members() []string {
+	var members []string
+	members = m.v4.appendMembers(members, make(net.IP, net.IPv4len), 0, 24)
+	members = m.v6.appendMembers(members, make(net.IP, net.IPv6len), 0, 120)
+	sort.Strings(members)
+	return members
+}
+
+func (m ipNetSet) Len() int {
+	return m.v4.countMembers() + m.v6.countMembers()
+}
+
+// countMembers returns the number of members of the subtree rooted at this node.
+func (n *trieNode) countMembers() int {
+	if n == nil {
+		return 0
+	}
+	count := 0
+	if n.member {
+		count++
+	}
+	if n.bitmap != nil {
+		for _, word := range n.bitmap {
+			count += bits.OnesCount64(word)
+		}
+	}
+	return count + n.children[0].countMembers() + n.children[1].countMembers()
+}
+
+// appendMembers appends the CIDRs of the members of the subtree rooted at this node to the given slice.  prefix holds
+// the first depth bits of the network that corresponds to this node.
+func (n *trieNode) appendMembers(members []string, prefix net.IP, depth, bitmapDepth uint64) []string {
+	if n.member {
+		ipn := net.IPNet{IP: prefix, Mask: net.CIDRMask(int(depth), len(prefix)*8)}
+		members = append(members, ipn.String())
+	}
+	if n.bitmap != nil {
+		for i := 0; i < 256; i++ {
+			if n.bitmap.contains(byte(i)) {
+				ip := make(net.IP, len(prefix))
+				copy(ip, prefix)
+				ip[len(ip)-1] = byte(i)
+				ipn := net.IPNet{IP: ip, Mask: net.CIDRMask(int(bitmapDepth+8), len(ip)*8)}
+				members = append(members, ipn.String())
+			}
+		}
+	}
+	for b, child := range n.children {
+		if child == nil {
+			continue
+		}
+		childPrefix := make(net.IP, len(prefix))
+		copy(childPrefix, prefix)
+		if b == 1 {
+			childPrefix[depth/8] |= 1 << (7 - depth%8)
+		}
+		members = child.appendMembers(members, childPrefix, depth+1, bitmapDepth)
+	}
+	return members
+}
+
 func (n *trieNode) insert(ip net.IP, depth, mask, bitmapDepth uint64) {
 	if depth == mask {
 		// found!
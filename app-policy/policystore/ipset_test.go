@@ -15,6 +15,7 @@ package policystore
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
@@ -286,3 +287,141 @@ func TestIPNetV6(t *testing.T) {
 	Expect(uut.ContainsAddress(&addrfe80_23af_22)).To(BeFalse())
 	Expect(uut.ContainsAddress(&addrfe81_23af_77bd_fe80)).To(BeFalse())
 }
+
+func TestMembers(t *testing.T) {
+	RegisterTestingT(t)
+
+	uut := NewIPSet(proto.IPSetUpdate_IP)
+	uut.AddString("2.2.2.3")
+	uut.AddString("2.2.2.2")
+	Expect(uut.Members()).To(Equal([]string{"2.2.2.2", "2.2.2.3"}))
+
+	uut = NewIPSet(proto.IPSetUpdate_IP_AND_PORT)
+	uut.AddString("2.2.2.2,tcp:80")
+	Expect(uut.Members()).To(Equal([]string{"2.2.2.2,tcp:80"}))
+
+	uut = NewIPSet(proto.IPSetUpdate_NET)
+	Expect(uut.Members()).To(BeEmpty())
+	members := []string{"10.0.0.0/8", "10.1.1.1/32", "10.1.1.200/32", "10.1.1.16/28", "2001:db8::/32", "2001:db8::1/128"}
+	for _, m := range members {
+		uut.AddString(m)
+	}
+	Expect(uut.Members()).To(ConsistOf(members))
+
+	uut.RemoveString("10.1.1.1/32")
+	uut.RemoveString("2001:db8::/32")
+	Expect(uut.Members()).To(ConsistOf("10.0.0.0/8", "10.1.1.200/32", "10.1.1.16/28", "2001:db8::1/128"))
+}
+
+func TestLen(t *testing.T) {
+	RegisterTestingT(t)
+
+	uut := NewIPSet(proto.IPSetUpdate_IP)
+	Expect(uut.Len()).To(Equal(0))
+	uut.AddString("2.2.2.3")
+	uut.AddString("2.2.2.2")
+	uut.AddString("2.2.2.2")
+	Expect(uut.Len()).To(Equal(2))
+
+	uut = NewIPSet(proto.IPSetUpdate_IP_AND_PORT)
+	uut.AddString("2.2.2.2,tcp:80")
+	Expect(uut.Len()).To(Equal(1))
+
+	uut = NewIPSet(proto.IPSetUpdate_NET)
+	Expect(uut.Len()).To(Equal(0))
+	members := []string{"10.0.0.0/8", "10.1.1.1/32", "10.1.1.200/32", "10.1.1.16/28", "2001:db8::/32", "2001:db8::1/128"}
+	for _, m := range members {
+		uut.AddString(m)
+	}
+	Expect(uut.Len()).To(Equal(len(members)))
+
+	uut.RemoveString("10.1.1.1/32")
+	uut.RemoveString("2001:db8::/32")
+	Expect(uut.Len()).To(Equal(4))
+
+	timed := NewTimedIPSet(proto.IPSetUpdate_IP, time.Now)
+	timed.AddString("2.2.2.2")
+	Expect(timed.Len()).To(Equal(1))
+}
+
+// addedAt returns the time at which the address was added to the set, which must contain it.
+func addedAt(s TimedIPSet, addr *envoyapi.Address) time.Time {
+	added, ok := s.AddedAt(addr)
+	ExpectWithOffset(1, ok).To(BeTrue())
+	return added
+}
+
+func TestTimedIPSetAddedAt(t *testing.T) {
+	RegisterTestingT(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	ips := NewTimedIPSet(proto.IPSetUpdate_IP, clock)
+	ips.AddString("2.2.2.2")
+	now = now.Add(time.Minute)
+	ips.AddString("2.2.2.3")
+	// Re-adding a member doesn't change the time it was added.
+	ips.AddString("2.2.2.2")
+	addr := makeIpAddr("2.2.2.2")
+	Expect(addedAt(ips, &addr)).To(Equal(start))
+	addr = makeIpAddr("2.2.2.3")
+	Expect(addedAt(ips, &addr)).To(Equal(start.Add(time.Minute)))
+	addr = makeIpAddr("2.2.2.4")
+	_, ok := ips.AddedAt(&addr)
+	Expect(ok).To(BeFalse())
+	ips.RemoveString("2.2.2.3")
+	addr = makeIpAddr("2.2.2.3")
+	_, ok = ips.AddedAt(&addr)
+	Expect(ok).To(BeFalse())
+	Expect(ips.ContainsAddress(&addr)).To(BeFalse())
+
+	ipPorts := NewTimedIPSet(proto.IPSetUpdate_IP_AND_PORT, clock)
+	ipPorts.AddString("2.2.2.2,tcp:80")
+	addr = makeAddr("2.2.2.2", envoyapi.SocketAddress_TCP, 80)
+	Expect(addedAt(ipPorts, &addr)).To(Equal(now))
+	addr = makeAddr("2.2.2.2", envoyapi.SocketAddress_TCP, 81)
+	_, ok = ipPorts.AddedAt(&addr)
+	Expect(ok).To(BeFalse())
+
+	// For NET sets, an address is a member from the time the first CIDR that contains it was added.
+	nets := NewTimedIPSet(proto.IPSetUpdate_NET, clock)
+	now = start
+	nets.AddString("10.0.0.0/16")
+	now = start.Add(time.Hour)
+	nets.AddString("10.0.1.5/32")
+	nets.AddString("10.1.0.0/24")
+	nets.AddString("fd00::/64")
+	addr = makeIpAddr("10.0.1.5")
+	Expect(addedAt(nets, &addr)).To(Equal(start))
+	addr = makeIpAddr("10.1.0.9")
+	Expect(addedAt(nets, &addr)).To(Equal(start.Add(time.Hour)))
+	addr = makeIpAddr("fd00::1")
+	Expect(addedAt(nets, &addr)).To(Equal(start.Add(time.Hour)))
+	addr = makeIpAddr("10.2.0.1")
+	_, ok = nets.AddedAt(&addr)
+	Expect(ok).To(BeFalse())
+	nets.RemoveString("10.0.0.0/16")
+	addr = makeIpAddr("10.0.1.5")
+	Expect(addedAt(nets, &addr)).To(Equal(start.Add(time.Hour)))
+}
+
+func TestTimedIPSetReplaceMembers(t *testing.T) {
+	RegisterTestingT(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	uut := NewTimedIPSet(proto.IPSetUpdate_IP, func() time.Time { return now })
+	uut.AddString("2.2.2.2")
+	uut.AddString("2.2.2.3")
+
+	now = now.Add(time.Minute)
+	uut.ReplaceMembers([]string{"2.2.2.2", "2.2.2.4"})
+
+	Expect(uut.Members()).To(Equal([]string{"2.2.2.2", "2.2.2.4"}))
+	addr := makeIpAddr("2.2.2.2")
+	Expect(addedAt(uut, &addr)).To(Equal(start))
+	addr = makeIpAddr("2.2.2.4")
+	Expect(addedAt(uut, &addr)).To(Equal(start.Add(time.Minute)))
+	addr = makeIpAddr("2.2.2.3")
+	Expect(uut.ContainsAddress(&addr)).To(BeFalse())
+}
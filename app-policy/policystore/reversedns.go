@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policystore
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// reverseDNSLookupTimeout bounds the time that a background lookup waits for DNS.
+const reverseDNSLookupTimeout = time.Second
+
+// ReverseDNSResolver looks up the names of an IP address from its PTR records.  net.Resolver implements it.
+type ReverseDNSResolver interface {
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+}
+
+// ReverseDNSCache gives the reverse-DNS names of IP addresses without waiting for DNS, so that it can be used while
+// holding the PolicyStore's lock.
+type ReverseDNSCache interface {
+	// CachedLookupAddr returns the cached names of the address, without any trailing dots.  ok is false if the
+	// address hasn't been resolved yet.
+	CachedLookupAddr(addr string) (names []string, ok bool)
+}
+
+// CachingReverseDNSResolver wraps a ReverseDNSResolver, caching the results of its lookups, including failures.  The
+// lookups happen in the background: CachedLookupAddr only reads the cache, starting a lookup for an address that isn't
+// cached and refreshing an entry that has expired, which it keeps returning until the refresh completes.  It is safe
+// for concurrent use.
+type CachingReverseDNSResolver struct {
+	resolver ReverseDNSResolver
+	ttl      time.Duration
+	now      func() time.Time
+
+	lock     sync.Mutex
+	entries  map[string]reverseDNSEntry
+	pending  map[string]bool
+	inFlight sync.WaitGroup
+}
+
+type reverseDNSEntry struct {
+	names   []string
+	expires time.Time
+}
+
+// NewCachingReverseDNSResolver returns a CachingReverseDNSResolver that caches the results of the given resolver for
+// the given TTL.
+func NewCachingReverseDNSResolver(resolver ReverseDNSResolver, ttl time.Duration) *CachingReverseDNSResolver {
+	return &CachingReverseDNSResolver{
+		resolver: resolver,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  map[string]reverseDNSEntry{},
+		pending:  map[string]bool{},
+	}
+}
+
+func (c *CachingReverseDNSResolver) CachedLookupAddr(addr string) ([]string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.entries[addr]
+	if (!ok || !c.now().Before(e.expires)) && !c.pending[addr] {
+		c.pending[addr] = true
+		c.inFlight.Add(1)
+		go c.lookup(addr)
+	}
+	return e.names, ok
+}
+
+// lookup resolves the address and caches the result.  A failed lookup is cached as an address without names, unless
+// it timed out, in which case the next CachedLookupAddr tries again.
+func (c *CachingReverseDNSResolver) lookup(addr string) {
+	defer c.inFlight.Done()
+	ctx, cancel := context.WithTimeout(context.Background(), reverseDNSLookupTimeout)
+	defer cancel()
+	names, err := c.resolver.LookupAddr(ctx, addr)
+	if err != nil {
+		log.WithError(err).WithField("addr", addr).Debug("Reverse-DNS lookup failed")
+		names = nil
+	}
+	trimmed := make([]string, len(names))
+	for i, n := range names {
+		trimmed[i] = strings.TrimSuffix(n, ".")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.pending, addr)
+	if ctx.Err() != nil {
+		return
+	}
+	now := c.now()
+	for a, e := range c.entries {
+		if !now.Before(e.expires) && !c.pending[a] {
+			delete(c.entries, a)
+		}
+	}
+	c.entries[addr] = reverseDNSEntry{names: trimmed, expires: now.Add(c.ttl)}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policystore
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// countingReverseDNSResolver resolves from a fixed map, counting its lookups.  Lookups of addresses in block wait
+// until it is closed.
+type countingReverseDNSResolver struct {
+	names   map[string][]string
+	block   map[string]chan struct{}
+	lock    sync.Mutex
+	lookups int
+}
+
+func (r *countingReverseDNSResolver) LookupAddr(ctx context.Context, addr string) ([]string, error) {
+	r.lock.Lock()
+	r.lookups++
+	r.lock.Unlock()
+	if c, ok := r.block[addr]; ok {
+		select {
+		case <-c:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if names, ok := r.names[addr]; ok {
+		return names, nil
+	}
+	return nil, errors.New("no PTR record")
+}
+
+func TestCachingReverseDNSResolver(t *testing.T) {
+	RegisterTestingT(t)
+
+	upstream := &countingReverseDNSResolver{names: map[string][]string{"10.0.0.1": {"web.example.com."}}}
+	uut := NewCachingReverseDNSResolver(upstream, time.Minute)
+	now := time.Now()
+	uut.now = func() time.Time { return now }
+
+	// The first lookup of an address isn't cached, and starts resolving it in the background.
+	_, ok := uut.CachedLookupAddr("10.0.0.1")
+	Expect(ok).To(BeFalse())
+	_, ok = uut.CachedLookupAddr("10.0.0.2")
+	Expect(ok).To(BeFalse())
+	uut.inFlight.Wait()
+
+	for i := 0; i < 2; i++ {
+		names, ok := uut.CachedLookupAddr("10.0.0.1")
+		Expect(ok).To(BeTrue())
+		Expect(names).To(Equal([]string{"web.example.com"}))
+		// Failures are cached as addresses without names.
+		names, ok = uut.CachedLookupAddr("10.0.0.2")
+		Expect(ok).To(BeTrue())
+		Expect(names).To(BeEmpty())
+	}
+	Expect(upstream.lookups).To(Equal(2))
+
+	// An expired entry is still returned while it is refreshed.
+	now = now.Add(time.Minute)
+	names, ok := uut.CachedLookupAddr("10.0.0.1")
+	Expect(ok).To(BeTrue())
+	Expect(names).To(Equal([]string{"web.example.com"}))
+	uut.inFlight.Wait()
+	Expect(upstream.lookups).To(Equal(3))
+	// The expired entry for the other address was dropped.
+	Expect(uut.entries).To(HaveLen(1))
+}
+
+func TestCachingReverseDNSResolverSingleLookup(t *testing.T) {
+	RegisterTestingT(t)
+
+	release := make(chan struct{})
+	upstream := &countingReverseDNSResolver{
+		names: map[string][]string{"10.0.0.1": {"web.example.com."}},
+		block: map[string]chan struct{}{"10.0.0.1": release},
+	}
+	uut := NewCachingReverseDNSResolver(upstream, time.Minute)
+
+	// Callers don't wait for a slow lookup, and only one lookup of the address is in flight at a time.
+	for i := 0; i < 3; i++ {
+		_, ok := uut.CachedLookupAddr("10.0.0.1")
+		Expect(ok).To(BeFalse())
+	}
+	close(release)
+	uut.inFlight.Wait()
+	Expect(upstream.lookups).To(Equal(1))
+	_, ok := uut.CachedLookupAddr("10.0.0.1")
+	Expect(ok).To(BeTrue())
+}
@@ -15,6 +15,7 @@
 package policystore
 
 import (
+	"sort"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
@@ -35,16 +36,57 @@ type PolicyStore struct {
 	Endpoint           *proto.WorkloadEndpoint
 	ServiceAccountByID map[proto.ServiceAccountID]*proto.ServiceAccountUpdate
 	NamespaceByID      map[proto.NamespaceID]*proto.NamespaceUpdate
+
+	// EndpointByIP indexes the workload endpoints known to the store by each of their IP addresses.
+	EndpointByIP map[string]*proto.WorkloadEndpoint
+
+	// IPPoolByID holds the IP pools sent by Felix, keyed by the ID that Felix assigns them.
+	IPPoolByID map[string]*proto.IPAMPool
+
+	// ServiceByID holds the Kubernetes services sent by Felix, keyed by "<namespace>/<name>".
+	ServiceByID map[string]*proto.ServiceUpdate
+
+	// RouteByDst holds the routes sent by Felix, keyed by destination CIDR.
+	RouteByDst map[string]*proto.RouteUpdate
+
+	// LocalIPAMBlocks holds the routes for the IPAM blocks that are affine to the local host, keyed by block CIDR.  It
+	// is a subset of RouteByDst, maintained in the same way as the dataplane's tunnel managers track their local
+	// blocks.
+	LocalIPAMBlocks map[string]*proto.RouteUpdate
+
+	// LocalHostname is the name of the host that Felix, and so this workload, is running on.  Felix sends it in the
+	// InSync message.
+	LocalHostname string
+
+	// NodeIPByHostname holds the IPv4 addresses of the nodes that Felix has sent host metadata for over the policy
+	// sync API, keyed by hostname.  Use LocalHostname to find the local node's address.
+	NodeIPByHostname map[string]string
+
+	// NodeLabelsByHostname holds the labels of the nodes in the cluster, from the host metadata that Felix sends over
+	// the policy sync API, keyed by hostname.
+	NodeLabelsByHostname map[string]map[string]string
+
+	// NodeASNumberByHostname holds the BGP AS numbers of the nodes in the cluster that have their own, from the same
+	// host metadata, keyed by hostname.  Nodes that use the global default AS number aren't present.
+	NodeASNumberByHostname map[string]string
 }
 
 func NewPolicyStore() *PolicyStore {
 	return &PolicyStore{
-		RWMutex:            sync.RWMutex{},
-		IPSetByID:          make(map[string]IPSet),
-		ProfileByID:        make(map[proto.ProfileID]*proto.Profile),
-		PolicyByID:         make(map[proto.PolicyID]*proto.Policy),
-		ServiceAccountByID: make(map[proto.ServiceAccountID]*proto.ServiceAccountUpdate),
-		NamespaceByID:      make(map[proto.NamespaceID]*proto.NamespaceUpdate),
+		RWMutex:                sync.RWMutex{},
+		IPSetByID:              make(map[string]IPSet),
+		ProfileByID:            make(map[proto.ProfileID]*proto.Profile),
+		PolicyByID:             make(map[proto.PolicyID]*proto.Policy),
+		ServiceAccountByID:     make(map[proto.ServiceAccountID]*proto.ServiceAccountUpdate),
+		NamespaceByID:          make(map[proto.NamespaceID]*proto.NamespaceUpdate),
+		EndpointByIP:           make(map[string]*proto.WorkloadEndpoint),
+		NodeIPByHostname:       make(map[string]string),
+		NodeLabelsByHostname:   make(map[string]map[string]string),
+		NodeASNumberByHostname: make(map[string]string),
+		IPPoolByID:             make(map[string]*proto.IPAMPool),
+		ServiceByID:            make(map[string]*proto.ServiceUpdate),
+		RouteByDst:             make(map[string]*proto.RouteUpdate),
+		LocalIPAMBlocks:        make(map[string]*proto.RouteUpdate),
 	}
 }
 
@@ -76,3 +118,164 @@ func (s *PolicyStore) Read(readFn func(store *PolicyStore)) {
 	log.Debug("PolicyStore read locked")
 	readFn(s)
 }
+
+// Reset clears the store, under the write lock, so that it is equivalent to a new store and can be reused, for
+// example to resync from scratch.  The maps are emptied rather than reallocated.
+func (s *PolicyStore) Reset() {
+	s.Write(func(store *PolicyStore) {
+		log.Debug("Resetting PolicyStore")
+		clear(store.PolicyByID)
+		clear(store.ProfileByID)
+		clear(store.IPSetByID)
+		store.Endpoint = nil
+		clear(store.ServiceAccountByID)
+		clear(store.NamespaceByID)
+		clear(store.EndpointByIP)
+		clear(store.IPPoolByID)
+		clear(store.ServiceByID)
+		clear(store.RouteByDst)
+		clear(store.LocalIPAMBlocks)
+		store.LocalHostname = ""
+		clear(store.NodeIPByHostname)
+		clear(store.NodeLabelsByHostname)
+		clear(store.NodeASNumberByHostname)
+	})
+}
+
+// ReplaceNamespaces replaces all the namespaces in the store with the given ones, under the write lock, so that readers
+// see either the old namespaces or the new ones, never a mixture.  The store takes a copy of the map, but not of the
+// updates it holds.
+func (s *PolicyStore) ReplaceNamespaces(namespaces map[proto.NamespaceID]*proto.NamespaceUpdate) {
+	byID := make(map[proto.NamespaceID]*proto.NamespaceUpdate, len(namespaces))
+	for id, ns := range namespaces {
+		byID[id] = ns
+	}
+	s.Write(func(store *PolicyStore) {
+		log.WithField("numNamespaces", len(byID)).Debug("Replacing all namespaces")
+		store.NamespaceByID = byID
+	})
+}
+
+// RulesReferencingIPSet returns the rules of all the policies and profiles in the store that reference the given IP
+// set, in any of their IP set, named port or IP port set clauses.  The order of the rules is unspecified.  The caller
+// must hold the read lock.
+func (s *PolicyStore) RulesReferencingIPSet(setID string) []*proto.Rule {
+	var rules []*proto.Rule
+	addReferencing := func(rs []*proto.Rule) {
+		for _, r := range rs {
+			if ruleReferencesIPSet(r, setID) {
+				rules = append(rules, r)
+			}
+		}
+	}
+	for _, p := range s.PolicyByID {
+		addReferencing(p.GetInboundRules())
+		addReferencing(p.GetOutboundRules())
+	}
+	for _, p := range s.ProfileByID {
+		addReferencing(p.GetInboundRules())
+		addReferencing(p.GetOutboundRules())
+	}
+	return rules
+}
+
+func ruleReferencesIPSet(r *proto.Rule, setID string) bool {
+	for _, ids := range RuleIPSetIDs(r) {
+		for _, id := range *ids {
+			if id == setID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RuleIPSetIDs returns pointers to the lists of IP set IDs that the rule's clauses reference, so that callers can
+// both read and rewrite them.
+func RuleIPSetIDs(r *proto.Rule) []*[]string {
+	return []*[]string{
+		&r.SrcIpSetIds,
+		&r.NotSrcIpSetIds,
+		&r.DstIpSetIds,
+		&r.NotDstIpSetIds,
+		&r.SrcNamedPortIpSetIds,
+		&r.NotSrcNamedPortIpSetIds,
+		&r.DstNamedPortIpSetIds,
+		&r.NotDstNamedPortIpSetIds,
+		&r.DstIpPortSetIds,
+		&r.NotDstIpPortSetIds,
+		&r.SrcIpPortSetIds,
+		&r.NotSrcIpPortSetIds,
+	}
+}
+
+// ValidateIPSetReferences returns the sorted IDs of the IP sets that the given rules reference but that aren't in the
+// store.  The caller must hold the read lock.
+func (s *PolicyStore) ValidateIPSetReferences(rules []*proto.Rule) []string {
+	missing := map[string]bool{}
+	for _, r := range rules {
+		for _, ids := range RuleIPSetIDs(r) {
+			for _, id := range *ids {
+				if _, ok := s.IPSetByID[id]; !ok {
+					missing[id] = true
+				}
+			}
+		}
+	}
+	var ids []string
+	for id := range missing {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// IPSetDelta holds the members added to and removed from an IP set.
+type IPSetDelta struct {
+	Added   []string
+	Removed []string
+}
+
+// DiffIPSets returns the minimal member changes that turn the IP sets of the old store into those of the new store,
+// keyed by IP set ID.  Sets that only exist in one of the stores have all their members added or removed; sets that
+// are unchanged are omitted.  The caller must hold the read lock on both stores.
+func DiffIPSets(old, new *PolicyStore) map[string]IPSetDelta {
+	deltas := map[string]IPSetDelta{}
+	for id, newSet := range new.IPSetByID {
+		var oldMembers []string
+		if oldSet, ok := old.IPSetByID[id]; ok {
+			oldMembers = oldSet.Members()
+		}
+		if d := diffMembers(oldMembers, newSet.Members()); len(d.Added) > 0 || len(d.Removed) > 0 {
+			deltas[id] = d
+		}
+	}
+	for id, oldSet := range old.IPSetByID {
+		if _, ok := new.IPSetByID[id]; ok {
+			continue
+		}
+		if members := oldSet.Members(); len(members) > 0 {
+			deltas[id] = IPSetDelta{Removed: members}
+		}
+	}
+	return deltas
+}
+
+// diffMembers returns the delta between two sorted lists of members.
+func diffMembers(old, new []string) (d IPSetDelta) {
+	i, j := 0, 0
+	for i < len(old) || j < len(new) {
+		switch {
+		case j == len(new) || (i < len(old) && old[i] < new[j]):
+			d.Removed = append(d.Removed, old[i])
+			i++
+		case i == len(old) || new[j] < old[i]:
+			d.Added = append(d.Added, new[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return
+}
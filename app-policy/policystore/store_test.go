@@ -15,9 +15,13 @@
 package policystore
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
 )
 
 func TestReadBlocksWrite(t *testing.T) {
@@ -67,3 +71,167 @@ func TestReadAllowsRead(t *testing.T) {
 	// Clean up so goroutines end
 	until <- true
 }
+
+func TestDiffIPSets(t *testing.T) {
+	RegisterTestingT(t)
+
+	newSet := func(members ...string) IPSet {
+		s := NewIPSet(proto.IPSetUpdate_IP)
+		for _, m := range members {
+			s.AddString(m)
+		}
+		return s
+	}
+	old := NewPolicyStore()
+	old.IPSetByID["unchanged"] = newSet("10.0.0.1")
+	old.IPSetByID["changed"] = newSet("10.0.0.1", "10.0.0.2", "10.0.0.3")
+	old.IPSetByID["removed"] = newSet("10.0.0.4")
+	new := NewPolicyStore()
+	new.IPSetByID["unchanged"] = newSet("10.0.0.1")
+	new.IPSetByID["changed"] = newSet("10.0.0.2", "10.0.0.3", "10.0.0.5", "10.0.0.6")
+	new.IPSetByID["added"] = newSet("10.0.0.7")
+
+	Expect(DiffIPSets(old, new)).To(Equal(map[string]IPSetDelta{
+		"changed": {Added: []string{"10.0.0.5", "10.0.0.6"}, Removed: []string{"10.0.0.1"}},
+		"added":   {Added: []string{"10.0.0.7"}},
+		"removed": {Removed: []string{"10.0.0.4"}},
+	}))
+	Expect(DiffIPSets(new, new)).To(BeEmpty())
+}
+
+func TestRulesReferencingIPSet(t *testing.T) {
+	RegisterTestingT(t)
+
+	srcRule := &proto.Rule{Action: "Allow", SrcIpSetIds: []string{"s:a", "s:b"}}
+	notDstRule := &proto.Rule{Action: "Deny", NotDstIpSetIds: []string{"s:a"}}
+	namedPortRule := &proto.Rule{Action: "Allow", DstNamedPortIpSetIds: []string{"n:a"}}
+	ipPortRule := &proto.Rule{Action: "Allow", DstIpPortSetIds: []string{"s:a"}}
+	otherRule := &proto.Rule{Action: "Allow", DstIpSetIds: []string{"s:c"}}
+	noSetsRule := &proto.Rule{Action: "Allow"}
+
+	store := NewPolicyStore()
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "p1"}] = &proto.Policy{
+		InboundRules:  []*proto.Rule{srcRule, otherRule},
+		OutboundRules: []*proto.Rule{notDstRule, noSetsRule},
+	}
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "p2"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{namedPortRule},
+	}
+	store.ProfileByID[proto.ProfileID{Name: "prof"}] = &proto.Profile{
+		InboundRules: []*proto.Rule{ipPortRule, noSetsRule},
+	}
+
+	Expect(store.RulesReferencingIPSet("s:a")).To(ConsistOf(srcRule, notDstRule, ipPortRule))
+	Expect(store.RulesReferencingIPSet("s:b")).To(ConsistOf(srcRule))
+	Expect(store.RulesReferencingIPSet("n:a")).To(ConsistOf(namedPortRule))
+	Expect(store.RulesReferencingIPSet("s:unused")).To(BeEmpty())
+}
+
+func TestValidateIPSetReferences(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := NewPolicyStore()
+	store.IPSetByID["s:present"] = NewIPSet(proto.IPSetUpdate_IP)
+	store.IPSetByID["n:present"] = NewIPSet(proto.IPSetUpdate_IP_AND_PORT)
+
+	Expect(store.ValidateIPSetReferences(nil)).To(BeEmpty())
+	Expect(store.ValidateIPSetReferences([]*proto.Rule{
+		{SrcIpSetIds: []string{"s:present"}, DstNamedPortIpSetIds: []string{"n:present"}},
+		{Action: "Allow"},
+	})).To(BeEmpty())
+	Expect(store.ValidateIPSetReferences([]*proto.Rule{
+		{SrcIpSetIds: []string{"s:present", "s:missing"}},
+		{NotDstIpSetIds: []string{"s:gone"}, DstIpPortSetIds: []string{"s:missing"}},
+	})).To(Equal([]string{"s:gone", "s:missing"}))
+}
+
+// ReplaceNamespaces swaps in the new namespaces atomically: concurrent readers see every namespace from one generation.
+func TestReplaceNamespaces(t *testing.T) {
+	RegisterTestingT(t)
+	const numNamespaces = 20
+	generation := func(g int) map[proto.NamespaceID]*proto.NamespaceUpdate {
+		namespaces := map[proto.NamespaceID]*proto.NamespaceUpdate{}
+		for i := 0; i < numNamespaces; i++ {
+			id := proto.NamespaceID{Name: fmt.Sprintf("ns-%d", i)}
+			namespaces[id] = &proto.NamespaceUpdate{Id: &id, Labels: map[string]string{"generation": fmt.Sprint(g)}}
+		}
+		return namespaces
+	}
+
+	store := NewPolicyStore()
+	store.ReplaceNamespaces(generation(0))
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	inconsistent := make(chan string, 4)
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				store.Read(func(s *PolicyStore) {
+					generations := map[string]bool{}
+					for _, ns := range s.NamespaceByID {
+						generations[ns.Labels["generation"]] = true
+					}
+					if len(s.NamespaceByID) != numNamespaces || len(generations) != 1 {
+						select {
+						case inconsistent <- fmt.Sprintf("%d namespaces from generations %v", len(s.NamespaceByID), generations):
+						default:
+						}
+					}
+				})
+			}
+		}()
+	}
+	for g := 1; g <= 200; g++ {
+		store.ReplaceNamespaces(generation(g))
+	}
+	close(stop)
+	wg.Wait()
+	close(inconsistent)
+
+	Expect(inconsistent).ToNot(Receive())
+	store.Read(func(s *PolicyStore) {
+		Expect(s.NamespaceByID).To(HaveLen(numNamespaces))
+		Expect(s.NamespaceByID[proto.NamespaceID{Name: "ns-0"}].Labels["generation"]).To(Equal("200"))
+	})
+}
+
+func TestReplaceNamespacesCopiesMap(t *testing.T) {
+	RegisterTestingT(t)
+	namespaces := map[proto.NamespaceID]*proto.NamespaceUpdate{
+		{Name: "default"}: {Id: &proto.NamespaceID{Name: "default"}},
+	}
+	store := NewPolicyStore()
+	store.ReplaceNamespaces(namespaces)
+	delete(namespaces, proto.NamespaceID{Name: "default"})
+	Expect(store.NamespaceByID).To(HaveKey(proto.NamespaceID{Name: "default"}))
+}
+
+func TestReset(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{Name: "ep"}
+	store.PolicyByID[proto.PolicyID{Tier: "default", Name: "p"}] = &proto.Policy{}
+	store.ProfileByID[proto.ProfileID{Name: "kns.default"}] = &proto.Profile{}
+	store.IPSetByID["s"] = NewIPSet(proto.IPSetUpdate_IP)
+	store.NamespaceByID[proto.NamespaceID{Name: "default"}] = &proto.NamespaceUpdate{}
+	store.EndpointByIP["10.0.0.1"] = store.Endpoint
+	store.LocalHostname = "node1"
+	store.NodeIPByHostname["node1"] = "192.168.0.1"
+	store.RouteByDst["10.0.0.0/26"] = &proto.RouteUpdate{}
+	ipSets := store.IPSetByID
+
+	store.Reset()
+	Expect(store).To(Equal(NewPolicyStore()))
+	// The maps are reused.
+	ipSets["t"] = NewIPSet(proto.IPSetUpdate_IP)
+	Expect(store.IPSetByID).To(HaveKey("t"))
+}
@@ -17,6 +17,7 @@ package syncher
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/projectcalico/calico/app-policy/health"
@@ -30,9 +31,10 @@ import (
 const PolicySyncRetryTime = 500 * time.Millisecond
 
 type syncClient struct {
-	target   string
-	dialOpts []grpc.DialOption
-	inSync   bool
+	target       string
+	dialOpts     []grpc.DialOption
+	inSync       bool
+	clusterState bool
 }
 
 type SyncClient interface {
@@ -46,9 +48,25 @@ type SyncClient interface {
 	health.ReadinessReporter
 }
 
+// ClientOption configures a syncClient.
+type ClientOption func(*syncClient)
+
+// WithClusterState asks Felix for the cluster-wide state, the host metadata, IPAM pools, services and routes that
+// rules such as src_is_local_node, src_ip_pools and dst_service_ports match on.  Without it, Felix doesn't send that
+// state and such rules see none.
+func WithClusterState() ClientOption {
+	return func(s *syncClient) {
+		s.clusterState = true
+	}
+}
+
 // NewClient creates a new syncClient.
-func NewClient(target string, opts []grpc.DialOption) SyncClient {
-	return &syncClient{target: target, dialOpts: opts}
+func NewClient(target string, opts []grpc.DialOption, clientOpts ...ClientOption) SyncClient {
+	s := &syncClient{target: target, dialOpts: opts}
+	for _, o := range clientOpts {
+		o(s)
+	}
+	return s
 }
 
 func (s *syncClient) Sync(cxt context.Context, stores chan<- *policystore.PolicyStore) {
@@ -97,7 +115,7 @@ func (s *syncClient) syncStore(cxt context.Context, store *policystore.PolicySto
 	log.Info("Successfully connected to Policy Sync server")
 	defer conn.Close()
 	client := proto.NewPolicySyncClient(conn)
-	stream, err := client.Sync(cxt, &proto.SyncRequest{})
+	stream, err := client.Sync(cxt, &proto.SyncRequest{ClusterState: s.clusterState})
 	if err != nil {
 		log.Warnf("failed to synchronize with Policy Sync server: %v", err)
 		s.inSync = false
@@ -147,13 +165,34 @@ func processUpdate(store *policystore.PolicyStore, inSync chan<- struct{}, updat
 		processNamespaceUpdate(store, payload.NamespaceUpdate)
 	case *proto.ToDataplane_NamespaceRemove:
 		processNamespaceRemove(store, payload.NamespaceRemove)
+	case *proto.ToDataplane_HostMetadataUpdate:
+		processHostMetadataUpdate(store, payload.HostMetadataUpdate)
+	case *proto.ToDataplane_HostMetadataRemove:
+		processHostMetadataRemove(store, payload.HostMetadataRemove)
+	case *proto.ToDataplane_HostMetadataV4V6Update:
+		processHostMetadataV4V6Update(store, payload.HostMetadataV4V6Update)
+	case *proto.ToDataplane_HostMetadataV4V6Remove:
+		processHostMetadataV4V6Remove(store, payload.HostMetadataV4V6Remove)
+	case *proto.ToDataplane_IpamPoolUpdate:
+		processIPAMPoolUpdate(store, payload.IpamPoolUpdate)
+	case *proto.ToDataplane_IpamPoolRemove:
+		processIPAMPoolRemove(store, payload.IpamPoolRemove)
+	case *proto.ToDataplane_ServiceUpdate:
+		processServiceUpdate(store, payload.ServiceUpdate)
+	case *proto.ToDataplane_ServiceRemove:
+		processServiceRemove(store, payload.ServiceRemove)
+	case *proto.ToDataplane_RouteUpdate:
+		processRouteUpdate(store, payload.RouteUpdate)
+	case *proto.ToDataplane_RouteRemove:
+		processRouteRemove(store, payload.RouteRemove)
 	default:
 		panic(fmt.Sprintf("unknown payload %v", update.String()))
 	}
 }
 
 func processInSync(store *policystore.PolicyStore, inSync *proto.InSync) {
-	log.Debug("Processing InSync")
+	log.WithField("hostname", inSync.GetHostname()).Debug("Processing InSync")
+	store.LocalHostname = inSync.GetHostname()
 }
 
 func processIPSetUpdate(store *policystore.PolicyStore, update *proto.IPSetUpdate) {
@@ -161,8 +200,13 @@ func processIPSetUpdate(store *policystore.PolicyStore, update *proto.IPSetUpdat
 		"id": update.Id,
 	}).Debug("Processing IPSetUpdate")
 
-	// IPSetUpdate replaces the existing set.
-	s := policystore.NewIPSet(update.Type)
+	// IPSetUpdate replaces the existing set.  If the set already exists with the same type, we update it in place so
+	// that the members it already had keep the times at which they were added.
+	if s, ok := store.IPSetByID[update.Id].(policystore.TimedIPSet); ok && s.Type() == update.Type {
+		s.ReplaceMembers(update.Members)
+		return
+	}
+	s := policystore.NewTimedIPSet(update.Type, time.Now)
 	for _, addr := range update.Members {
 		s.AddString(addr)
 	}
@@ -240,7 +284,9 @@ func processWorkloadEndpointUpdate(store *policystore.PolicyStore, update *proto
 		"workloadID":     update.GetId().GetWorkloadId(),
 		"endpointID":     update.GetId().GetEndpointId(),
 	}).Info("Processing WorkloadEndpointUpdate")
+	removeEndpointIPs(store, store.Endpoint)
 	store.Endpoint = update.Endpoint
+	addEndpointIPs(store, store.Endpoint)
 }
 
 func processWorkloadEndpointRemove(store *policystore.PolicyStore, update *proto.WorkloadEndpointRemove) {
@@ -250,9 +296,40 @@ func processWorkloadEndpointRemove(store *policystore.PolicyStore, update *proto
 		"workloadID":     update.GetId().GetWorkloadId(),
 		"endpointID":     update.GetId().GetEndpointId(),
 	}).Warning("Processing WorkloadEndpointRemove")
+	removeEndpointIPs(store, store.Endpoint)
 	store.Endpoint = nil
 }
 
+// addEndpointIPs indexes the endpoint in the store by each of its IP addresses.
+func addEndpointIPs(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint) {
+	for _, ip := range endpointIPs(ep) {
+		store.EndpointByIP[ip] = ep
+	}
+}
+
+// removeEndpointIPs removes the endpoint's IP addresses from the store's index.
+func removeEndpointIPs(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint) {
+	for _, ip := range endpointIPs(ep) {
+		if store.EndpointByIP[ip] == ep {
+			delete(store.EndpointByIP, ip)
+		}
+	}
+}
+
+// endpointIPs returns the IP addresses of the endpoint, in the same format Envoy uses to report peer addresses.
+func endpointIPs(ep *proto.WorkloadEndpoint) []string {
+	var ips []string
+	for _, n := range append(ep.GetIpv4Nets(), ep.GetIpv6Nets()...) {
+		ip, _, err := net.ParseCIDR(n)
+		if err != nil {
+			log.WithField("net", n).Warn("unable to parse endpoint CIDR")
+			continue
+		}
+		ips = append(ips, ip.String())
+	}
+	return ips
+}
+
 func processServiceAccountUpdate(store *policystore.PolicyStore, update *proto.ServiceAccountUpdate) {
 	log.WithField("id", update.Id).Debug("Processing ServiceAccountUpdate")
 	if update.Id == nil {
@@ -285,6 +362,103 @@ func processNamespaceRemove(store *policystore.PolicyStore, update *proto.Namesp
 	delete(store.NamespaceByID, *update.Id)
 }
 
+func processHostMetadataUpdate(store *policystore.PolicyStore, update *proto.HostMetadataUpdate) {
+	log.WithFields(log.Fields{
+		"hostname": update.Hostname,
+		"ip":       update.Ipv4Addr,
+	}).Debug("Processing HostMetadataUpdate")
+	store.NodeIPByHostname[update.Hostname] = update.Ipv4Addr
+}
+
+func processHostMetadataRemove(store *policystore.PolicyStore, update *proto.HostMetadataRemove) {
+	log.WithField("hostname", update.Hostname).Debug("Processing HostMetadataRemove")
+	delete(store.NodeIPByHostname, update.Hostname)
+}
+
+func processHostMetadataV4V6Update(store *policystore.PolicyStore, update *proto.HostMetadataV4V6Update) {
+	log.WithFields(log.Fields{
+		"hostname": update.Hostname,
+		"labels":   update.Labels,
+		"asNumber": update.Asnumber,
+	}).Debug("Processing HostMetadataV4V6Update")
+	store.NodeLabelsByHostname[update.Hostname] = update.Labels
+	if update.Asnumber != "" {
+		store.NodeASNumberByHostname[update.Hostname] = update.Asnumber
+	} else {
+		delete(store.NodeASNumberByHostname, update.Hostname)
+	}
+}
+
+func processHostMetadataV4V6Remove(store *policystore.PolicyStore, update *proto.HostMetadataV4V6Remove) {
+	log.WithField("hostname", update.Hostname).Debug("Processing HostMetadataV4V6Remove")
+	delete(store.NodeLabelsByHostname, update.Hostname)
+	delete(store.NodeASNumberByHostname, update.Hostname)
+}
+
+func processIPAMPoolUpdate(store *policystore.PolicyStore, update *proto.IPAMPoolUpdate) {
+	log.WithFields(log.Fields{
+		"id":   update.Id,
+		"name": update.GetPool().GetName(),
+	}).Debug("Processing IPAMPoolUpdate")
+	store.IPPoolByID[update.Id] = update.Pool
+}
+
+func processIPAMPoolRemove(store *policystore.PolicyStore, update *proto.IPAMPoolRemove) {
+	log.WithField("id", update.Id).Debug("Processing IPAMPoolRemove")
+	delete(store.IPPoolByID, update.Id)
+}
+
+func processServiceUpdate(store *policystore.PolicyStore, update *proto.ServiceUpdate) {
+	log.WithFields(log.Fields{
+		"name":      update.Name,
+		"namespace": update.Namespace,
+	}).Debug("Processing ServiceUpdate")
+	store.ServiceByID[update.Namespace+"/"+update.Name] = update
+}
+
+func processServiceRemove(store *policystore.PolicyStore, update *proto.ServiceRemove) {
+	log.WithFields(log.Fields{
+		"name":      update.Name,
+		"namespace": update.Namespace,
+	}).Debug("Processing ServiceRemove")
+	delete(store.ServiceByID, update.Namespace+"/"+update.Name)
+}
+
+func processRouteUpdate(store *policystore.PolicyStore, update *proto.RouteUpdate) {
+	log.WithFields(log.Fields{
+		"dst":  update.Dst,
+		"type": update.Type,
+	}).Debug("Processing RouteUpdate")
+	store.RouteByDst[update.Dst] = update
+	if routeIsLocalIPAMBlock(update) {
+		store.LocalIPAMBlocks[update.Dst] = update
+	} else {
+		delete(store.LocalIPAMBlocks, update.Dst)
+	}
+}
+
+func processRouteRemove(store *policystore.PolicyStore, update *proto.RouteRemove) {
+	log.WithField("dst", update.Dst).Debug("Processing RouteRemove")
+	delete(store.RouteByDst, update.Dst)
+	delete(store.LocalIPAMBlocks, update.Dst)
+}
+
+// routeIsLocalIPAMBlock returns true if the route is for an IPAM block that is affine to the local host.  Felix sends
+// such blocks as LOCAL_WORKLOAD routes, a type that it also uses for the /32 (or /128) routes of local workloads and
+// of IPs borrowed from other blocks, so those are excluded.
+func routeIsLocalIPAMBlock(update *proto.RouteUpdate) bool {
+	if update.Type != proto.RouteType_LOCAL_WORKLOAD || update.LocalWorkload {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(update.Dst)
+	if err != nil {
+		log.WithError(err).WithField("dst", update.Dst).Warn("Unable to parse route destination, not treating it as a local block.")
+		return false
+	}
+	ones, bits := cidr.Mask.Size()
+	return ones < bits
+}
+
 // Readiness returns whether the SyncClient is InSync.
 func (s *syncClient) Readiness() bool {
 	return s.inSync
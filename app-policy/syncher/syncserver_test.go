@@ -157,6 +157,30 @@ func TestIPSetUpdateExists(t *testing.T) {
 	Expect(ipset.ContainsAddress(addr3)).To(BeFalse())
 }
 
+// IPSetUpdate for an existing set keeps the times at which its remaining members were added.
+func TestIPSetUpdateKeepsAddTimes(t *testing.T) {
+	RegisterTestingT(t)
+
+	id := "test_id"
+	store := policystore.NewPolicyStore()
+	processIPSetUpdate(store, &proto.IPSetUpdate{Id: id, Type: proto.IPSetUpdate_IP, Members: []string{addr1Ip}})
+	ipset := store.IPSetByID[id].(policystore.TimedIPSet)
+	added, ok := ipset.AddedAt(addr1)
+	Expect(ok).To(BeTrue())
+
+	processIPSetUpdate(store, &proto.IPSetUpdate{Id: id, Type: proto.IPSetUpdate_IP, Members: []string{addr1Ip, addr2Ip}})
+	Expect(store.IPSetByID[id]).To(BeIdenticalTo(ipset))
+	stillAdded, ok := ipset.AddedAt(addr1)
+	Expect(ok).To(BeTrue())
+	Expect(stillAdded).To(Equal(added))
+	Expect(ipset.ContainsAddress(addr2)).To(BeTrue())
+
+	// A change of type replaces the set.
+	processIPSetUpdate(store, &proto.IPSetUpdate{Id: id, Type: proto.IPSetUpdate_NET, Members: []string{addr1Ip + "/32"}})
+	Expect(store.IPSetByID[id]).ToNot(BeIdenticalTo(ipset))
+	Expect(store.IPSetByID[id].ContainsAddress(addr1)).To(BeTrue())
+}
+
 // processUpdate handles IPSetUpdate without a crash.
 func TestIPSetUpdateDispatch(t *testing.T) {
 	RegisterTestingT(t)
@@ -511,6 +535,29 @@ func TestWorkloadEndpointUpdate(t *testing.T) {
 	Expect(store.Endpoint).To(BeIdenticalTo(endpoint1))
 }
 
+// WorkloadEndpointUpdate and WorkloadEndpointRemove maintain the index of endpoints by IP
+func TestWorkloadEndpointIndexByIP(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	ep := &proto.WorkloadEndpoint{
+		Name:       "wep",
+		Ipv4Nets:   []string{"10.0.0.1/32"},
+		Ipv6Nets:   []string{"fd00::1/128"},
+		ProfileIds: []string{"profile1"},
+	}
+	processWorkloadEndpointUpdate(store, &proto.WorkloadEndpointUpdate{Endpoint: ep})
+	Expect(store.EndpointByIP).To(Equal(map[string]*proto.WorkloadEndpoint{"10.0.0.1": ep, "fd00::1": ep}))
+
+	// An update with a changed IP replaces the old one.
+	ep2 := &proto.WorkloadEndpoint{Name: "wep", Ipv4Nets: []string{"10.0.0.2/32"}}
+	processWorkloadEndpointUpdate(store, &proto.WorkloadEndpointUpdate{Endpoint: ep2})
+	Expect(store.EndpointByIP).To(Equal(map[string]*proto.WorkloadEndpoint{"10.0.0.2": ep2}))
+
+	processWorkloadEndpointRemove(store, &proto.WorkloadEndpointRemove{})
+	Expect(store.EndpointByIP).To(BeEmpty())
+}
+
 // processUpdate handles WorkloadEndpointUpdate
 func TestWorkloadEndpointUpdateDispatch(t *testing.T) {
 	RegisterTestingT(t)
@@ -627,6 +674,150 @@ func TestNamespaceRemoveNilId(t *testing.T) {
 	Expect(func() { processNamespaceRemove(store, &proto.NamespaceRemove{}) }).To(Panic())
 }
 
+func TestHostMetadataUpdateDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+
+	update := &proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataUpdate{
+		HostMetadataUpdate: &proto.HostMetadataUpdate{Hostname: "node1", Ipv4Addr: "10.0.0.1"}}}
+	Expect(func() { processUpdate(store, inSync, update) }).ToNot(Panic())
+	Expect(store.NodeIPByHostname).To(Equal(map[string]string{"node1": "10.0.0.1"}))
+}
+
+func TestHostMetadataRemoveDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	store.NodeIPByHostname["node1"] = "10.0.0.1"
+	inSync := make(chan struct{})
+
+	remove := &proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataRemove{
+		HostMetadataRemove: &proto.HostMetadataRemove{Hostname: "node1", Ipv4Addr: "10.0.0.1"}}}
+	Expect(func() { processUpdate(store, inSync, remove) }).ToNot(Panic())
+	Expect(store.NodeIPByHostname).To(Equal(map[string]string{}))
+}
+
+func TestHostMetadataV4V6UpdateDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+
+	labels := map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}
+	update := &proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataV4V6Update{
+		HostMetadataV4V6Update: &proto.HostMetadataV4V6Update{
+			Hostname: "node1", Ipv4Addr: "10.0.0.1", Asnumber: "64512", Labels: labels,
+		}}}
+	Expect(func() { processUpdate(store, inSync, update) }).ToNot(Panic())
+	Expect(store.NodeLabelsByHostname).To(Equal(map[string]map[string]string{"node1": labels}))
+	Expect(store.NodeASNumberByHostname).To(Equal(map[string]string{"node1": "64512"}))
+
+	// The node reverts to the default AS number.
+	update.GetHostMetadataV4V6Update().Asnumber = ""
+	processUpdate(store, inSync, update)
+	Expect(store.NodeASNumberByHostname).To(BeEmpty())
+}
+
+func TestHostMetadataV4V6RemoveDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	store.NodeLabelsByHostname["node1"] = map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}
+	store.NodeASNumberByHostname["node1"] = "64512"
+	inSync := make(chan struct{})
+
+	remove := &proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataV4V6Remove{
+		HostMetadataV4V6Remove: &proto.HostMetadataV4V6Remove{Hostname: "node1", Ipv4Addr: "10.0.0.1"}}}
+	Expect(func() { processUpdate(store, inSync, remove) }).ToNot(Panic())
+	Expect(store.NodeLabelsByHostname).To(BeEmpty())
+	Expect(store.NodeASNumberByHostname).To(BeEmpty())
+}
+
+func TestIPAMPoolUpdateDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+
+	pool := &proto.IPAMPool{Name: "pool-a", Cidr: "10.10.0.0/16"}
+	update := &proto.ToDataplane{Payload: &proto.ToDataplane_IpamPoolUpdate{
+		IpamPoolUpdate: &proto.IPAMPoolUpdate{Id: "10.10.0.0-16", Pool: pool}}}
+	Expect(func() { processUpdate(store, inSync, update) }).ToNot(Panic())
+	Expect(store.IPPoolByID).To(Equal(map[string]*proto.IPAMPool{"10.10.0.0-16": pool}))
+}
+
+func TestIPAMPoolRemoveDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	store.IPPoolByID["10.10.0.0-16"] = &proto.IPAMPool{Name: "pool-a", Cidr: "10.10.0.0/16"}
+	inSync := make(chan struct{})
+
+	remove := &proto.ToDataplane{Payload: &proto.ToDataplane_IpamPoolRemove{
+		IpamPoolRemove: &proto.IPAMPoolRemove{Id: "10.10.0.0-16"}}}
+	Expect(func() { processUpdate(store, inSync, remove) }).ToNot(Panic())
+	Expect(store.IPPoolByID).To(BeEmpty())
+}
+
+func TestServiceUpdateDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+
+	svc := &proto.ServiceUpdate{Name: "web", Namespace: "default", ClusterIp: "10.96.0.10"}
+	update := &proto.ToDataplane{Payload: &proto.ToDataplane_ServiceUpdate{ServiceUpdate: svc}}
+	Expect(func() { processUpdate(store, inSync, update) }).ToNot(Panic())
+	Expect(store.ServiceByID).To(Equal(map[string]*proto.ServiceUpdate{"default/web": svc}))
+
+	remove := &proto.ToDataplane{Payload: &proto.ToDataplane_ServiceRemove{
+		ServiceRemove: &proto.ServiceRemove{Name: "web", Namespace: "default"}}}
+	Expect(func() { processUpdate(store, inSync, remove) }).ToNot(Panic())
+	Expect(store.ServiceByID).To(BeEmpty())
+}
+
+func TestRouteUpdateDispatch(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+
+	route := &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: "10.65.1.0/26"}
+	update := &proto.ToDataplane{Payload: &proto.ToDataplane_RouteUpdate{RouteUpdate: route}}
+	Expect(func() { processUpdate(store, inSync, update) }).ToNot(Panic())
+	Expect(store.RouteByDst).To(Equal(map[string]*proto.RouteUpdate{"10.65.1.0/26": route}))
+
+	remove := &proto.ToDataplane{Payload: &proto.ToDataplane_RouteRemove{
+		RouteRemove: &proto.RouteRemove{Dst: "10.65.1.0/26"}}}
+	Expect(func() { processUpdate(store, inSync, remove) }).ToNot(Panic())
+	Expect(store.RouteByDst).To(BeEmpty())
+}
+
+// Only routes for blocks affine to this host are recorded as local IPAM blocks.
+func TestRouteUpdateLocalIPAMBlocks(t *testing.T) {
+	RegisterTestingT(t)
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+
+	for _, route := range []*proto.RouteUpdate{
+		{Type: proto.RouteType_LOCAL_WORKLOAD, Dst: "10.65.1.0/26"},
+		{Type: proto.RouteType_LOCAL_WORKLOAD, Dst: "10.65.1.5/32", LocalWorkload: true},
+		{Type: proto.RouteType_LOCAL_WORKLOAD, Dst: "10.65.3.9/32"},
+		{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: "10.65.2.0/26"},
+		{Type: proto.RouteType_LOCAL_WORKLOAD, Dst: "fd00:10:65::/122"},
+	} {
+		update := &proto.ToDataplane{Payload: &proto.ToDataplane_RouteUpdate{RouteUpdate: route}}
+		processUpdate(store, inSync, update)
+	}
+	Expect(store.RouteByDst).To(HaveLen(5))
+	Expect(store.LocalIPAMBlocks).To(HaveLen(2))
+	Expect(store.LocalIPAMBlocks).To(HaveKey("10.65.1.0/26"))
+	Expect(store.LocalIPAMBlocks).To(HaveKey("fd00:10:65::/122"))
+
+	// A block that moves to another host is no longer local.
+	processUpdate(store, inSync, &proto.ToDataplane{Payload: &proto.ToDataplane_RouteUpdate{
+		RouteUpdate: &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: "10.65.1.0/26"}}})
+	Expect(store.LocalIPAMBlocks).ToNot(HaveKey("10.65.1.0/26"))
+
+	processUpdate(store, inSync, &proto.ToDataplane{Payload: &proto.ToDataplane_RouteRemove{
+		RouteRemove: &proto.RouteRemove{Dst: "fd00:10:65::/122"}}})
+	Expect(store.LocalIPAMBlocks).To(BeEmpty())
+}
+
 // processUpdate handles InSync
 func TestInSyncDispatch(t *testing.T) {
 	RegisterTestingT(t)
@@ -638,6 +829,17 @@ func TestInSyncDispatch(t *testing.T) {
 	Expect(inSync).To(BeClosed())
 }
 
+// processUpdate stores the local hostname from InSync
+func TestInSyncLocalHostname(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	inSync := make(chan struct{})
+	update := &proto.ToDataplane{Payload: &proto.ToDataplane_InSync{InSync: &proto.InSync{Hostname: "node1"}}}
+	processUpdate(store, inSync, update)
+	Expect(store.LocalHostname).To(Equal("node1"))
+}
+
 // processUpdate for an unhandled Payload causes a panic
 func TestProcessUpdateUnknown(t *testing.T) {
 	RegisterTestingT(t)
@@ -771,6 +973,30 @@ func TestSyncServerCancelBeforeInSync(t *testing.T) {
 	Eventually(syncDone).Should(BeClosed())
 }
 
+// A client created with WithClusterState asks for the cluster state in its SyncRequest.
+func TestSyncClusterState(t *testing.T) {
+	RegisterTestingT(t)
+
+	sCtx, sCancel := context.WithCancel(context.Background())
+	defer sCancel()
+
+	server := newTestSyncServer(sCtx)
+
+	uut := NewClient(server.GetTarget(), uds.GetDialOptions(), WithClusterState())
+	stores := make(chan *policystore.PolicyStore)
+
+	cCtx, cCancel := context.WithCancel(context.Background())
+	defer cCancel()
+	go uut.Sync(cCtx, stores)
+
+	server.SendInSync()
+	Eventually(stores).Should(Receive())
+	server.cLock.Lock()
+	defer server.cLock.Unlock()
+	Expect(server.requests).To(HaveLen(1))
+	Expect(server.requests[0].ClusterState).To(BeTrue())
+}
+
 type testSyncServer struct {
 	context    context.Context
 	updates    chan proto.ToDataplane
@@ -779,6 +1005,7 @@ type testSyncServer struct {
 	listener   net.Listener
 	cLock      sync.Mutex
 	cancelFns  []func()
+	requests   []*proto.SyncRequest
 }
 
 func newTestSyncServer(ctx context.Context) *testSyncServer {
@@ -790,10 +1017,11 @@ func newTestSyncServer(ctx context.Context) *testSyncServer {
 	return ss
 }
 
-func (s *testSyncServer) Sync(_ *proto.SyncRequest, stream proto.PolicySync_SyncServer) error {
+func (s *testSyncServer) Sync(req *proto.SyncRequest, stream proto.PolicySync_SyncServer) error {
 	ctx, cancel := context.WithCancel(s.context)
 	s.cLock.Lock()
 	s.cancelFns = append(s.cancelFns, cancel)
+	s.requests = append(s.requests, req)
 	s.cLock.Unlock()
 	var update proto.ToDataplane
 	for {
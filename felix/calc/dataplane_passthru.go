@@ -221,6 +221,7 @@ func kubernetesServiceToProto(s *kapiv1.Service) *proto.ServiceUpdate {
 			Protocol: protoGet(p.Protocol),
 			Port:     p.Port,
 			NodePort: p.NodePort,
+			Name:     p.Name,
 		})
 	}
 
@@ -407,9 +407,25 @@ func ModelWorkloadEndpointToProto(ep *model.WorkloadEndpoint, tiers []*proto.Tie
 		Ipv6Nat:                    natsToProtoNatInfo(ep.IPv6NAT),
 		AllowSpoofedSourcePrefixes: netsToStrings(ep.AllowSpoofedSourcePrefixes),
 		Annotations:                ep.Annotations,
+		Ports:                      endpointPortsToProto(ep.Ports),
 	}
 }
 
+func endpointPortsToProto(ports []model.EndpointPort) []*proto.EndpointPort {
+	if len(ports) == 0 {
+		return nil
+	}
+	protoPorts := make([]*proto.EndpointPort, len(ports))
+	for ii, p := range ports {
+		protoPorts[ii] = &proto.EndpointPort{
+			Name:     p.Name,
+			Protocol: p.Protocol.String(),
+			Port:     uint32(p.Port),
+		}
+	}
+	return protoPorts
+}
+
 func ModelHostEndpointToProto(ep *model.HostEndpoint, tiers, untrackedTiers, preDNATTiers []*proto.TierInfo, forwardTiers []*proto.TierInfo) *proto.HostEndpoint {
 	return &proto.HostEndpoint{
 		Name:              ep.Name,
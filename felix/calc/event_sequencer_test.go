@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
 
 	"github.com/projectcalico/calico/felix/calc"
 	"github.com/projectcalico/calico/felix/config"
@@ -65,6 +66,27 @@ var _ = DescribeTable("ModelWorkloadEndpointToProto",
 		Ipv6Nat:                    []*proto.NatInfo{},
 		AllowSpoofedSourcePrefixes: []string{},
 	}),
+	Entry("workload endpoint with ports", model.WorkloadEndpoint{
+		State: "up",
+		Name:  "bill",
+		Ports: []model.EndpointPort{
+			{Name: "http", Protocol: numorstring.ProtocolFromString("TCP"), Port: 8080},
+			{Name: "dns", Protocol: numorstring.ProtocolFromString("UDP"), Port: 53},
+		},
+	}, proto.WorkloadEndpoint{
+		State:                      "up",
+		Name:                       "bill",
+		Ipv4Nets:                   []string{},
+		Ipv6Nets:                   []string{},
+		Tiers:                      []*proto.TierInfo{},
+		Ipv4Nat:                    []*proto.NatInfo{},
+		Ipv6Nat:                    []*proto.NatInfo{},
+		AllowSpoofedSourcePrefixes: []string{},
+		Ports: []*proto.EndpointPort{
+			{Name: "http", Protocol: "TCP", Port: 8080},
+			{Name: "dns", Protocol: "UDP", Port: 53},
+		},
+	}),
 	Entry("workload endpoint with source IP spoofing configured", model.WorkloadEndpoint{
 		State:                      "up",
 		Name:                       "bill",
@@ -115,6 +115,9 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 		NotDstNamedPortIpSetIds: in.NotDstNamedPortIPSetIDs,
 		NotSrcIpSetIds:          in.NotSrcIPSetIDs,
 		NotDstIpSetIds:          in.NotDstIPSetIDs,
+		NotDstIpPortSetIds:      in.NotDstIPPortSetIDs,
+		SrcIpPortSetIds:         in.SrcIPPortSetIDs,
+		NotSrcIpPortSetIds:      in.NotSrcIPPortSetIDs,
 
 		// Pass through fields for the policy sync API.
 		OriginalSrcSelector:          in.OriginalSrcSelector,
@@ -127,6 +130,45 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 		OriginalSrcServiceNamespace:  in.OriginalSrcServiceNamespace,
 		OriginalDstService:           in.OriginalDstService,
 		OriginalDstServiceNamespace:  in.OriginalDstServiceNamespace,
+
+		DstAnnotations:           in.DstAnnotations,
+		AppProtocols:             in.AppProtocols,
+		SrcIsLocalNode:           in.SrcIsLocalNode,
+		JwtAudiences:             in.JWTAudiences,
+		RouteNames:               in.RouteNames,
+		SrcIpPools:               in.SrcIPPools,
+		DstServicePorts:          in.DstServicePorts,
+		DirectRemoteNet:          ipNetsToProtoStrings(in.DirectRemoteNets),
+		DstEncapsulations:        in.DstEncapsulations,
+		TlsTerminated:            in.TLSTerminated,
+		DstReady:                 in.DstReady,
+		GrpcCallTypes:            in.GRPCCallTypes,
+		SrcIpSetAddedWithinSecs:  in.SrcIPSetAddedWithinSecs,
+		DstIpSetAddedWithinSecs:  in.DstIPSetAddedWithinSecs,
+		SrcIpSetCardinalityAbove: in.SrcIPSetCardinalityAbove,
+		RequestLabelSelector:     in.RequestLabelSelector,
+		SrcNamespaceLabels:       in.SrcNamespaceLabels,
+		DstInLocalIpamBlock:      in.DstInLocalIPAMBlock,
+		TlsFingerprints:          in.TLSFingerprints,
+		NotTlsFingerprints:       in.NotTLSFingerprints,
+		AllowHairpin:             in.AllowHairpin,
+		DstListening:             in.DstListening,
+		SrcPrincipalPrefixes:     in.SrcPrincipalPrefixes,
+		SrcPrincipalSuffixes:     in.SrcPrincipalSuffixes,
+		DstPrincipalPrefixes:     in.DstPrincipalPrefixes,
+		DstPrincipalSuffixes:     in.DstPrincipalSuffixes,
+		SrcZones:                 in.SrcZones,
+		SrcRegions:               in.SrcRegions,
+		DstReverseDnsNames:       in.DstReverseDNSNames,
+		ConnectionReused:         in.ConnectionReused,
+		SrcAsNumbers:             in.SrcASNumbers,
+	}
+
+	if len(in.GRPCServices) > 0 || len(in.GRPCMethods) > 0 {
+		out.GrpcMatch = &proto.GrpcMatch{
+			Services: in.GRPCServices,
+			Methods:  in.GRPCMethods,
+		}
 	}
 
 	if len(in.OriginalSrcServiceAccountNames) > 0 || in.OriginalSrcServiceAccountSelector != "" {
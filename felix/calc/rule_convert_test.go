@@ -219,6 +219,17 @@ var _ = DescribeTable("ParsedRulesToProtoRules",
 		proto.Rule{
 			DstIpPortSetIds: []string{"ipPortSetID"},
 		}),
+	Entry("gRPC match rule",
+		ParsedRule{
+			GRPCServices: []string{"helloworld.Greeter"},
+			GRPCMethods:  []string{"SayHello"},
+		},
+		proto.Rule{
+			GrpcMatch: &proto.GrpcMatch{
+				Services: []string{"helloworld.Greeter"},
+				Methods:  []string{"SayHello"},
+			},
+		}),
 	Entry("fully-loaded rule",
 		fullyLoadedParsedRule,
 		fullyLoadedProtoRule),
@@ -346,6 +346,11 @@ type ParsedRule struct {
 	NotICMPCode             *int
 	NotSrcIPSetIDs          []string
 	NotDstIPSetIDs          []string
+	// The following IP/port set fields are only used by the policy sync API; the calculation graph doesn't generate
+	// them yet.
+	NotDstIPPortSetIDs []string
+	SrcIPPortSetIDs    []string
+	NotSrcIPPortSetIDs []string
 
 	// These fields allow us to pass through the raw match criteria from the V3 datamodel,
 	// unmodified. The selectors above are formed in the update processor layer by combining the
@@ -369,6 +374,41 @@ type ParsedRule struct {
 	// does not implement the match, but other dataplanes such as Dikastes do.
 	HTTPMatch *model.HTTPMatch
 
+	// These fields are only matched by Dikastes, so they are passed through unmodified.
+	DstAnnotations           map[string]string
+	AppProtocols             []string
+	SrcIsLocalNode           bool
+	JWTAudiences             []string
+	RouteNames               []string
+	SrcIPPools               []string
+	DstServicePorts          []string
+	DirectRemoteNets         []*net.IPNet
+	DstEncapsulations        []string
+	TLSTerminated            bool
+	DstReady                 bool
+	GRPCCallTypes            []string
+	SrcIPSetAddedWithinSecs  uint32
+	DstIPSetAddedWithinSecs  uint32
+	SrcIPSetCardinalityAbove uint32
+	RequestLabelSelector     string
+	SrcNamespaceLabels       map[string]string
+	DstInLocalIPAMBlock      bool
+	TLSFingerprints          []string
+	NotTLSFingerprints       []string
+	GRPCServices             []string
+	GRPCMethods              []string
+	AllowHairpin             bool
+	DstListening             bool
+	SrcPrincipalPrefixes     []string
+	SrcPrincipalSuffixes     []string
+	DstPrincipalPrefixes     []string
+	DstPrincipalSuffixes     []string
+	SrcZones                 []string
+	SrcRegions               []string
+	DstReverseDNSNames       []string
+	ConnectionReused         bool
+	SrcASNumbers             []uint32
+
 	Metadata *model.RuleMetadata
 }
 
@@ -495,6 +535,39 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		OriginalDstService:                rule.DstService,
 		OriginalDstServiceNamespace:       rule.DstServiceNamespace,
 		HTTPMatch:                         rule.HTTPMatch,
+		DstAnnotations:                    rule.DstAnnotations,
+		AppProtocols:                      rule.AppProtocols,
+		SrcIsLocalNode:                    rule.SrcIsLocalNode,
+		JWTAudiences:                      rule.JWTAudiences,
+		RouteNames:                        rule.RouteNames,
+		SrcIPPools:                        rule.SrcIPPools,
+		DstServicePorts:                   rule.DstServicePorts,
+		DirectRemoteNets:                  rule.DirectRemoteNets,
+		DstEncapsulations:                 rule.DstEncapsulations,
+		TLSTerminated:                     rule.TLSTerminated,
+		DstReady:                          rule.DstReady,
+		GRPCCallTypes:                     rule.GRPCCallTypes,
+		SrcIPSetAddedWithinSecs:           rule.SrcIPSetAddedWithinSecs,
+		DstIPSetAddedWithinSecs:           rule.DstIPSetAddedWithinSecs,
+		SrcIPSetCardinalityAbove:          rule.SrcIPSetCardinalityAbove,
+		RequestLabelSelector:              rule.RequestLabelSelector,
+		SrcNamespaceLabels:                rule.SrcNamespaceLabels,
+		DstInLocalIPAMBlock:               rule.DstInLocalIPAMBlock,
+		TLSFingerprints:                   rule.TLSFingerprints,
+		NotTLSFingerprints:                rule.NotTLSFingerprints,
+		GRPCServices:                      rule.GRPCServices,
+		GRPCMethods:                       rule.GRPCMethods,
+		AllowHairpin:                      rule.AllowHairpin,
+		DstListening:                      rule.DstListening,
+		SrcPrincipalPrefixes:              rule.SrcPrincipalPrefixes,
+		SrcPrincipalSuffixes:              rule.SrcPrincipalSuffixes,
+		DstPrincipalPrefixes:              rule.DstPrincipalPrefixes,
+		DstPrincipalSuffixes:              rule.DstPrincipalSuffixes,
+		SrcZones:                          rule.SrcZones,
+		SrcRegions:                        rule.SrcRegions,
+		DstReverseDNSNames:                rule.DstReverseDNSNames,
+		ConnectionReused:                  rule.ConnectionReused,
+		SrcASNumbers:                      rule.SrcASNumbers,
 
 		// Pass through metadata (used by iptables backend)
 		Metadata: rule.Metadata,
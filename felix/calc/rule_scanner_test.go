@@ -231,7 +231,10 @@ var _ = Describe("ParsedRule", func() {
 				strings.Contains(name, "LogPrefix") ||
 				(strings.Contains(name, "Selector") &&
 					!strings.Contains(name, "Original") &&
-					!strings.Contains(name, "Service")) {
+					!strings.Contains(name, "Service") &&
+					// Request labels aren't known until the request is checked, so their selector is
+					// passed through as-is rather than being rendered into IP sets.
+					name != "RequestLabelSelector") {
 				continue
 			}
 			if name == "DstService" || name == "DstServiceNamespace" || name == "SrcService" || name == "SrcServiceNamespace" {
@@ -252,7 +255,7 @@ var _ = Describe("ParsedRule", func() {
 	})
 	It("should have correct fields relative to proto.Rule", func() {
 		// We expect all the fields to have the same name, except for
-		// ICMP and service account matches, which differ in structure.
+		// ICMP, service account and gRPC matches, which differ in structure.
 		prType := reflect.TypeOf(ParsedRule{})
 		numPRFields := prType.NumField()
 		prFields := []string{}
@@ -260,7 +263,8 @@ var _ = Describe("ParsedRule", func() {
 			name := strings.ToLower(prType.Field(i).Name)
 			if strings.Contains(name, "icmptype") ||
 				strings.Contains(name, "icmpcode") ||
-				strings.Contains(name, "serviceaccount") {
+				strings.Contains(name, "serviceaccount") ||
+				name == "grpcservices" || name == "grpcmethods" {
 				// expected to differ.
 				continue
 			}
@@ -277,7 +281,8 @@ var _ = Describe("ParsedRule", func() {
 		for i := 0; i < numMRFields; i++ {
 			name := strings.ToLower(protoType.Field(i).Name)
 			if strings.Contains(name, "icmp") ||
-				strings.Contains(name, "serviceaccount") {
+				strings.Contains(name, "serviceaccount") ||
+				name == "grpcmatch" {
 				// expected to differ.
 				continue
 			}
@@ -301,6 +301,31 @@ type Config struct {
 	IpInIpEnabled    *bool  `config:"*bool;"`
 	IpInIpMtu        int    `config:"int;0"`
 	IpInIpTunnelAddr net.IP `config:"ipv4;"`
+	// IpInIpTxQueueLen, if non-zero, is the transmit queue length to set on the IPIP tunnel device.
+	IpInIpTxQueueLen int `config:"int;0;local"`
+	// IpInIpVRF, if set, is the name of an existing VRF device to enslave the IPIP tunnel device to.
+	IpInIpVRF string `config:"iface-param;;local"`
+	// IpInIpRemote, if set, makes the IPIP tunnel a point-to-point tunnel to the given remote address, through
+	// the ipip-p2p.calico device, rather than a multipoint tunnel through tunl0 that can reach any node.
+	IpInIpRemote net.IP `config:"ipv4;;local"`
+	// IpInIpHostRemovalGracePeriod is how long a removed host is kept in the all-hosts IP set, so that traffic
+	// from a node that is briefly absent (for example, while it restarts) isn't dropped.  Zero removes hosts
+	// immediately.
+	IpInIpHostRemovalGracePeriod time.Duration `config:"seconds;0;local"`
+	// IpInIpAllHostsMinRebuildInterval is the minimum time between rewrites of the all-hosts IP set.  Host changes
+	// that arrive sooner are coalesced into the next rewrite.  Zero rewrites the IP set on every change.
+	IpInIpAllHostsMinRebuildInterval time.Duration `config:"seconds;0;local"`
+	// IpInIpDeviceMaxAttempts, if non-zero, is the number of consecutive failed attempts to configure the IPIP
+	// tunnel device after which Felix gives up and reports itself as not live.  Zero retries forever.
+	IpInIpDeviceMaxAttempts int `config:"int;0;local"`
+	// IpInIpEnabledV6 enables IPv6-in-IPv6 tunnelling through the ip6tnl.calico device, with its own all-hosts IP
+	// set holding the hosts' IPv6 addresses.  Unlike IPv4 IPIP, it isn't enabled by the IP pools.  It requires
+	// IPv6 support to be enabled.
+	IpInIpEnabledV6 bool `config:"bool;false;local"`
+	// IpInIpMtuV6 is the MTU of the IPv6 IPIP tunnel device.  Zero sets it from the host's MTU.
+	IpInIpMtuV6 int `config:"int;0;local"`
+	// IPv6IpInIpTunnelAddr is the IPv6 address of the IPv6 IPIP tunnel device.
+	IPv6IpInIpTunnelAddr net.IP `config:"ipv6;;local"`
 
 	// Feature enablement.  Can be either "Enabled" or "Disabled".  Note, this governs the
 	// programming of NAT mappings derived from Kubernetes pod annotations.  OpenStack floating
@@ -454,7 +454,7 @@ configRetry:
 			"Policy sync API enabled.  Creating the policy sync server.")
 		toPolicySync := make(chan interface{})
 		policySyncUIDAllocator := policysync.NewUIDAllocator()
-		policySyncProcessor = policysync.NewProcessor(toPolicySync)
+		policySyncProcessor = policysync.NewProcessor(toPolicySync, configParams.FelixHostname)
 		policySyncServer = policysync.NewServer(
 			policySyncProcessor.JoinUpdates,
 			policySyncUIDAllocator.NextUID,
@@ -242,8 +242,11 @@ func StartDataplaneDriver(configParams *config.Config,
 				VXLANVNI:       configParams.VXLANVNI,
 
 				IPIPEnabled:            configParams.Encapsulation.IPIPEnabled,
+				IPIPEnabledV6:          configParams.IpInIpEnabledV6,
 				FelixConfigIPIPEnabled: configParams.IpInIpEnabled,
 				IPIPTunnelAddress:      configParams.IpInIpTunnelAddr,
+				IPIPTunnelAddressV6:    configParams.IPv6IpInIpTunnelAddr,
+				IPIPRemote:             configParams.IpInIpRemote,
 				VXLANTunnelAddress:     configParams.IPv4VXLANTunnelAddr,
 				VXLANTunnelAddressV6:   configParams.IPv6VXLANTunnelAddr,
 
@@ -297,6 +300,13 @@ func StartDataplaneDriver(configParams *config.Config,
 				RouteSyncDisabled:   configParams.RouteSyncDisabled,
 			},
 			IPIPMTU:                        configParams.IpInIpMtu,
+			IPIPTxQueueLen:                 configParams.IpInIpTxQueueLen,
+			IPIPVRF:                        configParams.IpInIpVRF,
+			IPIPRemote:                     configParams.IpInIpRemote,
+			IPIPMTUV6:                      configParams.IpInIpMtuV6,
+			IPIPHostRemovalGracePeriod:     configParams.IpInIpHostRemovalGracePeriod,
+			IPIPMinRebuildInterval:         configParams.IpInIpAllHostsMinRebuildInterval,
+			IPIPDeviceMaxAttempts:          configParams.IpInIpDeviceMaxAttempts,
 			VXLANMTU:                       configParams.VXLANMTU,
 			VXLANMTUV6:                     configParams.VXLANMTUV6,
 			VXLANPort:                      configParams.VXLANPort,
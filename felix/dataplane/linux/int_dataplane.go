@@ -134,14 +134,21 @@ func init() {
 }
 
 type Config struct {
-	Hostname             string
-	NodeZone             string
-	IPv6Enabled          bool
-	RuleRendererOverride rules.RuleRenderer
-	IPIPMTU              int
-	VXLANMTU             int
-	VXLANMTUV6           int
-	VXLANPort            int
+	Hostname                   string
+	NodeZone                   string
+	IPv6Enabled                bool
+	RuleRendererOverride       rules.RuleRenderer
+	IPIPMTU                    int
+	IPIPTxQueueLen             int
+	IPIPVRF                    string
+	IPIPRemote                 net.IP
+	IPIPMTUV6                  int
+	IPIPHostRemovalGracePeriod time.Duration
+	IPIPMinRebuildInterval     time.Duration
+	IPIPDeviceMaxAttempts      int
+	VXLANMTU                   int
+	VXLANMTUV6                 int
+	VXLANPort                  int
 
 	MaxIPSetSize int
 
@@ -287,7 +294,8 @@ type InternalDataplane struct {
 	iptablesFilterTables []*iptables.Table
 	ipSets               []common.IPSetsDataplane
 
-	ipipManager *ipipManager
+	ipipManager   *ipipManager
+	ipipManagerV6 *ipipManager
 
 	vxlanManager   *vxlanManager
 	vxlanParentC   chan string
@@ -357,10 +365,13 @@ type InternalDataplane struct {
 }
 
 const (
-	healthName     = "InternalDataplaneMainLoop"
-	healthInterval = 10 * time.Second
+	healthName       = "InternalDataplaneMainLoop"
+	ipipHealthName   = "IPIPTunnelDevice"
+	ipipHealthNameV6 = "IPIPTunnelDeviceV6"
+	healthInterval   = 10 * time.Second
 
 	ipipMTUOverhead        = 20
+	ipipV6MTUOverhead      = 40
 	vxlanMTUOverhead       = 50
 	vxlanV6MTUOverhead     = 70
 	wireguardMTUOverhead   = 60
@@ -810,8 +821,10 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	if config.RulesConfig.IPIPEnabled {
 		log.Info("IPIP enabled, starting thread to keep tunnel configuration in sync.")
 		// Add a manager to keep the all-hosts IP set up to date.
-		dp.ipipManager = newIPIPManager(ipSetsV4, config.MaxIPSetSize, config.ExternalNodesCidrs)
-		go dp.ipipManager.KeepIPIPDeviceInSync(config.IPIPMTU, config.RulesConfig.IPIPTunnelAddress, dataplaneFeatures.ChecksumOffloadBroken)
+		ipipOpts := append(ipipManagerOpts(config, ipipHealthName), withIPIPRemote(config.IPIPRemote))
+		dp.ipipManager = newIPIPManager(ipSetsV4, config.MaxIPSetSize, config.ExternalNodesCidrs, ipipOpts...)
+		dp.ipipManager.removeUnusedP2PDevice()
+		go dp.ipipManager.KeepIPIPDeviceInSync(context.Background(), config.IPIPMTU, config.IPIPTxQueueLen, config.RulesConfig.IPIPTunnelAddress, dataplaneFeatures.ChecksumOffloadBroken)
 		dp.RegisterManager(dp.ipipManager) // IPv4-only
 	} else {
 		// Only clean up IPIP addresses if IPIP is implicitly disabled (no IPIP pools and not explicitly set in FelixConfig)
@@ -907,6 +920,15 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 			go cleanUpVXLANDevice(VXLANIfaceNameV6)
 		}
 
+		if config.RulesConfig.IPIPEnabledV6 {
+			log.Info("IPv6 IPIP enabled, starting thread to keep tunnel configuration in sync.")
+			ipipOpts := append(ipipManagerOpts(config, ipipHealthNameV6), withIPIPVersion(6))
+			dp.ipipManagerV6 = newIPIPManager(ipSetsV6, config.MaxIPSetSize, config.ExternalNodesCidrs, ipipOpts...)
+			dp.ipipManagerV6.removeUnusedP2PDevice()
+			go dp.ipipManagerV6.KeepIPIPDeviceInSync(context.Background(), config.IPIPMTUV6, config.IPIPTxQueueLen, config.RulesConfig.IPIPTunnelAddressV6, dataplaneFeatures.ChecksumOffloadBroken)
+			dp.RegisterManager(dp.ipipManagerV6)
+		}
+
 		var routeTableV6 routetable.RouteTableInterface
 		if !config.RouteSyncDisabled {
 			log.Debug("RouteSyncDisabled is false.")
@@ -1067,6 +1089,7 @@ func determinePodMTU(config Config) int {
 	}
 	for _, s := range []mtuState{
 		{config.IPIPMTU, config.RulesConfig.IPIPEnabled},
+		{config.IPIPMTUV6, config.RulesConfig.IPIPEnabledV6},
 		{config.VXLANMTU, config.RulesConfig.VXLANEnabled},
 		{config.VXLANMTUV6, config.RulesConfig.VXLANEnabledV6},
 		{config.Wireguard.MTU, config.Wireguard.Enabled},
@@ -1088,6 +1111,23 @@ func determinePodMTU(config Config) int {
 	return mtu
 }
 
+// clampTunnelMTU returns the given tunnel MTU, reduced if necessary so that packets encapsulated with the given
+// overhead fit in the host MTU.  If the host MTU isn't known, the MTU is returned unchanged.
+func clampTunnelMTU(mtu, hostMTU, overhead int) int {
+	if hostMTU <= 0 {
+		return mtu
+	}
+	if maxMTU := hostMTU - overhead; mtu > maxMTU {
+		log.WithFields(log.Fields{
+			"mtu":     mtu,
+			"hostMTU": hostMTU,
+			"clamped": maxMTU,
+		}).Warn("Configured tunnel MTU is too large for the host MTU, clamping it")
+		return maxMTU
+	}
+	return mtu
+}
+
 // ConfigureDefaultMTUs defaults any MTU configurations that have not been set.
 // We default the values even if the encap is not enabled, in order to match behavior from earlier versions of Calico.
 // However, they MTU will only be considered for allocation to pod interfaces if the encap is enabled.
@@ -1097,6 +1137,10 @@ func ConfigureDefaultMTUs(hostMTU int, c *Config) {
 		log.Debug("Defaulting IPIP MTU based on host")
 		c.IPIPMTU = hostMTU - ipipMTUOverhead
 	}
+	if c.IPIPMTUV6 == 0 {
+		log.Debug("Defaulting IPv6 IPIP MTU based on host")
+		c.IPIPMTUV6 = hostMTU - ipipV6MTUOverhead
+	}
 	if c.VXLANMTU == 0 {
 		log.Debug("Defaulting IPv4 VXLAN MTU based on host")
 		c.VXLANMTU = hostMTU - vxlanMTUOverhead
@@ -1105,6 +1149,10 @@ func ConfigureDefaultMTUs(hostMTU int, c *Config) {
 		log.Debug("Defaulting IPv6 VXLAN MTU based on host")
 		c.VXLANMTUV6 = hostMTU - vxlanV6MTUOverhead
 	}
+	// Clamp the VXLAN MTUs so that encapsulated packets fit in the host's MTU.  Both the VXLAN devices and the pod MTU
+	// use these values, so they agree.
+	c.VXLANMTU = clampTunnelMTU(c.VXLANMTU, hostMTU, vxlanMTUOverhead)
+	c.VXLANMTUV6 = clampTunnelMTU(c.VXLANMTUV6, hostMTU, vxlanV6MTUOverhead)
 	if c.Wireguard.MTU == 0 {
 		if c.KubernetesProvider == config.ProviderAKS && c.Wireguard.EncryptHostTraffic {
 			// The default MTU on Azure is 1500, but the underlying network stack will fragment packets at 1400 bytes,
@@ -1137,6 +1185,32 @@ func ConfigureDefaultMTUs(hostMTU int, c *Config) {
 	}
 }
 
+// ipipManagerOpts returns the options for an IPIP manager that are common to both IP versions,
+// reporting the manager's liveness to the health aggregator under the given name.
+func ipipManagerOpts(config Config, healthName string) []ipipManagerOpt {
+	opts := []ipipManagerOpt{
+		withIPIPVRF(config.IPIPVRF),
+		withIPIPHostRemovalGracePeriod(config.IPIPHostRemovalGracePeriod),
+		withIPIPMinRebuildInterval(config.IPIPMinRebuildInterval),
+	}
+	if config.IPIPDeviceMaxAttempts > 0 {
+		opts = append(opts, withIPIPMaxDeviceAttempts(config.IPIPDeviceMaxAttempts))
+	}
+	if config.HealthAggregator != nil {
+		// Not ready until the tunnel device is first configured, and not ready again whenever configuring it fails.
+		// Not live once the sync loop gives up.  We only report on transitions, so there's no timeout.
+		config.HealthAggregator.RegisterReporter(healthName, &health.HealthReport{Live: true, Ready: true}, 0)
+		opts = append(opts, withIPIPHealthCallback(func(e ipipHealthEvent) {
+			report := &health.HealthReport{Live: !e.Fatal, Ready: e.Healthy}
+			if !e.Healthy {
+				report.Detail = fmt.Sprintf("failed to configure IPIP tunnel device: %v", e.Err)
+			}
+			config.HealthAggregator.Report(healthName, report)
+		}))
+	}
+	return opts
+}
+
 func cleanUpIPIPAddrs() {
 	// If IPIP is not enabled, check to see if there is are addresses in the IPIP device and delete them if there are.
 	log.Debug("Checking if we need to clean up the IPIP device")
@@ -1241,6 +1315,15 @@ type ManagerWithRouteTables interface {
 	GetRouteTableSyncers() []routetable.RouteTableSyncer
 }
 
+// ManagerWithReschedule is implemented by managers that have work that becomes due at a known time,
+// without any further updates arriving.
+type ManagerWithReschedule interface {
+	Manager
+	// RescheduleAfter returns how long until CompleteDeferredWork needs to be called again, or 0 if
+	// the manager has no such work pending.  It is called after CompleteDeferredWork.
+	RescheduleAfter() time.Duration
+}
+
 type ManagerWithRouteRules interface {
 	Manager
 	GetRouteRules() []routeRules
@@ -2117,6 +2200,13 @@ func (d *InternalDataplane) apply() {
 			// Queue a resync on the next Apply().
 			r.QueueResync()
 		}
+		for _, m := range []*ipipManager{d.ipipManager, d.ipipManagerV6} {
+			if m != nil {
+				// Catch any drift between the all-hosts IP set and the active hosts before
+				// the IP sets are resynced.
+				m.AuditAllHostsIPSet(true)
+			}
+		}
 		d.forceIPSetsRefresh = false
 	}
 
@@ -2201,6 +2291,16 @@ func (d *InternalDataplane) apply() {
 	}
 	iptablesWG.Wait()
 
+	// Some managers have work that comes due by itself; make sure we wake up for it.
+	for _, mgr := range d.allManagers {
+		if m, ok := mgr.(ManagerWithReschedule); ok {
+			if mgrReschedAfter := m.RescheduleAfter(); mgrReschedAfter != 0 &&
+				(reschedDelay == 0 || mgrReschedAfter < reschedDelay) {
+				reschedDelay = mgrReschedAfter
+			}
+		}
+	}
+
 	// Now clean up any left-over IP sets.
 	var ipSetsNeedsReschedule atomic.Bool
 	for _, ipSets := range d.ipSets {
@@ -139,4 +139,11 @@ var _ = Describe("Constructor test", func() {
 			Expect(dpConfig.Wireguard.MTU).To(Equal(1440))
 		})
 	})
+
+	Context("with IPv6 IPIP", func() {
+		It("should default the MTU from the host's", func() {
+			intdataplane.ConfigureDefaultMTUs(1500, &dpConfig)
+			Expect(dpConfig.IPIPMTUV6).To(Equal(1460))
+		})
+	})
 })
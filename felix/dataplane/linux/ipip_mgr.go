@@ -15,10 +15,14 @@
 package intdataplane
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"sort"
+	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
 
@@ -27,8 +31,45 @@ import (
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/felix/timeshim"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
+const (
+	// IPIPIfaceNameV4 is the kernel's fallback IPIP device, which appears when the ipip module is
+	// loaded.
+	IPIPIfaceNameV4 = "tunl0"
+	// IPIPIfaceNameV6 is the IPv6-in-IPv6 tunnel device that we create for IPv6 IPIP.
+	IPIPIfaceNameV6 = "ip6tnl.calico"
+	// IPIPP2PIfaceNameV4 and IPIPP2PIfaceNameV6 are the point-to-point tunnel devices that we create
+	// in place of the multipoint ones when a remote is configured.  The kernel doesn't allow the
+	// remote of its fallback tunl0 device to be changed, so a point-to-point tunnel needs its own
+	// device.
+	IPIPP2PIfaceNameV4 = "ipip-p2p.calico"
+	IPIPP2PIfaceNameV6 = "ip6-p2p.calico"
+)
+
+var countAllHostsIPSetDrift = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "felix_ipip_all_hosts_ipset_drift",
+	Help: "Number of times an audit found the all-hosts IP set out of sync with the active hosts.",
+})
+
+var (
+	countAllHostsIPSetUpdates = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_ipip_all_hosts_ipset_updates_total",
+		Help: "Number of times the all-hosts IP set was rewritten, by IP version.",
+	}, []string{"ip_version"})
+	gaugeAllHostsIPSetMembers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_ipip_all_hosts_ipset_members",
+		Help: "Number of members of the all-hosts IP set when it was last rewritten, by IP version.",
+	}, []string{"ip_version"})
+)
+
+func init() {
+	prometheus.MustRegister(countAllHostsIPSetDrift, countAllHostsIPSetUpdates, gaugeAllHostsIPSetMembers)
+}
+
 // ipipManager manages the all-hosts IP set, which is used by some rules in our static chains
 // when IPIP is enabled.  It doesn't actually program the rules, because they are part of the
 // top-level static chains.
@@ -37,27 +78,139 @@ import (
 type ipipManager struct {
 	ipsetsDataplane common.IPSetsDataplane
 
+	// ipVersion is the IP version of the tunnel, 4 for IPIP over IPv4 through tunl0, or 6 for
+	// IPv6 in IPv6 through an ip6tnl device.  ifaceName is the name of the tunnel device.
+	ipVersion uint8
+	ifaceName string
+
 	// activeHostnameToIP maps hostname to string IP address.  We don't bother to parse into
 	// net.IPs because we're going to pass them directly to the IPSet API.
 	activeHostnameToIP map[string]string
 	ipSetInSync        bool
 
+	// hostRemovalGracePeriod is how long a removed host stays in the all-hosts IP set.
+	// pendingHostRemovals maps the hostnames of removed hosts that are still in
+	// activeHostnameToIP to the time at which they should be removed.
+	hostRemovalGracePeriod time.Duration
+	pendingHostRemovals    map[string]time.Time
+
+	// minRebuildInterval is the minimum time between rewrites of the all-hosts IP set.  Changes
+	// that arrive sooner are coalesced into the next rewrite.  lastRebuild is the time of the
+	// last rewrite.
+	minRebuildInterval time.Duration
+	lastRebuild        time.Time
+
 	// Config for creating/refreshing the IP set.
 	ipSetMetadata ipsets.IPSetMetadata
 
 	// Dataplane shim.
 	dataplane ipipDataplane
 
+	// Time shim, used to pace the tunnel device sync loop.
+	time timeshim.Interface
+
+	// vrfName, if set, is the name of the VRF device that the tunnel device is enslaved to.
+	vrfName string
+
+	// remote, if set, is the remote address of a point-to-point tunnel, which uses its own device.
+	// If nil, the tunnel is multipoint, with no fixed remote.
+	remote net.IP
+
+	// healthCallback, if set, is called when programming of the tunnel device transitions between
+	// healthy and unhealthy.  healthKnown is false until the first attempt completes.
+	healthCallback func(ipipHealthEvent)
+	healthKnown    bool
+	healthy        bool
+
+	// maxDeviceAttempts, if non-zero, is the number of consecutive failed attempts to configure
+	// the tunnel device after which the sync loop gives up and reports a fatal health event.
+	maxDeviceAttempts int
+
 	// Configured list of external node ip cidr's to be added to the ipset.
 	externalNodeCIDRs []string
 }
 
+// ipipHealthEvent describes a transition of the IPIP tunnel device programming between healthy and
+// unhealthy.
+type ipipHealthEvent struct {
+	Healthy bool
+	// Err is the error that caused a transition to unhealthy.
+	Err  error
+	Time time.Time
+	// Fatal is set on the final event from a sync loop that has given up on configuring the
+	// device.
+	Fatal bool
+}
+
+type ipipManagerOpt func(*ipipManager)
+
+// withIPIPHealthCallback sets a callback that is called, from the tunnel device sync goroutine,
+// when programming of the device first succeeds or fails, and thereafter each time it transitions
+// between succeeding and failing.
+func withIPIPHealthCallback(cb func(ipipHealthEvent)) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.healthCallback = cb
+	}
+}
+
+// withIPIPMaxDeviceAttempts makes the tunnel device sync loop give up, and emit a fatal health
+// event, after the given number of consecutive failed attempts to configure the device.  Zero
+// retries forever.
+func withIPIPMaxDeviceAttempts(n int) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.maxDeviceAttempts = n
+	}
+}
+
+// withIPIPVRF sets the name of a VRF device to enslave the tunnel device to.  An empty name leaves
+// the device's master alone.
+func withIPIPVRF(name string) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.vrfName = name
+	}
+}
+
+// withIPIPRemote makes the tunnel a point-to-point tunnel to the given remote address, through a
+// dedicated device rather than tunl0 or ip6tnl.calico.  A nil address makes it a multipoint tunnel,
+// which is the default.
+func withIPIPRemote(remote net.IP) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.remote = remote
+	}
+}
+
+// withIPIPVersion sets the IP version of the tunnel.  The default, 4, uses the tunl0 device; 6
+// uses an ip6tnl device and the hosts' IPv6 addresses, and the manager must be given the IPv6
+// IP sets dataplane.
+func withIPIPVersion(v uint8) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.ipVersion = v
+	}
+}
+
+// withIPIPHostRemovalGracePeriod keeps removed hosts in the all-hosts IP set for the given period,
+// in case they come back.
+func withIPIPHostRemovalGracePeriod(d time.Duration) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.hostRemovalGracePeriod = d
+	}
+}
+
+// withIPIPMinRebuildInterval limits rewrites of the all-hosts IP set to one per the given
+// interval, coalescing the host changes in between, to bound IP set churn in large clusters.
+func withIPIPMinRebuildInterval(d time.Duration) ipipManagerOpt {
+	return func(m *ipipManager) {
+		m.minRebuildInterval = d
+	}
+}
+
 func newIPIPManager(
 	ipsetsDataplane common.IPSetsDataplane,
 	maxIPSetSize int,
 	externalNodeCidrs []string,
+	opts ...ipipManagerOpt,
 ) *ipipManager {
-	return newIPIPManagerWithShim(ipsetsDataplane, maxIPSetSize, realIPIPNetlink{}, externalNodeCidrs)
+	return newIPIPManagerWithShim(ipsetsDataplane, maxIPSetSize, realIPIPNetlink{}, externalNodeCidrs, timeshim.RealTime(), opts...)
 }
 
 func newIPIPManagerWithShim(
@@ -65,55 +218,135 @@ func newIPIPManagerWithShim(
 	maxIPSetSize int,
 	dataplane ipipDataplane,
 	externalNodeCIDRs []string,
+	timeShim timeshim.Interface,
+	opts ...ipipManagerOpt,
 ) *ipipManager {
 	ipipMgr := &ipipManager{
-		ipsetsDataplane:    ipsetsDataplane,
-		activeHostnameToIP: map[string]string{},
-		dataplane:          dataplane,
+		ipsetsDataplane:     ipsetsDataplane,
+		ipVersion:           4,
+		activeHostnameToIP:  map[string]string{},
+		pendingHostRemovals: map[string]time.Time{},
+		dataplane:           dataplane,
+		time:                timeShim,
 		ipSetMetadata: ipsets.IPSetMetadata{
 			MaxSize: maxIPSetSize,
 			SetID:   rules.IPSetIDAllHostNets,
 			Type:    ipsets.IPSetTypeHashNet,
 		},
-		externalNodeCIDRs: externalNodeCIDRs,
+	}
+	for _, o := range opts {
+		o(ipipMgr)
+	}
+	switch ipipMgr.ipVersion {
+	case 4:
+		ipipMgr.ifaceName = IPIPIfaceNameV4
+		if ipipMgr.remote != nil {
+			ipipMgr.ifaceName = IPIPP2PIfaceNameV4
+		}
+	case 6:
+		ipipMgr.ifaceName = IPIPIfaceNameV6
+		if ipipMgr.remote != nil {
+			ipipMgr.ifaceName = IPIPP2PIfaceNameV6
+		}
+	default:
+		log.WithField("ipVersion", ipipMgr.ipVersion).Panic("Unknown IP version")
+	}
+	// The all-hosts IP set only holds addresses of the tunnel's IP version.
+	for _, c := range externalNodeCIDRs {
+		_, ipNet, err := cnet.ParseCIDROrIP(c)
+		if err != nil || uint8(ipNet.Version()) != ipipMgr.ipVersion {
+			log.WithError(err).WithField("cidr", c).Debug("Ignoring external node CIDR of other IP version")
+			continue
+		}
+		ipipMgr.externalNodeCIDRs = append(ipipMgr.externalNodeCIDRs, c)
 	}
 	return ipipMgr
 }
 
 // KeepIPIPDeviceInSync is a goroutine that configures the IPIP tunnel device, then periodically
-// checks that it is still correctly configured.
-func (d *ipipManager) KeepIPIPDeviceInSync(mtu int, address net.IP, xsumBroken bool) {
+// checks that it is still correctly configured.  It returns when the context is done.
+func (d *ipipManager) KeepIPIPDeviceInSync(ctx context.Context, mtu, txQueueLen int, address net.IP, xsumBroken bool) {
 	log.Info("IPIP thread started.")
-	for {
-		err := d.configureIPIPDevice(mtu, address, xsumBroken)
+	failures := 0
+	for ctx.Err() == nil {
+		err := d.configureIPIPDevice(mtu, txQueueLen, address, xsumBroken)
+		d.updateHealth(err)
 		if err != nil {
+			failures++
+			if d.maxDeviceAttempts > 0 && failures >= d.maxDeviceAttempts {
+				log.WithError(err).WithField("attempts", failures).Error(
+					"Failed to configure IPIP tunnel device too many times, giving up.")
+				if d.healthCallback != nil {
+					d.healthCallback(ipipHealthEvent{Err: err, Time: d.time.Now(), Fatal: true})
+				}
+				return
+			}
 			log.WithError(err).Warn("Failed configure IPIP tunnel device, retrying...")
-			time.Sleep(1 * time.Second)
+			d.sleep(ctx, 1*time.Second)
 			continue
 		}
-		time.Sleep(10 * time.Second)
+		failures = 0
+		d.sleep(ctx, 10*time.Second)
+	}
+	log.Info("KeepIPIPDeviceInSync exiting due to context.")
+}
+
+// updateHealth records the outcome of an attempt to program the tunnel device and, if it differs
+// from the previous outcome, emits a health event.
+func (d *ipipManager) updateHealth(err error) {
+	healthy := err == nil
+	if d.healthKnown && d.healthy == healthy {
+		return
+	}
+	d.healthKnown = true
+	d.healthy = healthy
+	log.WithError(err).WithField("healthy", healthy).Info("IPIP tunnel device health changed.")
+	if d.healthCallback != nil {
+		d.healthCallback(ipipHealthEvent{Healthy: healthy, Err: err, Time: d.time.Now()})
+	}
+}
+
+// sleep waits for the given duration on the manager's time shim, returning early if the context
+// is done.
+func (d *ipipManager) sleep(ctx context.Context, duration time.Duration) {
+	timer := d.time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.Chan():
+	case <-ctx.Done():
+		log.Debug("Sleep returning early: context finished.")
 	}
 }
 
-// configureIPIPDevice ensures the IPIP tunnel device is up and configures correctly.
-func (d *ipipManager) configureIPIPDevice(mtu int, address net.IP, xsumBroken bool) error {
+// configureIPIPDevice ensures the IPIP tunnel device is up and configures correctly.  A txQueueLen
+// of 0 leaves the device's transmit queue length at its current value.
+func (d *ipipManager) configureIPIPDevice(mtu, txQueueLen int, address net.IP, xsumBroken bool) error {
 	logCxt := log.WithFields(log.Fields{
 		"mtu":        mtu,
+		"txQueueLen": txQueueLen,
 		"tunnelAddr": address,
 	})
 	logCxt.Debug("Configuring IPIP tunnel")
-	link, err := d.dataplane.LinkByName("tunl0")
+	link, err := d.dataplane.LinkByName(d.ifaceName)
+	needsAdd := err != nil
 	if err != nil {
 		log.WithError(err).Info("Failed to get IPIP tunnel device, assuming it isn't present")
-		// We call out to "ip tunnel", which takes care of loading the kernel module if
-		// needed.  The tunl0 device is actually created automatically by the kernel
-		// module.
-		err := d.dataplane.RunCmd("ip", "tunnel", "add", "tunl0", "mode", "ipip")
-		if err != nil {
+	} else if oldRemote := tunnelRemote(link); !oldRemote.Equal(d.remote) {
+		// The remote of a tunnel device can't be changed in place, so we recreate the device.
+		logCxt.WithFields(log.Fields{"oldRemote": oldRemote, "remote": d.remote}).Info(
+			"Tunnel device remote needs to be updated, recreating the device")
+		if err := d.dataplane.LinkDel(link); err != nil {
+			log.WithError(err).Warning("Failed to delete tunnel device")
+			return err
+		}
+		needsAdd = true
+	}
+	if needsAdd {
+		if err := d.addTunnelDevice(); err != nil {
 			log.WithError(err).Warning("Failed to add IPIP tunnel device")
 			return err
 		}
-		link, err = d.dataplane.LinkByName("tunl0")
+		link, err = d.dataplane.LinkByName(d.ifaceName)
 		if err != nil {
 			log.WithError(err).Warning("Failed to get tunnel device")
 			return err
@@ -131,9 +364,24 @@ func (d *ipipManager) configureIPIPDevice(mtu int, address net.IP, xsumBroken bo
 		logCxt.Info("Updated tunnel MTU")
 	}
 
+	if txQueueLen > 0 && attrs.TxQLen != txQueueLen {
+		logCxt.WithField("oldTxQueueLen", attrs.TxQLen).Info("Tunnel device txqueuelen needs to be updated")
+		if err := d.dataplane.LinkSetTxQLen(link, txQueueLen); err != nil {
+			log.WithError(err).Warn("Failed to set tunnel device txqueuelen")
+			return err
+		}
+		logCxt.Info("Updated tunnel txqueuelen")
+	}
+
+	if d.vrfName != "" {
+		if err := d.setVRF(link); err != nil {
+			return err
+		}
+	}
+
 	// If required, disable checksum offload.
 	if xsumBroken {
-		if err := ethtool.EthtoolTXOff("tunl0"); err != nil {
+		if err := ethtool.EthtoolTXOff(d.ifaceName); err != nil {
 			return fmt.Errorf("failed to disable checksum offload: %s", err)
 		}
 	}
@@ -147,28 +395,124 @@ func (d *ipipManager) configureIPIPDevice(mtu int, address net.IP, xsumBroken bo
 		logCxt.Info("Set tunnel admin up")
 	}
 
-	if err := d.setLinkAddressV4("tunl0", address); err != nil {
+	if err := d.setLinkAddress(d.ifaceName, address); err != nil {
 		log.WithError(err).Warn("Failed to set tunnel device IP")
 		return err
 	}
 	return nil
 }
 
-// setLinkAddressV4 updates the given link to set its local IP address.  It removes any other
-// addresses.
-func (d *ipipManager) setLinkAddressV4(linkName string, address net.IP) error {
+// addTunnelDevice creates the tunnel device.
+func (d *ipipManager) addTunnelDevice() error {
+	if d.remote != nil {
+		// A point-to-point tunnel, in its own device.
+		var link netlink.Link = &netlink.Iptun{
+			LinkAttrs: netlink.LinkAttrs{Name: d.ifaceName},
+			Remote:    d.remote,
+		}
+		if d.ipVersion == 6 {
+			link = &netlink.Ip6tnl{
+				LinkAttrs: netlink.LinkAttrs{Name: d.ifaceName},
+				Remote:    d.remote,
+				Proto:     syscall.IPPROTO_IPV6,
+			}
+		}
+		return d.dataplane.LinkAdd(link)
+	}
+	if d.ipVersion == 6 {
+		// A multipoint IPv6-in-IPv6 tunnel, with no fixed local or remote address.
+		link := &netlink.Ip6tnl{
+			LinkAttrs: netlink.LinkAttrs{Name: d.ifaceName},
+			Proto:     syscall.IPPROTO_IPV6,
+		}
+		return d.dataplane.LinkAdd(link)
+	}
+	// We call out to "ip tunnel", which takes care of loading the kernel module if needed.
+	// The tunl0 device is actually created automatically by the kernel module.
+	return d.dataplane.RunCmd("ip", "tunnel", "add", d.ifaceName, "mode", "ipip")
+}
+
+// tunnelRemote returns the remote address of the tunnel device, or nil if it is a multipoint
+// tunnel.
+func tunnelRemote(link netlink.Link) net.IP {
+	var remote net.IP
+	switch tun := link.(type) {
+	case *netlink.Iptun:
+		remote = tun.Remote
+	case *netlink.Ip6tnl:
+		remote = tun.Remote
+	}
+	if remote.IsUnspecified() {
+		return nil
+	}
+	return remote
+}
+
+// removeUnusedP2PDevice removes the point-to-point tunnel device if no remote is configured.  It
+// is left behind when Felix restarts after the remote is removed from its configuration, and would
+// otherwise keep the tunnel address.
+func (d *ipipManager) removeUnusedP2PDevice() {
+	if d.remote != nil {
+		return
+	}
+	name := IPIPP2PIfaceNameV4
+	if d.ipVersion == 6 {
+		name = IPIPP2PIfaceNameV6
+	}
+	link, err := d.dataplane.LinkByName(name)
+	if err != nil {
+		return
+	}
+	logCxt := log.WithField("iface", name)
+	logCxt.Info("Removing point-to-point tunnel device that is no longer configured")
+	if err := d.dataplane.LinkDel(link); err != nil {
+		logCxt.WithError(err).Warn("Failed to remove point-to-point tunnel device")
+	}
+}
+
+// setVRF ensures the tunnel device is enslaved to the configured VRF device.
+func (d *ipipManager) setVRF(link netlink.Link) error {
+	logCxt := log.WithField("vrf", d.vrfName)
+	vrf, err := d.dataplane.LinkByName(d.vrfName)
+	if err != nil {
+		logCxt.WithError(err).Warn("Failed to get VRF device")
+		return err
+	}
+	if vrf.Type() != "vrf" {
+		return fmt.Errorf("device %s is not a VRF (type %s)", d.vrfName, vrf.Type())
+	}
+	if link.Attrs().MasterIndex == vrf.Attrs().Index {
+		return nil
+	}
+	logCxt.WithField("oldMasterIndex", link.Attrs().MasterIndex).Info("Tunnel device needs to be enslaved to VRF")
+	if err := d.dataplane.LinkSetMasterByIndex(link, vrf.Attrs().Index); err != nil {
+		logCxt.WithError(err).Warn("Failed to enslave tunnel device to VRF")
+		return err
+	}
+	logCxt.Info("Enslaved tunnel device to VRF")
+	return nil
+}
+
+// setLinkAddress updates the given link to set its local IP address, of the manager's IP version.
+// It removes any other addresses of that version.
+func (d *ipipManager) setLinkAddress(linkName string, address net.IP) error {
 	logCxt := log.WithFields(log.Fields{
-		"link": linkName,
-		"addr": address,
+		"link":      linkName,
+		"addr":      address,
+		"ipVersion": d.ipVersion,
 	})
-	logCxt.Debug("Setting local IPv4 address on link.")
+	logCxt.Debug("Setting local address on link.")
 	link, err := d.dataplane.LinkByName(linkName)
 	if err != nil {
 		log.WithError(err).WithField("name", linkName).Warning("Failed to get device")
 		return err
 	}
 
-	addrs, err := d.dataplane.AddrList(link, netlink.FAMILY_V4)
+	family, bits := netlink.FAMILY_V4, 32
+	if d.ipVersion == 6 {
+		family, bits = netlink.FAMILY_V6, 128
+	}
+	addrs, err := d.dataplane.AddrList(link, family)
 	if err != nil {
 		log.WithError(err).Warn("Failed to list interface addresses")
 		return err
@@ -190,7 +534,7 @@ func (d *ipipManager) setLinkAddressV4(linkName string, address net.IP) error {
 
 	if !found && address != nil {
 		logCxt.Info("Address wasn't present, adding it.")
-		mask := net.CIDRMask(32, 32)
+		mask := net.CIDRMask(bits, bits)
 		ipNet := net.IPNet{
 			IP:   address.Mask(mask), // Mask the IP to match ParseCIDR()'s behaviour.
 			Mask: mask,
@@ -209,33 +553,272 @@ func (d *ipipManager) setLinkAddressV4(linkName string, address net.IP) error {
 }
 
 func (d *ipipManager) OnUpdate(msg interface{}) {
+	// The IPv4 tunnel uses the hosts' IPv4 addresses and the IPv6 tunnel their IPv6 addresses.
 	switch msg := msg.(type) {
 	case *proto.HostMetadataUpdate:
-		log.WithField("hostname", msg.Hostname).Debug("Host update/create")
-		d.activeHostnameToIP[msg.Hostname] = msg.Ipv4Addr
-		d.ipSetInSync = false
+		if d.ipVersion == 4 {
+			d.onHostUpdate(msg.Hostname, msg.Ipv4Addr)
+		}
 	case *proto.HostMetadataRemove:
-		log.WithField("hostname", msg.Hostname).Debug("Host removed")
-		delete(d.activeHostnameToIP, msg.Hostname)
-		d.ipSetInSync = false
+		if d.ipVersion == 4 {
+			d.onHostRemove(msg.Hostname)
+		}
+	case *proto.HostMetadataV6Update:
+		if d.ipVersion == 6 {
+			d.onHostUpdate(msg.Hostname, msg.Ipv6Addr)
+		}
+	case *proto.HostMetadataV6Remove:
+		if d.ipVersion == 6 {
+			d.onHostRemove(msg.Hostname)
+		}
 	}
 }
 
+func (d *ipipManager) onHostUpdate(hostname, addr string) {
+	log.WithField("hostname", hostname).Debug("Host update/create")
+	if _, ok := d.pendingHostRemovals[hostname]; ok {
+		log.WithField("hostname", hostname).Info("Removed host came back within grace period.")
+		delete(d.pendingHostRemovals, hostname)
+	}
+	d.activeHostnameToIP[hostname] = addr
+	d.ipSetInSync = false
+}
+
+func (d *ipipManager) onHostRemove(hostname string) {
+	log.WithField("hostname", hostname).Debug("Host removed")
+	if _, ok := d.activeHostnameToIP[hostname]; ok && d.hostRemovalGracePeriod > 0 {
+		if _, ok := d.pendingHostRemovals[hostname]; !ok {
+			d.pendingHostRemovals[hostname] = d.time.Now().Add(d.hostRemovalGracePeriod)
+		}
+		return
+	}
+	delete(d.activeHostnameToIP, hostname)
+	d.ipSetInSync = false
+}
+
+// RescheduleAfter returns how long until the next pending host removal, or throttled rewrite of
+// the all-hosts IP set, is due, or 0 if there are none.
+func (d *ipipManager) RescheduleAfter() time.Duration {
+	var next time.Duration
+	now := d.time.Now()
+	deadlines := make([]time.Time, 0, len(d.pendingHostRemovals)+1)
+	for _, deadline := range d.pendingHostRemovals {
+		deadlines = append(deadlines, deadline)
+	}
+	if !d.ipSetInSync && d.minRebuildInterval > 0 {
+		deadlines = append(deadlines, d.lastRebuild.Add(d.minRebuildInterval))
+	}
+	for _, deadline := range deadlines {
+		untilDeadline := deadline.Sub(now)
+		if untilDeadline <= 0 {
+			// Overdue; ask to be called again straight away.
+			untilDeadline = time.Millisecond
+		}
+		if next == 0 || untilDeadline < next {
+			next = untilDeadline
+		}
+	}
+	return next
+}
+
 func (m *ipipManager) CompleteDeferredWork() error {
+	now := m.time.Now()
+	for hostname, deadline := range m.pendingHostRemovals {
+		if now.Before(deadline) {
+			continue
+		}
+		log.WithField("hostname", hostname).Info("Grace period for removed host expired, removing it.")
+		delete(m.activeHostnameToIP, hostname)
+		delete(m.pendingHostRemovals, hostname)
+		m.ipSetInSync = false
+	}
 	if !m.ipSetInSync {
-		// For simplicity (and on the assumption that host add/removes are rare) rewrite
-		// the whole IP set whenever we get a change.  To replace this with delta handling
-		// would require reference counting the IPs because it's possible for two hosts
-		// to (at least transiently) share an IP.  That would add occupancy and make the
-		// code more complex.
-		log.Info("All-hosts IP set out-of sync, refreshing it.")
-		members := make([]string, 0, len(m.activeHostnameToIP)+len(m.externalNodeCIDRs))
-		for _, ip := range m.activeHostnameToIP {
-			members = append(members, ip)
-		}
-		members = append(members, m.externalNodeCIDRs...)
-		m.ipsetsDataplane.AddOrReplaceIPSet(m.ipSetMetadata, members)
-		m.ipSetInSync = true
+		if m.minRebuildInterval > 0 && now.Before(m.lastRebuild.Add(m.minRebuildInterval)) {
+			log.WithField("lastRebuild", m.lastRebuild).Debug(
+				"All-hosts IP set out-of sync but rebuilt recently, deferring refresh.")
+			return nil
+		}
+		m.syncAllHostsIPSet()
 	}
 	return nil
 }
+
+// syncAllHostsIPSet rewrites the all-hosts IP set from the active hosts and external node CIDRs.
+func (m *ipipManager) syncAllHostsIPSet() {
+	// For simplicity (and on the assumption that host add/removes are rare) rewrite
+	// the whole IP set whenever we get a change.  To replace this with delta handling
+	// would require reference counting the IPs because it's possible for two hosts
+	// to (at least transiently) share an IP.  That would add occupancy and make the
+	// code more complex.
+	log.Info("All-hosts IP set out-of sync, refreshing it.")
+	members := m.allHostsIPSetMembers()
+	m.ipsetsDataplane.AddOrReplaceIPSet(m.ipSetMetadata, members)
+	ipVersion := fmt.Sprint(m.ipVersion)
+	countAllHostsIPSetUpdates.WithLabelValues(ipVersion).Inc()
+	gaugeAllHostsIPSetMembers.WithLabelValues(ipVersion).Set(float64(len(members)))
+	m.ipSetInSync = true
+	m.lastRebuild = m.time.Now()
+}
+
+func (m *ipipManager) allHostsIPSetMembers() []string {
+	members := make([]string, 0, len(m.activeHostnameToIP)+len(m.externalNodeCIDRs))
+	for _, ip := range m.activeHostnameToIP {
+		members = append(members, ip)
+	}
+	return append(members, m.externalNodeCIDRs...)
+}
+
+// AuditAllHostsIPSet compares the members of the all-hosts IP set that were last pushed to the
+// IP sets dataplane with those derived from the active hosts.  Any discrepancy is logged and
+// counted and, if rebuild is true, the IP set is rewritten.  It returns true if there was no
+// discrepancy.  While a rewrite is already pending, there is nothing to audit, so it returns true.
+func (m *ipipManager) AuditAllHostsIPSet(rebuild bool) bool {
+	if !m.ipSetInSync {
+		return true
+	}
+	missing, extra := m.allHostsIPSetDrift()
+	if len(missing) == 0 && len(extra) == 0 {
+		log.Debug("All-hosts IP set audit found no discrepancies.")
+		return true
+	}
+
+	log.WithFields(log.Fields{
+		"missing": missing,
+		"extra":   extra,
+		"rebuild": rebuild,
+	}).Warn("All-hosts IP set has drifted from the active hosts.")
+	countAllHostsIPSetDrift.Inc()
+	if rebuild {
+		m.syncAllHostsIPSet()
+	}
+	return false
+}
+
+// allHostsIPSetDrift returns the members that are missing from, and the extra members in, the
+// all-hosts IP set that was last pushed to the IP sets dataplane, compared with those derived from
+// the active hosts.
+func (m *ipipManager) allHostsIPSetDrift() (missing, extra []string) {
+	ipSetType := m.ipSetMetadata.Type
+	expected := set.New[string]()
+	for _, member := range m.allHostsIPSetMembers() {
+		expected.Add(ipSetType.CanonicaliseMember(member).String())
+	}
+	actual := set.New[string]()
+	pushed, err := m.ipsetsDataplane.GetDesiredMembers(m.ipSetMetadata.SetID)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get members of all-hosts IP set, treating it as empty.")
+	} else if pushed != nil {
+		pushed.Iter(func(member string) error {
+			actual.Add(ipSetType.CanonicaliseMember(member).String())
+			return nil
+		})
+	}
+
+	expected.Iter(func(member string) error {
+		if !actual.Contains(member) {
+			missing = append(missing, member)
+		}
+		return nil
+	})
+	actual.Iter(func(member string) error {
+		if !expected.Contains(member) {
+			extra = append(extra, member)
+		}
+		return nil
+	})
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return
+}
+
+// IPIPSelfTestReport is the result of an ipipManager self-test.
+type IPIPSelfTestReport struct {
+	Checks []IPIPSelfTestCheck
+}
+
+// IPIPSelfTestCheck is the result of one of the checks in an ipipManager self-test.
+type IPIPSelfTestCheck struct {
+	Name   string
+	Passed bool
+	// Detail describes the problem, if the check failed, or what was checked, if it passed.
+	Detail string
+}
+
+// Passed returns true if all the checks in the report passed.
+func (r IPIPSelfTestReport) Passed() bool {
+	for _, c := range r.Checks {
+		if !c.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// SelfTest checks that the manager can look up the VRF device that the tunnel device is enslaved
+// to (if one is configured), that the tunnel device exists and is up, and that the all-hosts IP set
+// is in sync with the active hosts.  Unlike the device sync loop, it doesn't try to fix anything.
+// It reads the manager's host state, so, like AuditAllHostsIPSet, it must be called from the
+// dataplane's main loop.  It returns an error, along with the checks done so far, if the context
+// finishes first.
+func (m *ipipManager) SelfTest(ctx context.Context) (IPIPSelfTestReport, error) {
+	var report IPIPSelfTestReport
+	for _, check := range []func() IPIPSelfTestCheck{
+		m.selfTestVRF,
+		m.selfTestTunnelDevice,
+		m.selfTestAllHostsIPSet,
+	} {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		report.Checks = append(report.Checks, check())
+	}
+	log.WithField("report", report).Debug("IPIP self-test complete.")
+	return report, nil
+}
+
+func (m *ipipManager) selfTestVRF() IPIPSelfTestCheck {
+	c := IPIPSelfTestCheck{Name: "vrf-device"}
+	if m.vrfName == "" {
+		c.Passed, c.Detail = true, "no VRF configured"
+		return c
+	}
+	vrf, err := m.dataplane.LinkByName(m.vrfName)
+	switch {
+	case err != nil:
+		c.Detail = fmt.Sprintf("failed to look up VRF device %s: %v", m.vrfName, err)
+	case vrf.Type() != "vrf":
+		c.Detail = fmt.Sprintf("device %s is not a VRF (type %s)", m.vrfName, vrf.Type())
+	default:
+		c.Passed, c.Detail = true, fmt.Sprintf("found VRF device %s", m.vrfName)
+	}
+	return c
+}
+
+func (m *ipipManager) selfTestTunnelDevice() IPIPSelfTestCheck {
+	c := IPIPSelfTestCheck{Name: "tunnel-device"}
+	link, err := m.dataplane.LinkByName(m.ifaceName)
+	switch {
+	case err != nil:
+		c.Detail = fmt.Sprintf("failed to look up tunnel device: %v", err)
+	case link.Attrs().Flags&net.FlagUp == 0:
+		c.Detail = "tunnel device is not up"
+	default:
+		c.Passed, c.Detail = true, "tunnel device is up"
+	}
+	return c
+}
+
+func (m *ipipManager) selfTestAllHostsIPSet() IPIPSelfTestCheck {
+	c := IPIPSelfTestCheck{Name: "all-hosts-ipset"}
+	if !m.ipSetInSync {
+		c.Detail = "update pending"
+		return c
+	}
+	missing, extra := m.allHostsIPSetDrift()
+	if len(missing) > 0 || len(extra) > 0 {
+		c.Detail = fmt.Sprintf("missing members %v, extra members %v", missing, extra)
+		return c
+	}
+	c.Passed, c.Detail = true, "in sync"
+	return c
+}
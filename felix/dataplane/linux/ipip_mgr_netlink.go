@@ -23,8 +23,12 @@ import (
 // ipipDataplane is a shim interface for mocking netlink and os/exec in the IPIP manager.
 type ipipDataplane interface {
 	LinkByName(name string) (netlink.Link, error)
+	LinkAdd(link netlink.Link) error
+	LinkDel(link netlink.Link) error
 	LinkSetMTU(link netlink.Link, mtu int) error
+	LinkSetTxQLen(link netlink.Link, qlen int) error
 	LinkSetUp(link netlink.Link) error
+	LinkSetMasterByIndex(link netlink.Link, masterIndex int) error
 	AddrList(link netlink.Link, family int) ([]netlink.Addr, error)
 	AddrAdd(link netlink.Link, addr *netlink.Addr) error
 	AddrDel(link netlink.Link, addr *netlink.Addr) error
@@ -36,14 +40,31 @@ type realIPIPNetlink struct{}
 func (r realIPIPNetlink) LinkByName(name string) (netlink.Link, error) {
 	return netlink.LinkByName(name)
 }
+
+func (r realIPIPNetlink) LinkAdd(link netlink.Link) error {
+	return netlink.LinkAdd(link)
+}
+
+func (r realIPIPNetlink) LinkDel(link netlink.Link) error {
+	return netlink.LinkDel(link)
+}
+
 func (r realIPIPNetlink) LinkSetMTU(link netlink.Link, mtu int) error {
 	return netlink.LinkSetMTU(link, mtu)
 }
 
+func (r realIPIPNetlink) LinkSetTxQLen(link netlink.Link, qlen int) error {
+	return netlink.LinkSetTxQLen(link, qlen)
+}
+
 func (r realIPIPNetlink) LinkSetUp(link netlink.Link) error {
 	return netlink.LinkSetUp(link)
 }
 
+func (r realIPIPNetlink) LinkSetMasterByIndex(link netlink.Link, masterIndex int) error {
+	return netlink.LinkSetMasterByIndex(link, masterIndex)
+}
+
 func (r realIPIPNetlink) AddrList(link netlink.Link, family int) ([]netlink.Addr, error) {
 	return netlink.AddrList(link, family)
 }
@@ -18,15 +18,21 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"context"
 	"errors"
 	"fmt"
 	"net"
+	"syscall"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	log "github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
 
 	"github.com/projectcalico/calico/felix/dataplane/common"
 	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/timeshim/mocktime"
+	"github.com/projectcalico/calico/libcalico-go/lib/health"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
@@ -40,6 +46,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 		ipipMgr   *ipipManager
 		ipSets    *common.MockIPSets
 		dataplane *mockIPIPDataplane
+		mockTime  *mocktime.MockTime
 	)
 
 	ip, _, err := net.ParseCIDR("10.0.0.1/32")
@@ -54,7 +61,8 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 	BeforeEach(func() {
 		dataplane = &mockIPIPDataplane{}
 		ipSets = common.NewMockIPSets()
-		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil)
+		mockTime = mocktime.New()
+		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mockTime)
 	})
 
 	Describe("after calling configureIPIPDevice", func() {
@@ -64,7 +72,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 		}
 
 		BeforeEach(func() {
-			err = ipipMgr.configureIPIPDevice(1400, ip, false)
+			err = ipipMgr.configureIPIPDevice(1400, 0, ip, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -85,7 +93,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 		Describe("after second call with same params", func() {
 			BeforeEach(func() {
 				dataplane.ResetCalls()
-				err := ipipMgr.configureIPIPDevice(1400, ip, false)
+				err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
 				Expect(err).ToNot(HaveOccurred())
 			})
 			It("should avoid creating the interface", func() {
@@ -105,7 +113,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 		Describe("after second call with different params", func() {
 			BeforeEach(func() {
 				dataplane.ResetCalls()
-				err = ipipMgr.configureIPIPDevice(1500, ip2, false)
+				err = ipipMgr.configureIPIPDevice(1500, 0, ip2, false)
 				Expect(err).ToNot(HaveOccurred())
 
 			})
@@ -127,7 +135,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 		Describe("after second call with nil IP", func() {
 			BeforeEach(func() {
 				dataplane.ResetCalls()
-				err := ipipMgr.configureIPIPDevice(1500, nil, false)
+				err := ipipMgr.configureIPIPDevice(1500, 0, nil, false)
 				Expect(err).ToNot(HaveOccurred())
 			})
 			It("should avoid creating the interface", func() {
@@ -147,7 +155,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 
 	Describe("after calling configureIPIPDevice with no IP", func() {
 		BeforeEach(func() {
-			err := ipipMgr.configureIPIPDevice(1400, nil, false)
+			err := ipipMgr.configureIPIPDevice(1400, 0, nil, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -165,12 +173,448 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 		})
 	})
 
+	Describe("after calling configureIPIPDevice with a txqueuelen", func() {
+		BeforeEach(func() {
+			err := ipipMgr.configureIPIPDevice(1400, 1000, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should set the txqueuelen", func() {
+			Expect(dataplane.tunnelLinkAttrs.TxQLen).To(Equal(1000))
+		})
+
+		Describe("after second call with same params", func() {
+			BeforeEach(func() {
+				dataplane.ResetCalls()
+				err := ipipMgr.configureIPIPDevice(1400, 1000, ip, false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			It("should avoid setting the txqueuelen again", func() {
+				Expect(dataplane.LinkSetTxQLenCalled).To(BeFalse())
+			})
+		})
+
+		Describe("after the txqueuelen drifts", func() {
+			BeforeEach(func() {
+				dataplane.tunnelLinkAttrs.TxQLen = 500
+				dataplane.ResetCalls()
+				err := ipipMgr.configureIPIPDevice(1400, 1000, ip, false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			It("should correct the txqueuelen", func() {
+				Expect(dataplane.LinkSetTxQLenCalled).To(BeTrue())
+				Expect(dataplane.tunnelLinkAttrs.TxQLen).To(Equal(1000))
+			})
+		})
+
+		Describe("after a call with no txqueuelen", func() {
+			BeforeEach(func() {
+				dataplane.ResetCalls()
+				err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			It("should leave the txqueuelen alone", func() {
+				Expect(dataplane.LinkSetTxQLenCalled).To(BeFalse())
+				Expect(dataplane.tunnelLinkAttrs.TxQLen).To(Equal(1000))
+			})
+		})
+	})
+
+	It("should leave the master alone with no VRF configured", func() {
+		err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataplane.LinkSetMasterCalled).To(BeFalse())
+	})
+
+	Describe("with a VRF configured", func() {
+		BeforeEach(func() {
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mockTime, withIPIPVRF("vrf-blue"))
+		})
+
+		It("should fail if the VRF device doesn't exist", func() {
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).To(HaveOccurred())
+			Expect(dataplane.LinkSetMasterCalled).To(BeFalse())
+		})
+
+		It("should fail if the device isn't a VRF", func() {
+			dataplane.vrfLink = &mockLink{typ: "dummy"}
+			dataplane.vrfLink.attrs.Name = "vrf-blue"
+			dataplane.vrfLink.attrs.Index = 10
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).To(HaveOccurred())
+			Expect(dataplane.LinkSetMasterCalled).To(BeFalse())
+		})
+
+		Describe("after calling configureIPIPDevice", func() {
+			BeforeEach(func() {
+				dataplane.vrfLink = &mockLink{typ: "vrf"}
+				dataplane.vrfLink.attrs.Name = "vrf-blue"
+				dataplane.vrfLink.attrs.Index = 10
+				err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should enslave the device to the VRF", func() {
+				Expect(dataplane.LinkSetMasterCalled).To(BeTrue())
+				Expect(dataplane.tunnelLinkAttrs.MasterIndex).To(Equal(10))
+			})
+
+			It("should avoid setting the master again", func() {
+				dataplane.ResetCalls()
+				err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataplane.LinkSetMasterCalled).To(BeFalse())
+			})
+
+			It("should correct the master if it drifts", func() {
+				dataplane.tunnelLinkAttrs.MasterIndex = 0
+				dataplane.ResetCalls()
+				err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataplane.LinkSetMasterCalled).To(BeTrue())
+				Expect(dataplane.tunnelLinkAttrs.MasterIndex).To(Equal(10))
+			})
+		})
+	})
+
+	Describe("with a remote configured", func() {
+		remote := net.ParseIP("172.16.0.2")
+
+		BeforeEach(func() {
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mockTime, withIPIPRemote(remote))
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should create a dedicated point-to-point device", func() {
+			Expect(dataplane.RunCmdCalled).To(BeFalse())
+			Expect(dataplane.tunnelLink).To(BeNil())
+			Expect(dataplane.p2pLink).To(BeAssignableToTypeOf(&netlink.Iptun{}))
+			Expect(dataplane.p2pLink.Attrs().Name).To(Equal(IPIPP2PIfaceNameV4))
+			Expect(dataplane.p2pLink.(*netlink.Iptun).Remote.Equal(remote)).To(BeTrue())
+		})
+
+		It("should configure the point-to-point device", func() {
+			Expect(dataplane.tunnelLinkAttrs.Name).To(Equal(IPIPP2PIfaceNameV4))
+			Expect(dataplane.tunnelLinkAttrs.MTU).To(Equal(1400))
+			Expect(dataplane.tunnelLinkAttrs.Flags).To(Equal(net.FlagUp))
+			Expect(dataplane.addrs).To(HaveLen(1))
+		})
+
+		It("should avoid recreating the device", func() {
+			dataplane.ResetCalls()
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataplane.LinkDelCalled).To(BeFalse())
+			Expect(dataplane.LinkAddCalled).To(BeFalse())
+		})
+
+		It("should recreate the device if its remote drifts", func() {
+			dataplane.p2pLink.(*netlink.Iptun).Remote = net.ParseIP("172.16.0.3")
+			dataplane.ResetCalls()
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataplane.LinkDelCalled).To(BeTrue())
+			Expect(dataplane.LinkAddCalled).To(BeTrue())
+			Expect(dataplane.p2pLink.(*netlink.Iptun).Remote.Equal(remote)).To(BeTrue())
+		})
+
+		It("should remove the device once the remote is no longer configured", func() {
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mockTime)
+			dataplane.ResetCalls()
+			ipipMgr.removeUnusedP2PDevice()
+			Expect(dataplane.LinkDelCalled).To(BeTrue())
+			Expect(dataplane.p2pLink).To(BeNil())
+		})
+
+		It("should keep the device while the remote is configured", func() {
+			dataplane.ResetCalls()
+			ipipMgr.removeUnusedP2PDevice()
+			Expect(dataplane.LinkDelCalled).To(BeFalse())
+			Expect(dataplane.p2pLink).NotTo(BeNil())
+		})
+	})
+
+	Describe("with no remote configured", func() {
+		It("should use the multipoint tunl0 device", func() {
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataplane.p2pLink).To(BeNil())
+			Expect(dataplane.tunnelLink.Remote).To(BeNil())
+		})
+
+		It("should treat an unspecified remote as multipoint", func() {
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+			dataplane.tunnelLink.Remote = net.IPv4zero
+			dataplane.ResetCalls()
+			err = ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataplane.LinkDelCalled).To(BeFalse())
+		})
+
+		It("should do nothing if there is no point-to-point device to remove", func() {
+			ipipMgr.removeUnusedP2PDevice()
+			Expect(dataplane.LinkDelCalled).To(BeFalse())
+		})
+	})
+
+	Describe("KeepIPIPDeviceInSync", func() {
+		var (
+			cancel context.CancelFunc
+			done   chan struct{}
+		)
+
+		// waitForSleep waits until the sync loop has scheduled its next timer.  The loop is then
+		// blocked on the mock clock so it's safe to inspect the mock dataplane.
+		waitForSleep := func() {
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+		}
+
+		BeforeEach(func() {
+			// Fail the "ip tunnel add" on the first attempt.
+			dataplane.ErrorAtCall = 2
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				ipipMgr.KeepIPIPDeviceInSync(ctx, 1400, 0, ip, false)
+			}()
+			waitForSleep()
+		})
+
+		AfterEach(func() {
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should not configure the device after the failure", func() {
+			Expect(dataplane.NumCalls).To(Equal(2))
+			Expect(dataplane.tunnelLink).To(BeNil())
+		})
+
+		It("should not retry before the retry interval", func() {
+			mockTime.IncrementTime(999 * time.Millisecond)
+			Expect(dataplane.NumCalls).To(Equal(2))
+			Expect(mockTime.HasTimers()).To(BeTrue())
+		})
+
+		Describe("after the retry interval", func() {
+			BeforeEach(func() {
+				mockTime.IncrementTime(1 * time.Second)
+				waitForSleep()
+			})
+
+			It("should configure the device", func() {
+				Expect(dataplane.tunnelLink).NotTo(BeNil())
+				Expect(dataplane.tunnelLinkAttrs.MTU).To(Equal(1400))
+				Expect(dataplane.tunnelLinkAttrs.Flags & net.FlagUp).NotTo(BeZero())
+				Expect(dataplane.addrs).To(HaveLen(1))
+				Expect(dataplane.addrs[0].IP.String()).To(Equal(ip.String()))
+			})
+
+			It("should recheck the device after the resync interval", func() {
+				numCalls := dataplane.NumCalls
+				dataplane.ResetCalls()
+				mockTime.IncrementTime(9 * time.Second)
+				Expect(dataplane.NumCalls).To(Equal(numCalls))
+
+				mockTime.IncrementTime(1 * time.Second)
+				waitForSleep()
+				Expect(dataplane.NumCalls).To(BeNumerically(">", numCalls))
+				Expect(dataplane.RunCmdCalled).To(BeFalse())
+				Expect(dataplane.LinkSetMTUCalled).To(BeFalse())
+				Expect(dataplane.AddrUpdated).To(BeFalse())
+			})
+		})
+
+		It("should exit when the context is cancelled", func() {
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+
+	Describe("with a health callback", func() {
+		var (
+			events chan ipipHealthEvent
+			cancel context.CancelFunc
+			done   chan struct{}
+		)
+
+		BeforeEach(func() {
+			events = make(chan ipipHealthEvent, 10)
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mockTime,
+				withIPIPHealthCallback(func(e ipipHealthEvent) { events <- e }))
+
+			// Fail the "ip tunnel add" on the first attempt.
+			dataplane.ErrorAtCall = 2
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				ipipMgr.KeepIPIPDeviceInSync(ctx, 1400, 0, ip, false)
+			}()
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+		})
+
+		AfterEach(func() {
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should emit an event for each transition", func() {
+			var e ipipHealthEvent
+			Eventually(events).Should(Receive(&e))
+			Expect(e.Healthy).To(BeFalse())
+			Expect(e.Err).To(Equal(mockFailure))
+
+			// Retry succeeds.
+			mockTime.IncrementTime(1 * time.Second)
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+			Eventually(events).Should(Receive(&e))
+			Expect(e.Healthy).To(BeTrue())
+			Expect(e.Err).NotTo(HaveOccurred())
+
+			// Periodic resync succeeds again; no transition.
+			mockTime.IncrementTime(10 * time.Second)
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+			Expect(events).NotTo(Receive())
+
+			// Next resync fails.  (A failure of the first LinkByName is handled, so fail the second
+			// call.)
+			dataplane.ErrorAtCall = dataplane.NumCalls + 2
+			mockTime.IncrementTime(10 * time.Second)
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+			Eventually(events).Should(Receive(&e))
+			Expect(e.Healthy).To(BeFalse())
+			Expect(e.Err).To(Equal(mockFailure))
+			Expect(events).NotTo(Receive())
+		})
+	})
+
+	Describe("with a limit on device configuration attempts", func() {
+		var (
+			events chan ipipHealthEvent
+			cancel context.CancelFunc
+			done   chan struct{}
+		)
+
+		BeforeEach(func() {
+			events = make(chan ipipHealthEvent, 10)
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mockTime,
+				withIPIPHealthCallback(func(e ipipHealthEvent) { events <- e }),
+				withIPIPMaxDeviceAttempts(3))
+			dataplane.AlwaysFail = true
+
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				ipipMgr.KeepIPIPDeviceInSync(ctx, 1400, 0, ip, false)
+			}()
+		})
+
+		AfterEach(func() {
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should give up and report a fatal event after the budget", func() {
+			var e ipipHealthEvent
+			Eventually(events).Should(Receive(&e))
+			Expect(e.Healthy).To(BeFalse())
+			Expect(e.Fatal).To(BeFalse())
+
+			// Two more failed attempts use up the budget.
+			for i := 0; i < 2; i++ {
+				Eventually(mockTime.HasTimers).Should(BeTrue())
+				mockTime.IncrementTime(1 * time.Second)
+			}
+			Eventually(events).Should(Receive(&e))
+			Expect(e.Fatal).To(BeTrue())
+			Expect(e.Healthy).To(BeFalse())
+			Expect(e.Err).To(Equal(mockFailure))
+
+			// The loop has exited without retrying.
+			Eventually(done).Should(BeClosed())
+			Expect(mockTime.HasTimers()).To(BeFalse())
+			Expect(events).NotTo(Receive())
+		})
+
+		It("should reset the budget after a success", func() {
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+			mockTime.IncrementTime(1 * time.Second)
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+			dataplane.AlwaysFail = false
+			mockTime.IncrementTime(1 * time.Second)
+			Eventually(mockTime.HasTimers).Should(BeTrue())
+
+			// Two more failures don't exhaust the budget, since the count started again.
+			dataplane.AlwaysFail = true
+			for i := 0; i < 2; i++ {
+				mockTime.IncrementTime(10 * time.Second)
+				Eventually(mockTime.HasTimers).Should(BeTrue())
+			}
+			Consistently(done).ShouldNot(BeClosed())
+			for len(events) > 0 {
+				Expect((<-events).Fatal).To(BeFalse())
+			}
+		})
+	})
+
+	Describe("with health reporting configured", func() {
+		var (
+			aggregator *health.HealthAggregator
+			cb         func(ipipHealthEvent)
+		)
+
+		BeforeEach(func() {
+			aggregator = health.NewHealthAggregator()
+			m := &ipipManager{}
+			for _, o := range ipipManagerOpts(Config{HealthAggregator: aggregator}, "ipip-test") {
+				o(m)
+			}
+			cb = m.healthCallback
+			Expect(cb).NotTo(BeNil())
+		})
+
+		It("should be live but not ready before the device is configured", func() {
+			summary := aggregator.Summary()
+			Expect(summary.Live).To(BeTrue())
+			Expect(summary.Ready).To(BeFalse())
+		})
+
+		It("should report each readiness transition", func() {
+			cb(ipipHealthEvent{Healthy: true})
+			Expect(aggregator.Summary().Ready).To(BeTrue())
+
+			cb(ipipHealthEvent{Healthy: false, Err: mockFailure})
+			summary := aggregator.Summary()
+			Expect(summary.Live).To(BeTrue())
+			Expect(summary.Ready).To(BeFalse())
+
+			cb(ipipHealthEvent{Healthy: true})
+			Expect(aggregator.Summary().Ready).To(BeTrue())
+		})
+
+		It("should report not live once the sync loop gives up", func() {
+			cb(ipipHealthEvent{Healthy: false, Err: mockFailure, Fatal: true})
+			summary := aggregator.Summary()
+			Expect(summary.Live).To(BeFalse())
+			Expect(summary.Ready).To(BeFalse())
+		})
+	})
+
 	// Cover the error cases.  We pass the error back up the stack, check that that happens
 	// for all calls.
 	const expNumCalls = 8
 	It("a successful call should only call into dataplane expected number of times", func() {
 		// This spec is a sanity-check that we've got the expNumCalls constant correct.
-		err := ipipMgr.configureIPIPDevice(1400, ip, false)
+		err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(dataplane.NumCalls).To(BeNumerically("==", expNumCalls))
 	})
@@ -185,7 +629,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 			})
 
 			It("should return the error", func() {
-				Expect(ipipMgr.configureIPIPDevice(1400, ip, false)).To(Equal(mockFailure))
+				Expect(ipipMgr.configureIPIPDevice(1400, 0, ip, false)).To(Equal(mockFailure))
 			})
 
 			Describe("with an IP to remove", func() {
@@ -196,7 +640,7 @@ var _ = Describe("IpipMgr (tunnel configuration)", func() {
 						})
 				})
 				It("should return the error", func() {
-					Expect(ipipMgr.configureIPIPDevice(1400, ip, false)).To(Equal(mockFailure))
+					Expect(ipipMgr.configureIPIPDevice(1400, 0, ip, false)).To(Equal(mockFailure))
 				})
 			})
 		})
@@ -217,7 +661,7 @@ var _ = Describe("ipipManager IP set updates", func() {
 	BeforeEach(func() {
 		dataplane = &mockIPIPDataplane{}
 		ipSets = common.NewMockIPSets()
-		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, []string{externalCIDR})
+		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, []string{externalCIDR}, mocktime.New())
 	})
 
 	It("should not create the IP set until first call to CompleteDeferredWork()", func() {
@@ -332,6 +776,53 @@ var _ = Describe("ipipManager IP set updates", func() {
 			})
 		})
 
+		It("should count IP set updates and report the number of members", func() {
+			updatesBefore := testutil.ToFloat64(countAllHostsIPSetUpdates.WithLabelValues("4"))
+			Expect(testutil.ToFloat64(gaugeAllHostsIPSetMembers.WithLabelValues("4"))).To(Equal(2.0))
+
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host2",
+				Ipv4Addr: "10.0.0.2",
+			})
+			Expect(ipipMgr.CompleteDeferredWork()).To(Succeed())
+			Expect(testutil.ToFloat64(countAllHostsIPSetUpdates.WithLabelValues("4"))).To(Equal(updatesBefore + 1))
+			Expect(testutil.ToFloat64(gaugeAllHostsIPSetMembers.WithLabelValues("4"))).To(Equal(3.0))
+
+			// A batch with no changes doesn't rewrite the IP set.
+			Expect(ipipMgr.CompleteDeferredWork()).To(Succeed())
+			Expect(testutil.ToFloat64(countAllHostsIPSetUpdates.WithLabelValues("4"))).To(Equal(updatesBefore + 1))
+		})
+
+		It("should find no drift in an audit", func() {
+			ipSets.AddOrReplaceCalled = false
+			Expect(ipipMgr.AuditAllHostsIPSet(true)).To(BeTrue())
+			Expect(ipSets.AddOrReplaceCalled).To(BeFalse())
+		})
+
+		Describe("after the IP set drifts", func() {
+			var driftBefore float64
+
+			BeforeEach(func() {
+				driftBefore = testutil.ToFloat64(countAllHostsIPSetDrift)
+				allHostsSet().Discard("10.0.0.1")
+				allHostsSet().Add("10.0.0.9")
+				ipSets.AddOrReplaceCalled = false
+			})
+
+			It("should detect the drift without rebuilding", func() {
+				Expect(ipipMgr.AuditAllHostsIPSet(false)).To(BeFalse())
+				Expect(testutil.ToFloat64(countAllHostsIPSetDrift)).To(Equal(driftBefore + 1))
+				Expect(ipSets.AddOrReplaceCalled).To(BeFalse())
+			})
+
+			It("should detect and correct the drift", func() {
+				Expect(ipipMgr.AuditAllHostsIPSet(true)).To(BeFalse())
+				Expect(testutil.ToFloat64(countAllHostsIPSetDrift)).To(Equal(driftBefore + 1))
+				Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", externalCIDR)))
+				Expect(ipipMgr.AuditAllHostsIPSet(true)).To(BeTrue())
+			})
+		})
+
 		Describe("after a no-op batch", func() {
 			BeforeEach(func() {
 				ipSets.AddOrReplaceCalled = false
@@ -343,32 +834,390 @@ var _ = Describe("ipipManager IP set updates", func() {
 			})
 		})
 	})
+
+	Describe("with a host removal grace period", func() {
+		var mockTime *mocktime.MockTime
+
+		BeforeEach(func() {
+			mockTime = mocktime.New()
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, []string{externalCIDR}, mockTime,
+				withIPIPHostRemovalGracePeriod(30*time.Second))
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host1",
+				Ipv4Addr: "10.0.0.1",
+			})
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host2",
+				Ipv4Addr: "10.0.0.2",
+			})
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipipMgr.RescheduleAfter()).To(BeZero())
+
+			ipipMgr.OnUpdate(&proto.HostMetadataRemove{
+				Hostname: "host2",
+			})
+			ipSets.AddOrReplaceCalled = false
+			err = ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should keep the removed host's IP during the grace period", func() {
+			Expect(ipSets.AddOrReplaceCalled).To(BeFalse())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", "10.0.0.2", externalCIDR)))
+			Expect(ipipMgr.RescheduleAfter()).To(Equal(30 * time.Second))
+
+			mockTime.IncrementTime(29 * time.Second)
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipSets.AddOrReplaceCalled).To(BeFalse())
+			Expect(ipipMgr.RescheduleAfter()).To(Equal(time.Second))
+		})
+
+		It("should remove the host's IP after the grace period", func() {
+			mockTime.IncrementTime(30 * time.Second)
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", externalCIDR)))
+			Expect(ipipMgr.RescheduleAfter()).To(BeZero())
+		})
+
+		It("should not restart the grace period on a repeated removal", func() {
+			mockTime.IncrementTime(20 * time.Second)
+			ipipMgr.OnUpdate(&proto.HostMetadataRemove{
+				Hostname: "host2",
+			})
+			mockTime.IncrementTime(10 * time.Second)
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", externalCIDR)))
+		})
+
+		It("should cancel the removal if the host comes back", func() {
+			mockTime.IncrementTime(10 * time.Second)
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host2",
+				Ipv4Addr: "10.0.0.2",
+			})
+			Expect(ipipMgr.RescheduleAfter()).To(BeZero())
+			mockTime.IncrementTime(time.Minute)
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", "10.0.0.2", externalCIDR)))
+		})
+
+		It("should not start a grace period for an unknown host", func() {
+			mockTime.IncrementTime(10 * time.Second)
+			ipipMgr.OnUpdate(&proto.HostMetadataRemove{
+				Hostname: "host3",
+			})
+			// Only host2's removal is still pending.
+			Expect(ipipMgr.RescheduleAfter()).To(Equal(20 * time.Second))
+		})
+	})
+
+	Describe("with a minimum rebuild interval", func() {
+		var mockTime *mocktime.MockTime
+
+		BeforeEach(func() {
+			mockTime = mocktime.New()
+			ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, []string{externalCIDR}, mockTime,
+				withIPIPMinRebuildInterval(10*time.Second))
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host1",
+				Ipv4Addr: "10.0.0.1",
+			})
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipSets.AddOrReplaceCalled).To(BeTrue())
+			Expect(ipipMgr.RescheduleAfter()).To(BeZero())
+			ipSets.AddOrReplaceCalled = false
+		})
+
+		It("should coalesce changes within the interval into one rebuild", func() {
+			mockTime.IncrementTime(2 * time.Second)
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host2",
+				Ipv4Addr: "10.0.0.2",
+			})
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipSets.AddOrReplaceCalled).To(BeFalse())
+			Expect(ipipMgr.RescheduleAfter()).To(Equal(8 * time.Second))
+
+			mockTime.IncrementTime(3 * time.Second)
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host3",
+				Ipv4Addr: "10.0.0.3",
+			})
+			ipipMgr.OnUpdate(&proto.HostMetadataRemove{
+				Hostname: "host1",
+			})
+			err = ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipSets.AddOrReplaceCalled).To(BeFalse())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", externalCIDR)))
+			Expect(ipipMgr.RescheduleAfter()).To(Equal(5 * time.Second))
+
+			mockTime.IncrementTime(5 * time.Second)
+			err = ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipSets.AddOrReplaceCalled).To(BeTrue())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.2", "10.0.0.3", externalCIDR)))
+			Expect(ipipMgr.RescheduleAfter()).To(BeZero())
+		})
+
+		It("should rebuild immediately after the interval has passed", func() {
+			mockTime.IncrementTime(10 * time.Second)
+			ipipMgr.OnUpdate(&proto.HostMetadataUpdate{
+				Hostname: "host2",
+				Ipv4Addr: "10.0.0.2",
+			})
+			err := ipipMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipSets.AddOrReplaceCalled).To(BeTrue())
+			Expect(allHostsSet()).To(Equal(set.From("10.0.0.1", "10.0.0.2", externalCIDR)))
+		})
+	})
+})
+
+var _ = Describe("ipipManager self-test", func() {
+	var (
+		ipipMgr   *ipipManager
+		ipSets    *common.MockIPSets
+		dataplane *mockIPIPDataplane
+	)
+
+	checkResults := func(report IPIPSelfTestReport) map[string]bool {
+		results := map[string]bool{}
+		for _, c := range report.Checks {
+			results[c.Name] = c.Passed
+		}
+		return results
+	}
+
+	BeforeEach(func() {
+		dataplane = &mockIPIPDataplane{}
+		dataplane.vrfLink = &mockLink{typ: "vrf"}
+		dataplane.vrfLink.attrs.Name = "vrf-blue"
+		dataplane.vrfLink.attrs.Index = 10
+		ipSets = common.NewMockIPSets()
+		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mocktime.New(), withIPIPVRF("vrf-blue"))
+
+		Expect(ipipMgr.configureIPIPDevice(1400, 0, net.ParseIP("10.0.0.1"), false)).To(Succeed())
+		ipipMgr.OnUpdate(&proto.HostMetadataUpdate{Hostname: "host1", Ipv4Addr: "10.0.0.1"})
+		Expect(ipipMgr.CompleteDeferredWork()).To(Succeed())
+	})
+
+	It("should pass when healthy", func() {
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Passed()).To(BeTrue())
+		Expect(checkResults(report)).To(Equal(map[string]bool{
+			"vrf-device":      true,
+			"tunnel-device":   true,
+			"all-hosts-ipset": true,
+		}))
+	})
+
+	It("should pass without a VRF", func() {
+		ipipMgr.vrfName = ""
+		dataplane.vrfLink = nil
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Passed()).To(BeTrue())
+	})
+
+	It("should fail if the VRF device is missing", func() {
+		dataplane.vrfLink = nil
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Passed()).To(BeFalse())
+		Expect(checkResults(report)).To(HaveKeyWithValue("vrf-device", false))
+		Expect(checkResults(report)).To(HaveKeyWithValue("tunnel-device", true))
+	})
+
+	It("should fail if the tunnel device is down", func() {
+		dataplane.tunnelLinkAttrs.Flags &^= net.FlagUp
+		dataplane.ResetCalls()
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkResults(report)).To(HaveKeyWithValue("tunnel-device", false))
+		Expect(dataplane.LinkSetUpCalled).To(BeFalse(), "self-test shouldn't try to fix the device")
+	})
+
+	It("should fail if the tunnel device is missing", func() {
+		dataplane.tunnelLink = nil
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkResults(report)).To(HaveKeyWithValue("tunnel-device", false))
+	})
+
+	It("should fail if the all-hosts IP set has drifted", func() {
+		ipSets.Members["all-hosts-net"].Discard("10.0.0.1")
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkResults(report)).To(HaveKeyWithValue("all-hosts-ipset", false))
+		Expect(report.Checks[2].Detail).To(ContainSubstring("10.0.0.1"))
+	})
+
+	It("should fail while an all-hosts IP set update is pending", func() {
+		ipipMgr.OnUpdate(&proto.HostMetadataUpdate{Hostname: "host2", Ipv4Addr: "10.0.0.2"})
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkResults(report)).To(HaveKeyWithValue("all-hosts-ipset", false))
+	})
+
+	It("should stop when the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, err := ipipMgr.SelfTest(ctx)
+		Expect(err).To(Equal(context.Canceled))
+		Expect(report.Checks).To(BeEmpty())
+	})
+})
+
+var _ = Describe("IpipMgr (IPv6)", func() {
+	var (
+		ipipMgr   *ipipManager
+		ipSets    *common.MockIPSets
+		dataplane *mockIPIPDataplane
+	)
+
+	ip := net.ParseIP("fd00::1")
+
+	BeforeEach(func() {
+		dataplane = &mockIPIPDataplane{}
+		ipSets = common.NewMockIPSets()
+		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, []string{"11.0.0.1/32", "fd11::/64"},
+			mocktime.New(), withIPIPVersion(6))
+	})
+
+	It("should reject an unknown IP version", func() {
+		Expect(func() {
+			newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mocktime.New(), withIPIPVersion(5))
+		}).To(Panic())
+	})
+
+	Describe("after calling configureIPIPDevice", func() {
+		BeforeEach(func() {
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should create an ip6ip6 tunnel device with netlink", func() {
+			Expect(dataplane.LinkAddCalled).To(BeTrue())
+			Expect(dataplane.RunCmdCalled).To(BeFalse())
+			Expect(dataplane.tunnel6Link.Name).To(Equal(IPIPIfaceNameV6))
+			Expect(dataplane.tunnel6Link.Proto).To(BeEquivalentTo(syscall.IPPROTO_IPV6))
+			Expect(dataplane.tunnelLink).To(BeNil())
+		})
+		It("should set the MTU", func() {
+			Expect(dataplane.tunnelLinkAttrs.MTU).To(Equal(1400))
+		})
+		It("should set the interface UP", func() {
+			Expect(dataplane.tunnelLinkAttrs.Flags).To(Equal(net.FlagUp))
+		})
+		It("should configure the IPv6 address", func() {
+			Expect(dataplane.addrFamily).To(Equal(netlink.FAMILY_V6))
+			Expect(dataplane.addrs).To(HaveLen(1))
+			Expect(dataplane.addrs[0].IPNet.String()).To(Equal("fd00::1/128"))
+		})
+
+		It("should avoid creating the interface again", func() {
+			dataplane.ResetCalls()
+			err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataplane.LinkAddCalled).To(BeFalse())
+			Expect(dataplane.AddrUpdated).To(BeFalse())
+		})
+	})
+
+	It("should create a dedicated point-to-point device with a remote configured", func() {
+		remote := net.ParseIP("fd00::2")
+		ipipMgr = newIPIPManagerWithShim(ipSets, 1024, dataplane, nil, mocktime.New(),
+			withIPIPVersion(6), withIPIPRemote(remote))
+		err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataplane.tunnel6Link).To(BeNil())
+		Expect(dataplane.p2pLink).To(BeAssignableToTypeOf(&netlink.Ip6tnl{}))
+		tun := dataplane.p2pLink.(*netlink.Ip6tnl)
+		Expect(tun.Name).To(Equal(IPIPP2PIfaceNameV6))
+		Expect(tun.Proto).To(BeEquivalentTo(syscall.IPPROTO_IPV6))
+		Expect(tun.Remote.Equal(remote)).To(BeTrue())
+
+		dataplane.ResetCalls()
+		err = ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataplane.LinkDelCalled).To(BeFalse())
+		Expect(dataplane.LinkAddCalled).To(BeFalse())
+	})
+
+	It("should check the IPv6 tunnel device in the self-test", func() {
+		err := ipipMgr.configureIPIPDevice(1400, 0, ip, false)
+		Expect(err).ToNot(HaveOccurred())
+		report, err := ipipMgr.SelfTest(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Checks[1]).To(Equal(IPIPSelfTestCheck{
+			Name: "tunnel-device", Passed: true, Detail: "tunnel device is up",
+		}))
+	})
+
+	It("should put the hosts' IPv6 addresses and IPv6 external CIDRs in the all-hosts IP set", func() {
+		ipipMgr.OnUpdate(&proto.HostMetadataV6Update{Hostname: "host1", Ipv6Addr: "fd00::1"})
+		ipipMgr.OnUpdate(&proto.HostMetadataV6Update{Hostname: "host2", Ipv6Addr: "fd00::2"})
+		// IPv4 host updates are for the IPv4 tunnel.
+		ipipMgr.OnUpdate(&proto.HostMetadataUpdate{Hostname: "host3", Ipv4Addr: "10.0.0.3"})
+		err := ipipMgr.CompleteDeferredWork()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ipSets.Members["all-hosts-net"]).To(Equal(set.From("fd00::1", "fd00::2", "fd11::/64")))
+
+		ipipMgr.OnUpdate(&proto.HostMetadataV6Remove{Hostname: "host2"})
+		ipipMgr.OnUpdate(&proto.HostMetadataRemove{Hostname: "host1"})
+		err = ipipMgr.CompleteDeferredWork()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ipSets.Members["all-hosts-net"]).To(Equal(set.From("fd00::1", "fd11::/64")))
+	})
 })
 
 type mockIPIPDataplane struct {
-	tunnelLink      *mockLink
+	tunnelLink      *netlink.Iptun
+	tunnel6Link     *netlink.Ip6tnl
+	p2pLink         netlink.Link
 	tunnelLinkAttrs *netlink.LinkAttrs
 	addrs           []netlink.Addr
+	addrFamily      int
+	vrfLink         *mockLink
 
-	RunCmdCalled     bool
-	LinkSetMTUCalled bool
-	LinkSetUpCalled  bool
-	AddrUpdated      bool
+	RunCmdCalled        bool
+	LinkAddCalled       bool
+	LinkDelCalled       bool
+	LinkSetMTUCalled    bool
+	LinkSetTxQLenCalled bool
+	LinkSetUpCalled     bool
+	LinkSetMasterCalled bool
+	AddrUpdated         bool
 
 	NumCalls    int
 	ErrorAtCall int
+	// AlwaysFail makes every call fail.
+	AlwaysFail bool
 }
 
 func (d *mockIPIPDataplane) ResetCalls() {
 	d.RunCmdCalled = false
+	d.LinkAddCalled = false
+	d.LinkDelCalled = false
 	d.LinkSetMTUCalled = false
+	d.LinkSetTxQLenCalled = false
 	d.LinkSetUpCalled = false
+	d.LinkSetMasterCalled = false
 	d.AddrUpdated = false
 }
 
 func (d *mockIPIPDataplane) incCallCount() error {
 	d.NumCalls += 1
-	if d.NumCalls == d.ErrorAtCall {
+	if d.AlwaysFail || d.NumCalls == d.ErrorAtCall {
 		log.Warn("Simulating an error due to call count")
 		return mockFailure
 	}
@@ -382,11 +1231,48 @@ func (d *mockIPIPDataplane) LinkByName(name string) (netlink.Link, error) {
 		return nil, err
 	}
 
-	Expect(name).To(Equal("tunl0"))
-	if d.tunnelLink == nil {
-		return nil, notFound
+	switch {
+	case name == IPIPIfaceNameV4 && d.tunnelLink != nil:
+		return d.tunnelLink, nil
+	case name == IPIPIfaceNameV6 && d.tunnel6Link != nil:
+		return d.tunnel6Link, nil
+	case d.p2pLink != nil && name == d.p2pLink.Attrs().Name:
+		return d.p2pLink, nil
+	case d.vrfLink != nil && name == d.vrfLink.attrs.Name:
+		return d.vrfLink, nil
+	}
+	return nil, notFound
+}
+
+func (d *mockIPIPDataplane) LinkAdd(link netlink.Link) error {
+	d.LinkAddCalled = true
+	if err := d.incCallCount(); err != nil {
+		return err
+	}
+	log.WithField("link", link).Info("LinkAdd called")
+	if name := link.Attrs().Name; name == IPIPP2PIfaceNameV4 || name == IPIPP2PIfaceNameV6 {
+		Expect(d.p2pLink).To(BeNil())
+		d.p2pLink = link
+		d.tunnelLinkAttrs = link.Attrs()
+		return nil
 	}
-	return d.tunnelLink, nil
+	Expect(link).To(BeAssignableToTypeOf(&netlink.Ip6tnl{}))
+	Expect(d.tunnel6Link).To(BeNil())
+	d.tunnel6Link = link.(*netlink.Ip6tnl)
+	d.tunnelLinkAttrs = &d.tunnel6Link.LinkAttrs
+	return nil
+}
+
+func (d *mockIPIPDataplane) LinkDel(link netlink.Link) error {
+	d.LinkDelCalled = true
+	if err := d.incCallCount(); err != nil {
+		return err
+	}
+	log.WithField("link", link).Info("LinkDel called")
+	Expect(link).To(BeIdenticalTo(d.p2pLink))
+	d.p2pLink = nil
+	d.addrs = nil
+	return nil
 }
 
 func (d *mockIPIPDataplane) LinkSetMTU(link netlink.Link, mtu int) error {
@@ -394,26 +1280,47 @@ func (d *mockIPIPDataplane) LinkSetMTU(link netlink.Link, mtu int) error {
 	if err := d.incCallCount(); err != nil {
 		return err
 	}
-	Expect(link.Attrs().Name).To(Equal("tunl0"))
+	Expect(link.Attrs().Name).To(Equal(d.tunnelLinkAttrs.Name))
 	d.tunnelLinkAttrs.MTU = mtu
 	return nil
 }
 
+func (d *mockIPIPDataplane) LinkSetTxQLen(link netlink.Link, qlen int) error {
+	d.LinkSetTxQLenCalled = true
+	if err := d.incCallCount(); err != nil {
+		return err
+	}
+	Expect(link.Attrs().Name).To(Equal(d.tunnelLinkAttrs.Name))
+	d.tunnelLinkAttrs.TxQLen = qlen
+	return nil
+}
+
 func (d *mockIPIPDataplane) LinkSetUp(link netlink.Link) error {
 	d.LinkSetUpCalled = true
 	if err := d.incCallCount(); err != nil {
 		return err
 	}
-	Expect(link.Attrs().Name).To(Equal("tunl0"))
+	Expect(link.Attrs().Name).To(Equal(d.tunnelLinkAttrs.Name))
 	d.tunnelLinkAttrs.Flags |= net.FlagUp
 	return nil
 }
 
+func (d *mockIPIPDataplane) LinkSetMasterByIndex(link netlink.Link, masterIndex int) error {
+	d.LinkSetMasterCalled = true
+	if err := d.incCallCount(); err != nil {
+		return err
+	}
+	Expect(link.Attrs().Name).To(Equal(d.tunnelLinkAttrs.Name))
+	d.tunnelLinkAttrs.MasterIndex = masterIndex
+	return nil
+}
+
 func (d *mockIPIPDataplane) AddrList(link netlink.Link, family int) ([]netlink.Addr, error) {
 	if err := d.incCallCount(); err != nil {
 		return nil, err
 	}
-	Expect(link.Attrs().Name).To(Equal("tunl0"))
+	Expect(link.Attrs().Name).To(Equal(d.tunnelLinkAttrs.Name))
+	d.addrFamily = family
 	return d.addrs, nil
 }
 
@@ -449,9 +1356,9 @@ func (d *mockIPIPDataplane) RunCmd(name string, args ...string) error {
 
 	if d.tunnelLink == nil {
 		log.Info("Creating tunnel link")
-		link := &mockLink{}
-		link.attrs.Name = "tunl0"
-		d.tunnelLinkAttrs = &link.attrs
+		link := &netlink.Iptun{}
+		link.Name = "tunl0"
+		d.tunnelLinkAttrs = &link.LinkAttrs
 		d.tunnelLink = link
 	}
 	return nil
@@ -83,6 +83,13 @@ type vxlanManager struct {
 	myVTEPChangedC chan struct{}
 	myVTEP         *proto.VXLANTunnelEndpointUpdate
 
+	// Holds the parent interface of this node's VTEP, and its MTU, as last resolved by the device sync loop.
+	// parentDeviceIP is the address that the parent was resolved for.
+	parentLock     sync.Mutex
+	parentLink     netlink.Link
+	parentDeviceIP string
+	parentMTU      int
+
 	// VXLAN configuration.
 	vxlanDevice string
 	vxlanID     int
@@ -394,6 +401,81 @@ func (m *vxlanManager) getLocalVTEPParent() (netlink.Link, error) {
 	return m.getParentInterface(m.getLocalVTEP())
 }
 
+// refreshParent looks up the parent interface for the given local VTEP and caches it, along with its MTU.
+func (m *vxlanManager) refreshParent(localVTEP *proto.VXLANTunnelEndpointUpdate) (netlink.Link, error) {
+	parent, err := m.getParentInterface(localVTEP)
+	if err != nil {
+		return nil, err
+	}
+	m.parentLock.Lock()
+	defer m.parentLock.Unlock()
+	if m.parentMTU != parent.Attrs().MTU {
+		m.logCtx.WithFields(logrus.Fields{
+			"parent": parent.Attrs().Name,
+			"old":    m.parentMTU,
+			"new":    parent.Attrs().MTU,
+		}).Info("VXLAN device parent MTU changed")
+	}
+	m.parentLink = parent
+	m.parentDeviceIP = m.parentDeviceIPOf(localVTEP)
+	m.parentMTU = parent.Attrs().MTU
+	return parent, nil
+}
+
+// cachedParent returns the cached parent interface for the given local VTEP, looking it up if it hasn't been
+// resolved yet or was resolved for a different parent address.
+func (m *vxlanManager) cachedParent(localVTEP *proto.VXLANTunnelEndpointUpdate) (netlink.Link, error) {
+	m.parentLock.Lock()
+	parent := m.parentLink
+	if m.parentDeviceIP != m.parentDeviceIPOf(localVTEP) {
+		parent = nil
+	}
+	m.parentLock.Unlock()
+	if parent != nil {
+		return parent, nil
+	}
+	return m.refreshParent(localVTEP)
+}
+
+// ParentMTU returns the MTU of the VTEP's parent interface, as of the last time that it was resolved, or 0 if it
+// hasn't been resolved.
+func (m *vxlanManager) ParentMTU() int {
+	m.parentLock.Lock()
+	defer m.parentLock.Unlock()
+	return m.parentMTU
+}
+
+// mtuFitsParent returns false, with a warning, if encapsulated packets from a device with the given MTU wouldn't fit in
+// the parent interface's MTU.  The MTU isn't changed here, because the pod MTU is derived from the same configured
+// value, and they must agree; ConfigureDefaultMTUs clamps it to the host MTU up front.  If the parent's MTU isn't known,
+// the MTU is assumed to fit.
+func (m *vxlanManager) mtuFitsParent(mtu int) bool {
+	parentMTU := m.ParentMTU()
+	if parentMTU <= 0 {
+		return true
+	}
+	overhead := vxlanMTUOverhead
+	if m.ipVersion == 6 {
+		overhead = vxlanV6MTUOverhead
+	}
+	if maxMTU := parentMTU - overhead; mtu > maxMTU {
+		m.logCtx.WithFields(logrus.Fields{
+			"mtu":       mtu,
+			"parentMTU": parentMTU,
+			"maxMTU":    maxMTU,
+		}).Warn("Configured VXLAN MTU is too large for the parent interface, encapsulated packets may be dropped")
+		return false
+	}
+	return true
+}
+
+func (m *vxlanManager) parentDeviceIPOf(localVTEP *proto.VXLANTunnelEndpointUpdate) string {
+	if m.ipVersion == 6 {
+		return localVTEP.ParentDeviceIpv6
+	}
+	return localVTEP.ParentDeviceIp
+}
+
 func (m *vxlanManager) GetRouteTableSyncers() []routetable.RouteTableSyncer {
 	rts := []routetable.RouteTableSyncer{m.routeTable, m.blackholeRouteTable}
 
@@ -483,41 +565,28 @@ func (m *vxlanManager) CompleteDeferredWork() error {
 				continue
 			}
 
-			if r.GetSameSubnet() {
-				if r.DstNodeIp == "" {
-					logCtx.Debug("Can't program non-encap route since host IP is not known.")
-					continue
-				}
-
-				defaultRoute := routetable.Target{
+			expl := m.explainRoute(r)
+			switch expl.Decision {
+			case RouteDecisionNoEncap:
+				noEncapRoute := routetable.Target{
 					Type: routetable.TargetTypeNoEncap,
 					CIDR: cidr,
-					GW:   ip.FromString(r.DstNodeIp),
+					GW:   ip.FromString(expl.Gateway),
 				}
-
-				noEncapRoutes = append(noEncapRoutes, defaultRoute)
+				noEncapRoutes = append(noEncapRoutes, noEncapRoute)
 				logCtx.WithField("route", r).Debug("adding no encap route to list for addition")
-			} else {
-				// Extract the gateway addr for this route based on its remote VTEP.
-				vtep, ok := m.vtepsByNode[r.DstNodeName]
-				if !ok {
-					// When the VTEP arrives, it'll set routesDirty=true so this loop will execute again.
-					logCtx.Debug("Dataplane has route with no corresponding VTEP")
-					continue
-				}
-
-				vtepAddr := vtep.Ipv4Addr
-				if m.ipVersion == 6 {
-					vtepAddr = vtep.Ipv6Addr
-				}
+			case RouteDecisionVXLAN:
 				vxlanRoute := routetable.Target{
 					Type: routetable.TargetTypeVXLAN,
 					CIDR: cidr,
-					GW:   ip.FromString(vtepAddr),
+					GW:   ip.FromString(expl.Gateway),
 				}
-
 				vxlanRoutes = append(vxlanRoutes, vxlanRoute)
 				logCtx.WithField("route", vxlanRoute).Debug("adding vxlan route to list for addition")
+			default:
+				// When the missing information arrives, it'll set routesDirty=true so this loop will
+				// execute again.
+				logCtx.WithField("reason", expl.Reason).Debug("Can't program route yet")
 			}
 		}
 
@@ -542,6 +611,105 @@ func (m *vxlanManager) CompleteDeferredWork() error {
 	return nil
 }
 
+// RouteDecision is the way in which the VXLAN manager programs a route.
+type RouteDecision string
+
+const (
+	// RouteDecisionNoEncap means the route is programmed via the parent interface, without
+	// encapsulation, because the destination node is on the same subnet.
+	RouteDecisionNoEncap RouteDecision = "no-encap"
+	// RouteDecisionVXLAN means the route is programmed via the VXLAN device.
+	RouteDecisionVXLAN RouteDecision = "vxlan"
+	// RouteDecisionNotProgrammed means the route can't be programmed yet; see the explanation's
+	// Reason.
+	RouteDecisionNotProgrammed RouteDecision = "not-programmed"
+)
+
+// RouteExplanation describes how, and why, the VXLAN manager programs the route to a destination.
+type RouteExplanation struct {
+	// Dst is the destination CIDR of the route.
+	Dst      string
+	Decision RouteDecision
+	// SameSubnet is true if Felix's calculation graph determined that the destination node is on
+	// the same subnet as this node, so that traffic doesn't need encapsulating.
+	SameSubnet bool
+	// Gateway is the next hop: the destination node's IP for a no-encap route, or its VTEP
+	// address for a VXLAN route.
+	Gateway string
+	// Device is the interface that the route is programmed on.
+	Device string
+	// ParentInterface is the interface that carries the traffic off the host, either directly or
+	// once it has been encapsulated.
+	ParentInterface string
+	// Reason explains the decision.
+	Reason string
+}
+
+// ExplainRoute returns an explanation of how the route to the given destination is programmed.
+// dst may be the destination CIDR of a route, or an IP address within one, in which case the
+// most specific route is explained.  It returns an error if the manager has no route to dst.
+//
+// Like the manager's other methods, it must be called from the dataplane goroutine.
+func (m *vxlanManager) ExplainRoute(dst string) (RouteExplanation, error) {
+	r, ok := m.routesByDest[dst]
+	if !ok {
+		addr := ip.FromString(dst)
+		if addr == nil {
+			return RouteExplanation{}, fmt.Errorf("no VXLAN route to %s", dst)
+		}
+		bestLen := -1
+		for _, candidate := range m.routesByDest {
+			cidr, err := ip.CIDRFromString(candidate.Dst)
+			if err != nil || !cidr.Contains(addr) {
+				continue
+			}
+			if int(cidr.Prefix()) > bestLen {
+				r, bestLen = candidate, int(cidr.Prefix())
+			}
+		}
+		if r == nil {
+			return RouteExplanation{}, fmt.Errorf("no VXLAN route to %s", dst)
+		}
+	}
+	return m.explainRoute(r), nil
+}
+
+// explainRoute decides how to program the given route.
+func (m *vxlanManager) explainRoute(r *proto.RouteUpdate) RouteExplanation {
+	expl := RouteExplanation{
+		Dst:             r.Dst,
+		Decision:        RouteDecisionNotProgrammed,
+		SameSubnet:      r.GetSameSubnet(),
+		ParentInterface: m.parentIfaceName,
+	}
+	if r.GetSameSubnet() {
+		if r.DstNodeIp == "" {
+			expl.Reason = "destination node is on the same subnet but its IP is not known"
+			return expl
+		}
+		expl.Decision = RouteDecisionNoEncap
+		expl.Gateway = r.DstNodeIp
+		expl.Device = m.parentIfaceName
+		expl.Reason = "destination node is on the same subnet"
+		return expl
+	}
+
+	// Extract the gateway addr for this route based on its remote VTEP.
+	vtep, ok := m.vtepsByNode[r.DstNodeName]
+	if !ok {
+		expl.Reason = fmt.Sprintf("destination node %q is on a different subnet but has no VTEP", r.DstNodeName)
+		return expl
+	}
+	expl.Decision = RouteDecisionVXLAN
+	expl.Gateway = vtep.Ipv4Addr
+	if m.ipVersion == 6 {
+		expl.Gateway = vtep.Ipv6Addr
+	}
+	expl.Device = m.vxlanDevice
+	expl.Reason = "destination node is on a different subnet"
+	return expl
+}
+
 func (m *vxlanManager) OnParentNameUpdate(name string) {
 	if name == "" {
 		m.logCtx.Warn("Empty parent interface name? Ignoring.")
@@ -609,7 +777,7 @@ func (m *vxlanManager) KeepVXLANDeviceInSync(ctx context.Context, mtu int, xsumB
 			continue
 		}
 
-		parent, err := m.getLocalVTEPParent()
+		parent, err := m.refreshParent(localVTEP)
 		if err != nil {
 			m.logCtx.WithError(err).Warn("Failed to find VXLAN tunnel device parent, retrying...")
 			sleepMonitoringChans(1 * time.Second)
@@ -684,7 +852,7 @@ func (m *vxlanManager) getParentInterface(localVTEP *proto.VXLANTunnelEndpointUp
 func (m *vxlanManager) configureVXLANDevice(mtu int, localVTEP *proto.VXLANTunnelEndpointUpdate, xsumBroken bool) error {
 	logCtx := m.logCtx.WithFields(logrus.Fields{"device": m.vxlanDevice})
 	logCtx.Debug("Configuring VXLAN tunnel device")
-	parent, err := m.getParentInterface(localVTEP)
+	parent, err := m.cachedParent(localVTEP)
 	if err != nil {
 		return err
 	}
@@ -749,6 +917,7 @@ func (m *vxlanManager) configureVXLANDevice(mtu int, localVTEP *proto.VXLANTunne
 	}
 
 	// Make sure the MTU is set correctly.
+	m.mtuFitsParent(mtu)
 	attrs := link.Attrs()
 	oldMTU := attrs.MTU
 	if oldMTU != mtu {
@@ -36,6 +36,9 @@ import (
 type mockVXLANDataplane struct {
 	links     []netlink.Link
 	ipVersion uint8
+
+	numLinkListCalls int
+	lastMTU          int
 }
 
 func (m *mockVXLANDataplane) LinkByName(name string) (netlink.Link, error) {
@@ -64,6 +67,7 @@ func (m *mockVXLANDataplane) LinkByName(name string) (netlink.Link, error) {
 }
 
 func (m *mockVXLANDataplane) LinkSetMTU(link netlink.Link, mtu int) error {
+	m.lastMTU = mtu
 	return nil
 }
 
@@ -97,6 +101,7 @@ func (m *mockVXLANDataplane) AddrDel(link netlink.Link, addr *netlink.Addr) erro
 }
 
 func (m *mockVXLANDataplane) LinkList() ([]netlink.Link, error) {
+	m.numLinkListCalls++
 	return m.links, nil
 }
 
@@ -124,6 +129,7 @@ var _ = Describe("VXLANManager", func() {
 	var manager, managerV6 *vxlanManager
 	var rt, brt, prt *mockRouteTable
 	var fdb *mockVXLANFDB
+	var nlHandle *mockVXLANDataplane
 
 	BeforeEach(func() {
 		rt = &mockRouteTable{
@@ -140,6 +146,10 @@ var _ = Describe("VXLANManager", func() {
 
 		la := netlink.NewLinkAttrs()
 		la.Name = "eth0"
+		nlHandle = &mockVXLANDataplane{
+			links:     []netlink.Link{&mockLink{attrs: la}},
+			ipVersion: 4,
+		}
 		manager = newVXLANManagerWithShims(
 			common.NewMockIPSets(),
 			rt, brt,
@@ -154,10 +164,7 @@ var _ = Describe("VXLANManager", func() {
 					VXLANPort: 20,
 				},
 			},
-			&mockVXLANDataplane{
-				links:     []netlink.Link{&mockLink{attrs: la}},
-				ipVersion: 4,
-			},
+			nlHandle,
 			4,
 			func(
 				interfacePrefixes []string, ipVersion uint8, netlinkTimeout time.Duration,
@@ -283,6 +290,69 @@ var _ = Describe("VXLANManager", func() {
 		Expect(fdb.setVTEPsCalls).To(Equal(1))
 	})
 
+	It("explains the routes to same-subnet and cross-subnet destinations", func() {
+		manager.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
+			Node:           "node2",
+			Mac:            "00:0a:95:9d:68:16",
+			Ipv4Addr:       "10.0.80.0/32",
+			ParentDeviceIp: "172.0.12.1",
+		})
+		manager.OnParentNameUpdate("eth0")
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_REMOTE_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "172.0.0.0/26",
+			DstNodeName: "node2",
+			DstNodeIp:   "172.8.8.8",
+			SameSubnet:  true,
+		})
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_REMOTE_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "172.0.0.64/26",
+			DstNodeName: "node2",
+			DstNodeIp:   "172.8.8.8",
+		})
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_REMOTE_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "172.0.0.128/26",
+			DstNodeName: "node3",
+			DstNodeIp:   "172.9.9.9",
+		})
+
+		expl, err := manager.ExplainRoute("172.0.0.0/26")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expl).To(Equal(RouteExplanation{
+			Dst:             "172.0.0.0/26",
+			Decision:        RouteDecisionNoEncap,
+			SameSubnet:      true,
+			Gateway:         "172.8.8.8",
+			Device:          "eth0",
+			ParentInterface: "eth0",
+			Reason:          "destination node is on the same subnet",
+		}))
+
+		expl, err = manager.ExplainRoute("172.0.0.70")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expl).To(Equal(RouteExplanation{
+			Dst:             "172.0.0.64/26",
+			Decision:        RouteDecisionVXLAN,
+			Gateway:         "10.0.80.0/32",
+			Device:          "vxlan.calico",
+			ParentInterface: "eth0",
+			Reason:          "destination node is on a different subnet",
+		}))
+
+		expl, err = manager.ExplainRoute("172.0.0.128/26")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expl.Decision).To(Equal(RouteDecisionNotProgrammed))
+		Expect(expl.Reason).To(ContainSubstring("has no VTEP"))
+
+		_, err = manager.ExplainRoute("192.168.0.1")
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("successfully adds a IPv6 route to the parent interface", func() {
 		managerV6.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
 			Node:             "node1",
@@ -468,4 +538,57 @@ var _ = Describe("VXLANManager", func() {
 		Expect(managerV6.routesDirty).To(BeFalse())
 		Expect(prt.currentRoutes["eth0"]).To(HaveLen(1))
 	})
+
+	It("validates the device MTU against the cached parent MTU, refreshing it on resync", func() {
+		manager.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
+			Node:           "node1",
+			Mac:            "00:0a:74:9d:68:16",
+			Ipv4Addr:       "10.0.0.0",
+			ParentDeviceIp: "172.0.0.2",
+		})
+		localVTEP := manager.getLocalVTEP()
+		parent := nlHandle.links[0].(*mockLink)
+		parent.attrs.MTU = 1400
+
+		By("resolving the parent on resync")
+		_, err := manager.refreshParent(localVTEP)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manager.ParentMTU()).To(Equal(1400))
+
+		By("using the cached MTU without looking up the parent again")
+		parent.attrs.MTU = 9000
+		calls := nlHandle.numLinkListCalls
+		Expect(manager.mtuFitsParent(1450)).To(BeFalse())
+		Expect(manager.configureVXLANDevice(1450, localVTEP, false)).To(Succeed())
+		Expect(nlHandle.numLinkListCalls).To(Equal(calls))
+		// The device keeps the configured MTU, which the pod MTU is derived from.
+		Expect(nlHandle.lastMTU).To(Equal(1450))
+
+		By("picking up the parent's new MTU on the next resync")
+		_, err = manager.refreshParent(localVTEP)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manager.ParentMTU()).To(Equal(9000))
+		Expect(manager.mtuFitsParent(1450)).To(BeTrue())
+	})
+
+	It("assumes the device MTU fits if the parent MTU is unknown", func() {
+		Expect(manager.ParentMTU()).To(Equal(0))
+		Expect(manager.mtuFitsParent(8950)).To(BeTrue())
+	})
+
+	It("gives the device and pods the same MTU after clamping it to the host MTU", func() {
+		manager.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
+			Node:           "node1",
+			Mac:            "00:0a:74:9d:68:16",
+			Ipv4Addr:       "10.0.0.0",
+			ParentDeviceIp: "172.0.0.2",
+		})
+		config := Config{VXLANMTU: 8950}
+		config.RulesConfig.VXLANEnabled = true
+		ConfigureDefaultMTUs(1500, &config)
+		Expect(config.VXLANMTU).To(Equal(1500 - vxlanMTUOverhead))
+
+		Expect(manager.configureVXLANDevice(config.VXLANMTU, manager.getLocalVTEP(), false)).To(Succeed())
+		Expect(nlHandle.lastMTU).To(Equal(determinePodMTU(config)))
+	})
 })
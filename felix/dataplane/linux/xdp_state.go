@@ -1486,7 +1486,43 @@ func isValidRuleForXDP(rule *proto.Rule) bool {
 		// have no application layer policy stuff
 		rule.HttpMatch == nil &&
 		rule.SrcServiceAccountMatch == nil &&
-		rule.DstServiceAccountMatch == nil
+		rule.DstServiceAccountMatch == nil &&
+		// have none of the clauses that only the policy sync API (Dikastes) matches
+		len(rule.DstAnnotations) == 0 &&
+		len(rule.AppProtocols) == 0 &&
+		!rule.SrcIsLocalNode &&
+		len(rule.JwtAudiences) == 0 &&
+		len(rule.RouteNames) == 0 &&
+		len(rule.SrcIpPools) == 0 &&
+		len(rule.DstServicePorts) == 0 &&
+		len(rule.DirectRemoteNet) == 0 &&
+		len(rule.DstEncapsulations) == 0 &&
+		!rule.TlsTerminated &&
+		!rule.DstReady &&
+		len(rule.GrpcCallTypes) == 0 &&
+		rule.SrcIpSetAddedWithinSecs == 0 &&
+		rule.DstIpSetAddedWithinSecs == 0 &&
+		rule.SrcIpSetCardinalityAbove == 0 &&
+		rule.RequestLabelSelector == "" &&
+		len(rule.SrcNamespaceLabels) == 0 &&
+		!rule.DstInLocalIpamBlock &&
+		len(rule.TlsFingerprints) == 0 &&
+		len(rule.NotTlsFingerprints) == 0 &&
+		rule.GrpcMatch == nil &&
+		!rule.AllowHairpin &&
+		!rule.DstListening &&
+		len(rule.SrcPrincipalPrefixes) == 0 &&
+		len(rule.SrcPrincipalSuffixes) == 0 &&
+		len(rule.DstPrincipalPrefixes) == 0 &&
+		len(rule.DstPrincipalSuffixes) == 0 &&
+		len(rule.SrcZones) == 0 &&
+		len(rule.SrcRegions) == 0 &&
+		len(rule.DstReverseDnsNames) == 0 &&
+		len(rule.NotDstIpPortSetIds) == 0 &&
+		len(rule.SrcIpPortSetIds) == 0 &&
+		len(rule.NotSrcIpPortSetIds) == 0 &&
+		!rule.ConnectionReused &&
+		len(rule.SrcAsNumbers) == 0
 
 	// Note that XDP doesn't support writing rule.Metadata to the dataplane
 	// (as we do using -m comment in iptables), but the rule still can be
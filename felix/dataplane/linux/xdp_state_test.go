@@ -121,6 +121,41 @@ var knownProtoRuleFields = set.From(
 	"HttpMatch",
 	"Metadata",
 	"DstIpPortSetIds",
+	"DstAnnotations",
+	"AppProtocols",
+	"SrcIsLocalNode",
+	"JwtAudiences",
+	"RouteNames",
+	"SrcIpPools",
+	"DstServicePorts",
+	"DirectRemoteNet",
+	"DstEncapsulations",
+	"TlsTerminated",
+	"DstReady",
+	"GrpcCallTypes",
+	"SrcIpSetAddedWithinSecs",
+	"DstIpSetAddedWithinSecs",
+	"SrcIpSetCardinalityAbove",
+	"RequestLabelSelector",
+	"SrcNamespaceLabels",
+	"DstInLocalIpamBlock",
+	"TlsFingerprints",
+	"NotTlsFingerprints",
+	"GrpcMatch",
+	"AllowHairpin",
+	"DstListening",
+	"SrcPrincipalPrefixes",
+	"SrcPrincipalSuffixes",
+	"DstPrincipalPrefixes",
+	"DstPrincipalSuffixes",
+	"SrcZones",
+	"SrcRegions",
+	"DstReverseDnsNames",
+	"NotDstIpPortSetIds",
+	"SrcIpPortSetIds",
+	"NotSrcIpPortSetIds",
+	"ConnectionReused",
+	"SrcAsNumbers",
 )
 
 func testAllProtoRuleFieldsAreKnown() {
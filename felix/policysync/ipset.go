@@ -107,6 +107,9 @@ func AddIPSetsRule(r *proto.Rule, s map[string]bool) {
 	addAll(r.SrcIpSetIds, s)
 	addAll(r.DstIpSetIds, s)
 	addAll(r.DstIpPortSetIds, s)
+	addAll(r.NotDstIpPortSetIds, s)
+	addAll(r.SrcIpPortSetIds, s)
+	addAll(r.NotSrcIpPortSetIds, s)
 	addAll(r.SrcNamedPortIpSetIds, s)
 	addAll(r.DstNamedPortIpSetIds, s)
 	addAll(r.NotSrcIpSetIds, s)
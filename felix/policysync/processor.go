@@ -40,6 +40,17 @@ type Processor struct {
 	namespaceByID      map[proto.NamespaceID]*proto.NamespaceUpdate
 	ipSetsByID         map[string]*ipSetInfo
 	receivedInSync     bool
+	// hostname is the name of the local host, which is sent to clients in the InSync message.
+	hostname string
+
+	// Cluster-wide state that isn't specific to an endpoint.  It is only sent to endpoints that ask for it when they
+	// join, and only the parts that they use to evaluate rules: the local host's addresses, and the routes that
+	// target a node.  Changes are forwarded to those endpoints, and all of it is sent to them when they join.
+	localHostMetadata          *proto.HostMetadataUpdate
+	hostMetadataV4V6ByHostname map[string]*proto.HostMetadataV4V6Update
+	ipamPoolByID               map[string]*proto.IPAMPoolUpdate
+	serviceByID                map[string]*proto.ServiceUpdate
+	routeByDst                 map[string]*proto.RouteUpdate
 }
 
 type EndpointInfo struct {
@@ -50,6 +61,7 @@ type EndpointInfo struct {
 	syncedPolicies map[proto.PolicyID]bool
 	syncedProfiles map[proto.ProfileID]bool
 	syncedIPSets   map[string]bool
+	clusterState   bool
 }
 
 type JoinMetadata struct {
@@ -66,14 +78,17 @@ type JoinRequest struct {
 	// workload endpoint is removed, or when a new JoinRequest is received for the same endpoint.  If nil, indicates
 	// the client wants to stop receiving updates.
 	C chan<- proto.ToDataplane
+	// ClusterState is true if the client asked for the cluster-wide state: hosts, IPAM pools, services and routes.
+	ClusterState bool
 }
 
 type LeaveRequest struct {
 	JoinMetadata
 }
 
-func NewProcessor(updates <-chan interface{}) *Processor {
+func NewProcessor(updates <-chan interface{}, hostname string) *Processor {
 	return &Processor{
+		hostname: hostname,
 		// Updates from the calculation graph.
 		Updates: updates,
 		// JoinUpdates from the new servers that have started.
@@ -84,6 +99,11 @@ func NewProcessor(updates <-chan interface{}) *Processor {
 		serviceAccountByID: make(map[proto.ServiceAccountID]*proto.ServiceAccountUpdate),
 		namespaceByID:      make(map[proto.NamespaceID]*proto.NamespaceUpdate),
 		ipSetsByID:         make(map[string]*ipSetInfo),
+
+		hostMetadataV4V6ByHostname: make(map[string]*proto.HostMetadataV4V6Update),
+		ipamPoolByID:               make(map[string]*proto.IPAMPoolUpdate),
+		serviceByID:                make(map[string]*proto.ServiceUpdate),
+		routeByDst:                 make(map[string]*proto.RouteUpdate),
 	}
 }
 
@@ -133,6 +153,7 @@ func (p *Processor) handleJoin(joinReq JoinRequest) {
 	ei.syncedPolicies = map[proto.PolicyID]bool{}
 	ei.syncedProfiles = map[proto.ProfileID]bool{}
 	ei.syncedIPSets = map[string]bool{}
+	ei.clusterState = joinReq.ClusterState
 
 	p.maybeSyncEndpoint(ei)
 
@@ -140,11 +161,12 @@ func (p *Processor) handleJoin(joinReq JoinRequest) {
 	// accounts that were updated before it joined.
 	p.sendServiceAccounts(ei)
 	p.sendNamespaces(ei)
+	p.sendClusterState(ei)
 
 	if p.receivedInSync {
 		log.WithField("channel", ei.output).Debug("Already in sync with the datastore, sending in-sync message to client")
 		ei.output <- proto.ToDataplane{
-			Payload: &proto.ToDataplane_InSync{InSync: &proto.InSync{}}}
+			Payload: &proto.ToDataplane_InSync{InSync: &proto.InSync{Hostname: p.hostname}}}
 	}
 	logCxt.Debug("Done with join")
 }
@@ -207,6 +229,32 @@ func (p *Processor) handleDataplane(update interface{}) {
 		p.handleIPSetDeltaUpdate(update)
 	case *proto.IPSetRemove:
 		p.handleIPSetRemove(update)
+	case *proto.HostMetadataUpdate:
+		p.handleHostMetadataUpdate(update)
+	case *proto.HostMetadataRemove:
+		p.handleHostMetadataRemove(update)
+	case *proto.HostMetadataV4V6Update:
+		p.hostMetadataV4V6ByHostname[update.Hostname] = update
+		p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataV4V6Update{HostMetadataV4V6Update: update}})
+	case *proto.HostMetadataV4V6Remove:
+		delete(p.hostMetadataV4V6ByHostname, update.Hostname)
+		p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataV4V6Remove{HostMetadataV4V6Remove: update}})
+	case *proto.IPAMPoolUpdate:
+		p.ipamPoolByID[update.Id] = update
+		p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_IpamPoolUpdate{IpamPoolUpdate: update}})
+	case *proto.IPAMPoolRemove:
+		delete(p.ipamPoolByID, update.Id)
+		p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_IpamPoolRemove{IpamPoolRemove: update}})
+	case *proto.ServiceUpdate:
+		p.serviceByID[update.Namespace+"/"+update.Name] = update
+		p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_ServiceUpdate{ServiceUpdate: update}})
+	case *proto.ServiceRemove:
+		delete(p.serviceByID, update.Namespace+"/"+update.Name)
+		p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_ServiceRemove{ServiceRemove: update}})
+	case *proto.RouteUpdate:
+		p.handleRouteUpdate(update)
+	case *proto.RouteRemove:
+		p.handleRouteRemove(update)
 	default:
 		log.WithFields(log.Fields{
 			"type": reflect.TypeOf(update),
@@ -223,7 +271,7 @@ func (p *Processor) handleInSync(update *proto.InSync) {
 	p.receivedInSync = true
 	for _, ei := range p.updateableEndpoints() {
 		ei.output <- proto.ToDataplane{
-			Payload: &proto.ToDataplane_InSync{InSync: &proto.InSync{}}}
+			Payload: &proto.ToDataplane_InSync{InSync: &proto.InSync{Hostname: p.hostname}}}
 	}
 }
 
@@ -441,6 +489,44 @@ func (p *Processor) handleIPSetRemove(update *proto.IPSetRemove) {
 	// as soon as the endpoint no longer has a reference to the IPSet.
 }
 
+// handleHostMetadataUpdate forwards the local host's addresses, which clients use to recognise requests from the local
+// node.  Other hosts' addresses aren't sent.
+func (p *Processor) handleHostMetadataUpdate(update *proto.HostMetadataUpdate) {
+	if update.Hostname != p.hostname {
+		return
+	}
+	p.localHostMetadata = update
+	p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataUpdate{HostMetadataUpdate: update}})
+}
+
+func (p *Processor) handleHostMetadataRemove(update *proto.HostMetadataRemove) {
+	if update.Hostname != p.hostname {
+		return
+	}
+	p.localHostMetadata = nil
+	p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataRemove{HostMetadataRemove: update}})
+}
+
+// handleRouteUpdate forwards routes that target a node, which clients use to find the node of an address and the
+// encapsulation used to reach it.  Other routes, such as those for IP pools without blocks, aren't sent, and a route
+// that stops targeting a node is removed.
+func (p *Processor) handleRouteUpdate(update *proto.RouteUpdate) {
+	if update.DstNodeName == "" {
+		p.handleRouteRemove(&proto.RouteRemove{Dst: update.Dst})
+		return
+	}
+	p.routeByDst[update.Dst] = update
+	p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_RouteUpdate{RouteUpdate: update}})
+}
+
+func (p *Processor) handleRouteRemove(update *proto.RouteRemove) {
+	if _, ok := p.routeByDst[update.Dst]; !ok {
+		return
+	}
+	delete(p.routeByDst, update.Dst)
+	p.broadcastClusterState(proto.ToDataplane{Payload: &proto.ToDataplane_RouteRemove{RouteRemove: update}})
+}
+
 func (p *Processor) syncAddedPolicies(ei *EndpointInfo) {
 	ei.iteratePolicies(func(pId proto.PolicyID) bool {
 		if !ei.syncedPolicies[pId] {
@@ -548,6 +634,38 @@ func (p *Processor) sendNamespaces(ei *EndpointInfo) {
 	}
 }
 
+// sendClusterState sends all the known hosts, IPAM pools, services and routes to the endpoint, if it asked for them.
+func (p *Processor) sendClusterState(ei *EndpointInfo) {
+	if !ei.clusterState {
+		return
+	}
+	if p.localHostMetadata != nil {
+		ei.output <- proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataUpdate{HostMetadataUpdate: p.localHostMetadata}}
+	}
+	for _, update := range p.hostMetadataV4V6ByHostname {
+		ei.output <- proto.ToDataplane{Payload: &proto.ToDataplane_HostMetadataV4V6Update{HostMetadataV4V6Update: update}}
+	}
+	for _, update := range p.ipamPoolByID {
+		ei.output <- proto.ToDataplane{Payload: &proto.ToDataplane_IpamPoolUpdate{IpamPoolUpdate: update}}
+	}
+	for _, update := range p.serviceByID {
+		ei.output <- proto.ToDataplane{Payload: &proto.ToDataplane_ServiceUpdate{ServiceUpdate: update}}
+	}
+	for _, update := range p.routeByDst {
+		ei.output <- proto.ToDataplane{Payload: &proto.ToDataplane_RouteUpdate{RouteUpdate: update}}
+	}
+}
+
+// broadcastClusterState sends the update to every endpoint that can currently be sent updates and asked for the
+// cluster state.
+func (p *Processor) broadcastClusterState(update proto.ToDataplane) {
+	for _, ei := range p.updateableEndpoints() {
+		if ei.clusterState {
+			ei.output <- update
+		}
+	}
+}
+
 // A slice of all the Endpoints that can currently be sent updates.
 func (p *Processor) updateableEndpoints() []*EndpointInfo {
 	out := make([]*EndpointInfo, 0)
@@ -53,7 +53,7 @@ var _ = Describe("Processor", func() {
 
 	BeforeEach(func() {
 		updates = make(chan interface{})
-		uut = policysync.NewProcessor(updates)
+		uut = policysync.NewProcessor(updates, "test-host")
 
 		updateServiceAccount = func(name, namespace string) {
 			msg := &proto.ServiceAccountUpdate{
@@ -317,6 +317,126 @@ var _ = Describe("Processor", func() {
 			})
 		})
 
+		Describe("Cluster state update/remove", func() {
+			hostUpd := &proto.HostMetadataV4V6Update{Hostname: "node1", Ipv4Addr: "10.0.0.1/32", Asnumber: "64512"}
+			localHostUpd := &proto.HostMetadataUpdate{Hostname: "test-host", Ipv4Addr: "10.0.0.2"}
+			poolUpd := &proto.IPAMPoolUpdate{Id: "10.65.0.0-16", Pool: &proto.IPAMPool{Cidr: "10.65.0.0/16"}}
+			svcUpd := &proto.ServiceUpdate{Name: "svc", Namespace: "default", ClusterIp: "10.96.0.10"}
+			routeUpd := &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: "10.65.1.0/26", DstNodeName: "node1"}
+
+			joinWithClusterState := func(w string, jid uint64) chan proto.ToDataplane {
+				output := make(chan proto.ToDataplane, 100)
+				uut.JoinUpdates <- policysync.JoinRequest{
+					JoinMetadata: policysync.JoinMetadata{EndpointID: testId(w), JoinUID: jid},
+					C:            output,
+					ClusterState: true,
+				}
+				return output
+			}
+
+			Context("updates before any join", func() {
+
+				BeforeEach(func() {
+					updates <- hostUpd
+					updates <- localHostUpd
+					// Only the local host's addresses are sent.
+					updates <- &proto.HostMetadataUpdate{Hostname: "node1", Ipv4Addr: "10.0.0.1"}
+					updates <- poolUpd
+					updates <- svcUpd
+					updates <- routeUpd
+					updates <- &proto.RouteUpdate{Type: proto.RouteType_REMOTE_WORKLOAD, Dst: "10.65.2.0/26", DstNodeName: "node2"}
+					updates <- &proto.RouteRemove{Dst: "10.65.2.0/26"}
+					// Routes that don't target a node aren't sent.
+					updates <- &proto.RouteUpdate{Type: proto.RouteType_CIDR_INFO, Dst: "10.66.0.0/16"}
+				})
+
+				Context("on new join with cluster state", func() {
+					var output chan proto.ToDataplane
+
+					BeforeEach(func() {
+						output = joinWithClusterState("test", 1)
+					})
+
+					It("should get the current state", func() {
+						var received []interface{}
+						for i := 0; i < 5; i++ {
+							received = append(received, (<-output).Payload)
+						}
+						Expect(received).To(ConsistOf(
+							&proto.ToDataplane_HostMetadataV4V6Update{HostMetadataV4V6Update: hostUpd},
+							&proto.ToDataplane_HostMetadataUpdate{HostMetadataUpdate: localHostUpd},
+							&proto.ToDataplane_IpamPoolUpdate{IpamPoolUpdate: poolUpd},
+							&proto.ToDataplane_ServiceUpdate{ServiceUpdate: svcUpd},
+							&proto.ToDataplane_RouteUpdate{RouteUpdate: routeUpd},
+						))
+						Consistently(output).ShouldNot(Receive())
+					})
+
+					It("should pass removes", func() {
+						for i := 0; i < 5; i++ {
+							<-output
+						}
+						updates <- &proto.ServiceRemove{Name: "svc", Namespace: "default"}
+						Eventually(output).Should(Receive(Equal(proto.ToDataplane{
+							Payload: &proto.ToDataplane_ServiceRemove{
+								ServiceRemove: &proto.ServiceRemove{Name: "svc", Namespace: "default"},
+							},
+						})))
+					})
+				})
+
+				Context("on new join without cluster state", func() {
+					It("should get nothing", func() {
+						output, _ := join("test", 1)
+						Consistently(output).ShouldNot(Receive())
+					})
+				})
+			})
+
+			Context("with two joined endpoints, one with cluster state", func() {
+				var withState, withoutState chan proto.ToDataplane
+
+				BeforeEach(func() {
+					withState = joinWithClusterState("test0", 1)
+					withoutState, _ = join("test1", 2)
+					for i, output := range []chan proto.ToDataplane{withState, withoutState} {
+						// Ensure the joins are completed by sending a workload endpoint for each.
+						d := testId(fmt.Sprintf("test%d", i))
+						updates <- &proto.WorkloadEndpointUpdate{
+							Id:       &d,
+							Endpoint: &proto.WorkloadEndpoint{},
+						}
+						<-output
+					}
+				})
+
+				It("should forward updates and removes to the endpoint with cluster state", func() {
+					updates <- hostUpd
+					updates <- &proto.IPAMPoolRemove{Id: "10.65.0.0-16"}
+					Eventually(withState).Should(Receive(Equal(proto.ToDataplane{
+						Payload: &proto.ToDataplane_HostMetadataV4V6Update{HostMetadataV4V6Update: hostUpd},
+					})))
+					Eventually(withState).Should(Receive(Equal(proto.ToDataplane{
+						Payload: &proto.ToDataplane_IpamPoolRemove{
+							IpamPoolRemove: &proto.IPAMPoolRemove{Id: "10.65.0.0-16"},
+						},
+					})))
+					Consistently(withoutState).ShouldNot(Receive())
+				})
+
+				It("should remove a route that stops targeting a node", func() {
+					updates <- routeUpd
+					Eventually(withState).Should(Receive(Equal(proto.ToDataplane{
+						Payload: &proto.ToDataplane_RouteUpdate{RouteUpdate: routeUpd},
+					})))
+					updates <- &proto.RouteUpdate{Type: proto.RouteType_CIDR_INFO, Dst: routeUpd.Dst}
+					Eventually(withState).Should(Receive(Equal(proto.ToDataplane{
+						Payload: &proto.ToDataplane_RouteRemove{RouteRemove: &proto.RouteRemove{Dst: routeUpd.Dst}},
+					})))
+				})
+			})
+		})
+
 		Describe("IP Set updates", func() {
 
 			Context("with two joined endpoints, one with active profile", func() {
@@ -1330,16 +1450,15 @@ var _ = Describe("Processor", func() {
 		})
 
 		Describe("InSync processing", func() {
-			It("should send InSync on all open outputs", func(done Done) {
+			It("should send InSync, with the local hostname, on all open outputs", func(done Done) {
 				var c [2]chan proto.ToDataplane
 				for i := 0; i < 2; i++ {
 					c[i], _ = join(fmt.Sprintf("test%d", i), uint64(i))
 				}
-				is := &proto.InSync{}
-				updates <- is
+				updates <- &proto.InSync{}
 				for i := 0; i < 2; i++ {
 					g := <-c[i]
-					Expect(&g).To(HavePayload(is))
+					Expect(&g).To(HavePayload(&proto.InSync{Hostname: "test-host"}))
 				}
 				close(done)
 			})
@@ -51,12 +51,13 @@ var _ = Describe("Server", func() {
 				output = make(chan *proto.ToDataplane)
 				stream = &testSyncStream{output: output}
 				go func() {
-					_ = uut.Sync(&proto.SyncRequest{}, stream)
+					_ = uut.Sync(&proto.SyncRequest{ClusterState: true}, stream)
 					syncDone <- true
 				}()
 				j := <-joins
 				jr := j.(policysync.JoinRequest)
 				Expect(jr.EndpointID.GetWorkloadId()).To(Equal(WorkloadID))
+				Expect(jr.ClusterState).To(BeTrue())
 				updates = jr.C
 				close(done)
 			})
@@ -30,6 +30,7 @@ It has these top-level messages:
 	Rule
 	ServiceAccountMatch
 	HTTPMatch
+	GrpcMatch
 	RuleMetadata
 	IcmpTypeAndCode
 	Protocol
@@ -37,6 +38,7 @@ It has these top-level messages:
 	WorkloadEndpointID
 	WorkloadEndpointUpdate
 	WorkloadEndpoint
+	EndpointPort
 	WorkloadEndpointRemove
 	HostEndpointID
 	HostEndpointUpdate
@@ -227,6 +229,9 @@ func (IPSetUpdate_IPSetType) EnumDescriptor() ([]byte, []int) {
 }
 
 type SyncRequest struct {
+	// Set by clients that evaluate rules on cluster-wide state, such as node labels, services and routes.  Felix only
+	// sends HostMetadata, IPAMPool, Service and Route updates to clients that set it.
+	ClusterState bool `protobuf:"varint,1,opt,name=cluster_state,json=clusterState,proto3" json:"cluster_state,omitempty"`
 }
 
 func (m *SyncRequest) Reset()                    { *m = SyncRequest{} }
@@ -234,6 +239,13 @@ func (m *SyncRequest) String() string            { return proto1.CompactTextStri
 func (*SyncRequest) ProtoMessage()               {}
 func (*SyncRequest) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{0} }
 
+func (m *SyncRequest) GetClusterState() bool {
+	if m != nil {
+		return m.ClusterState
+	}
+	return false
+}
+
 type ToDataplane struct {
 	// Sequence number incremented with each message.  Useful for correlating
 	// messages in logs.
@@ -1795,6 +1807,8 @@ func (m *RawConfig) GetConfig() map[string]string {
 }
 
 type InSync struct {
+	// On the policy sync API, the name of the host that Felix is running on.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
 }
 
 func (m *InSync) Reset()                    { *m = InSync{} }
@@ -1802,6 +1816,13 @@ func (m *InSync) String() string            { return proto1.CompactTextString(m)
 func (*InSync) ProtoMessage()               {}
 func (*InSync) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{5} }
 
+func (m *InSync) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
 type IPSetUpdate struct {
 	Id      string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Members []string              `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
@@ -2140,6 +2161,87 @@ type Rule struct {
 	// Pass through of the v3 datamodel HTTP match criteria.
 	HttpMatch *HTTPMatch    `protobuf:"bytes,122,opt,name=http_match,json=httpMatch" json:"http_match,omitempty"`
 	Metadata  *RuleMetadata `protobuf:"bytes,123,opt,name=metadata" json:"metadata,omitempty"`
+	// Annotations that the destination workload endpoint must carry, as key/value pairs.
+	DstAnnotations map[string]string `protobuf:"bytes,135,rep,name=dst_annotations,json=dstAnnotations" json:"dst_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Application protocols (e.g. "mysql"), as detected by Envoy and passed in the request metadata.
+	AppProtocols []string `protobuf:"bytes,136,rep,name=app_protocols,json=appProtocols" json:"app_protocols,omitempty"`
+	// Match if the source IP is one of the local node's addresses.
+	SrcIsLocalNode bool `protobuf:"varint,137,opt,name=src_is_local_node,json=srcIsLocalNode,proto3" json:"src_is_local_node,omitempty"`
+	// Audiences, at least one of which must be in the "aud" claim of the request's JWT.
+	JwtAudiences []string `protobuf:"bytes,138,rep,name=jwt_audiences,json=jwtAudiences" json:"jwt_audiences,omitempty"`
+	// Names of the Envoy routes that the request must have matched.
+	RouteNames []string `protobuf:"bytes,139,rep,name=route_names,json=routeNames" json:"route_names,omitempty"`
+	// Names of IP pools, one of which must contain the source IP.
+	SrcIpPools []string `protobuf:"bytes,140,rep,name=src_ip_pools,json=srcIpPools" json:"src_ip_pools,omitempty"`
+	// Names of Kubernetes service ports (e.g. "https"), one of which the destination IP and port must resolve to.
+	DstServicePorts []string `protobuf:"bytes,141,rep,name=dst_service_ports,json=dstServicePorts" json:"dst_service_ports,omitempty"`
+	// CIDRs, one of which must contain the TCP-level remote address of the connection.  Unlike src_net, this is always
+	// the immediate peer, such as a proxy, rather than the logical source of the request.
+	DirectRemoteNet []string `protobuf:"bytes,143,rep,name=direct_remote_net,json=directRemoteNet" json:"direct_remote_net,omitempty"`
+	// Encapsulations ("IPIP", "VXLAN" or "None"), one of which must be used by the route to the destination.
+	DstEncapsulations []string `protobuf:"bytes,144,rep,name=dst_encapsulations,json=dstEncapsulations" json:"dst_encapsulations,omitempty"`
+	// If true, the connection's TLS must have been terminated by Envoy, making the request visible to L7 inspection,
+	// rather than passed through to the destination.
+	TlsTerminated bool `protobuf:"varint,145,opt,name=tls_terminated,json=tlsTerminated,proto3" json:"tls_terminated,omitempty"`
+	// If true, the destination must be a workload endpoint whose state is "active".
+	DstReady bool `protobuf:"varint,146,opt,name=dst_ready,json=dstReady,proto3" json:"dst_ready,omitempty"`
+	// Kinds of gRPC call ("Unary" or "Streaming"), one of which the request must be.
+	GrpcCallTypes []string `protobuf:"bytes,147,rep,name=grpc_call_types,json=grpcCallTypes" json:"grpc_call_types,omitempty"`
+	// If non-zero, the source (destination) address must have been added to one of the rule's source (destination) IP
+	// sets within this many seconds.
+	SrcIpSetAddedWithinSecs uint32 `protobuf:"varint,148,opt,name=src_ip_set_added_within_secs,json=srcIpSetAddedWithinSecs,proto3" json:"src_ip_set_added_within_secs,omitempty"`
+	DstIpSetAddedWithinSecs uint32 `protobuf:"varint,149,opt,name=dst_ip_set_added_within_secs,json=dstIpSetAddedWithinSecs,proto3" json:"dst_ip_set_added_within_secs,omitempty"`
+	// If non-zero, the source address must be in one of the rule's source IP sets that has more than this many members.
+	// Intended for sets of sources that are aggregated elsewhere, for example the distinct sources seen scanning a port.
+	SrcIpSetCardinalityAbove uint32 `protobuf:"varint,150,opt,name=src_ip_set_cardinality_above,json=srcIpSetCardinalityAbove,proto3" json:"src_ip_set_cardinality_above,omitempty"`
+	// Selector over the request-scoped labels that Envoy passes in the request's metadata, for example a feature-flag
+	// cohort.
+	RequestLabelSelector string `protobuf:"bytes,151,opt,name=request_label_selector,json=requestLabelSelector,proto3" json:"request_label_selector,omitempty"`
+	// Labels that the source's namespace must carry, as key/value pairs.  A simpler form of the namespace selector for
+	// common requirements such as "istio-injection=enabled".
+	SrcNamespaceLabels map[string]string `protobuf:"bytes,152,rep,name=src_namespace_labels,json=srcNamespaceLabels" json:"src_namespace_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If true, the destination address must be within one of the IPAM blocks that are affine to this host.  Allows
+	// node-local policy, for example to traffic between pods on the same host.
+	DstInLocalIpamBlock bool `protobuf:"varint,153,opt,name=dst_in_local_ipam_block,json=dstInLocalIpamBlock,proto3" json:"dst_in_local_ipam_block,omitempty"`
+	// JA3 or JA4 fingerprints of the client's TLS hello, one of which the request's fingerprint must match.  Envoy must
+	// pass the fingerprints in the request's metadata.
+	TlsFingerprints []string `protobuf:"bytes,154,rep,name=tls_fingerprints,json=tlsFingerprints" json:"tls_fingerprints,omitempty"`
+	// JA3 or JA4 fingerprints of the client's TLS hello, none of which the request's fingerprint may match.
+	NotTlsFingerprints []string `protobuf:"bytes,155,rep,name=not_tls_fingerprints,json=notTlsFingerprints" json:"not_tls_fingerprints,omitempty"`
+	// Restricts the rule to gRPC calls to the given services and methods.  Requests that aren't gRPC calls aren't
+	// restricted.
+	GrpcMatch *GrpcMatch `protobuf:"bytes,156,opt,name=grpc_match,json=grpcMatch" json:"grpc_match,omitempty"`
+	// If true, an Allow rule matches hairpin requests, whose source and destination are the same IP, even if the policy
+	// store is configured to deny hairpin traffic.
+	AllowHairpin bool `protobuf:"varint,157,opt,name=allow_hairpin,json=allowHairpin,proto3" json:"allow_hairpin,omitempty"`
+	// If true, the destination IP and port must be one of the ports that the destination workload endpoint declares.
+	DstListening bool `protobuf:"varint,159,opt,name=dst_listening,json=dstListening,proto3" json:"dst_listening,omitempty"`
+	// Literal prefixes and suffixes, one of each of which the peer's principal (e.g. its SPIFFE ID) must have.
+	SrcPrincipalPrefixes []string `protobuf:"bytes,160,rep,name=src_principal_prefixes,json=srcPrincipalPrefixes" json:"src_principal_prefixes,omitempty"`
+	SrcPrincipalSuffixes []string `protobuf:"bytes,161,rep,name=src_principal_suffixes,json=srcPrincipalSuffixes" json:"src_principal_suffixes,omitempty"`
+	DstPrincipalPrefixes []string `protobuf:"bytes,162,rep,name=dst_principal_prefixes,json=dstPrincipalPrefixes" json:"dst_principal_prefixes,omitempty"`
+	DstPrincipalSuffixes []string `protobuf:"bytes,163,rep,name=dst_principal_suffixes,json=dstPrincipalSuffixes" json:"dst_principal_suffixes,omitempty"`
+	// Match the zone and region of the node that the source belongs to, from the node's
+	// topology.kubernetes.io/zone and topology.kubernetes.io/region labels.
+	SrcZones   []string `protobuf:"bytes,164,rep,name=src_zones,json=srcZones" json:"src_zones,omitempty"`
+	SrcRegions []string `protobuf:"bytes,165,rep,name=src_regions,json=srcRegions" json:"src_regions,omitempty"`
+	// Names, one of which must be a reverse-DNS (PTR) name of the destination IP.  A "*" label matches exactly one
+	// label, except that a leading "*" label matches one or more, so "*.example.com" matches any name under
+	// example.com.  Destinations without a PTR record don't match.
+	DstReverseDnsNames []string `protobuf:"bytes,166,rep,name=dst_reverse_dns_names,json=dstReverseDnsNames" json:"dst_reverse_dns_names,omitempty"`
+	// The destination IP, protocol and port must not be in any of these IP_AND_PORT sets.
+	NotDstIpPortSetIds []string `protobuf:"bytes,169,rep,name=not_dst_ip_port_set_ids,json=notDstIpPortSetIds" json:"not_dst_ip_port_set_ids,omitempty"`
+	// The source IP, protocol and port must be in all of the src_ip_port_set_ids and none of the
+	// not_src_ip_port_set_ids IP_AND_PORT sets, for example to match the NAT'd source of a client.
+	SrcIpPortSetIds    []string `protobuf:"bytes,170,rep,name=src_ip_port_set_ids,json=srcIpPortSetIds" json:"src_ip_port_set_ids,omitempty"`
+	NotSrcIpPortSetIds []string `protobuf:"bytes,171,rep,name=not_src_ip_port_set_ids,json=notSrcIpPortSetIds" json:"not_src_ip_port_set_ids,omitempty"`
+	// If true, the request must be on a connection for which Envoy reused an earlier authorization decision, for
+	// example a later request on a keep-alive connection.  An Allow rule with this set, ahead of rules with L7 clauses,
+	// lets reused connections skip the L7 checks.
+	ConnectionReused bool `protobuf:"varint,172,opt,name=connection_reused,json=connectionReused,proto3" json:"connection_reused,omitempty"`
+	// Match sources whose route, learned via BGP, points to a node with one of these AS numbers.  A source with no route
+	// to a node, or whose node has no AS number of its own, doesn't match.
+	SrcAsNumbers []uint32 `protobuf:"varint,173,rep,packed,name=src_as_numbers,json=srcAsNumbers" json:"src_as_numbers,omitempty"`
 	// An opaque ID/hash for the rule.
 	RuleId string `protobuf:"bytes,201,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 }
@@ -2464,6 +2566,251 @@ func (m *Rule) GetMetadata() *RuleMetadata {
 	return nil
 }
 
+func (m *Rule) GetDstAnnotations() map[string]string {
+	if m != nil {
+		return m.DstAnnotations
+	}
+	return nil
+}
+
+func (m *Rule) GetAppProtocols() []string {
+	if m != nil {
+		return m.AppProtocols
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcIsLocalNode() bool {
+	if m != nil {
+		return m.SrcIsLocalNode
+	}
+	return false
+}
+
+func (m *Rule) GetJwtAudiences() []string {
+	if m != nil {
+		return m.JwtAudiences
+	}
+	return nil
+}
+
+func (m *Rule) GetRouteNames() []string {
+	if m != nil {
+		return m.RouteNames
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcIpPools() []string {
+	if m != nil {
+		return m.SrcIpPools
+	}
+	return nil
+}
+
+func (m *Rule) GetDstServicePorts() []string {
+	if m != nil {
+		return m.DstServicePorts
+	}
+	return nil
+}
+
+func (m *Rule) GetDirectRemoteNet() []string {
+	if m != nil {
+		return m.DirectRemoteNet
+	}
+	return nil
+}
+
+func (m *Rule) GetDstEncapsulations() []string {
+	if m != nil {
+		return m.DstEncapsulations
+	}
+	return nil
+}
+
+func (m *Rule) GetTlsTerminated() bool {
+	if m != nil {
+		return m.TlsTerminated
+	}
+	return false
+}
+
+func (m *Rule) GetDstReady() bool {
+	if m != nil {
+		return m.DstReady
+	}
+	return false
+}
+
+func (m *Rule) GetGrpcCallTypes() []string {
+	if m != nil {
+		return m.GrpcCallTypes
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcIpSetAddedWithinSecs() uint32 {
+	if m != nil {
+		return m.SrcIpSetAddedWithinSecs
+	}
+	return 0
+}
+
+func (m *Rule) GetDstIpSetAddedWithinSecs() uint32 {
+	if m != nil {
+		return m.DstIpSetAddedWithinSecs
+	}
+	return 0
+}
+
+func (m *Rule) GetSrcIpSetCardinalityAbove() uint32 {
+	if m != nil {
+		return m.SrcIpSetCardinalityAbove
+	}
+	return 0
+}
+
+func (m *Rule) GetRequestLabelSelector() string {
+	if m != nil {
+		return m.RequestLabelSelector
+	}
+	return ""
+}
+
+func (m *Rule) GetSrcNamespaceLabels() map[string]string {
+	if m != nil {
+		return m.SrcNamespaceLabels
+	}
+	return nil
+}
+
+func (m *Rule) GetDstInLocalIpamBlock() bool {
+	if m != nil {
+		return m.DstInLocalIpamBlock
+	}
+	return false
+}
+
+func (m *Rule) GetTlsFingerprints() []string {
+	if m != nil {
+		return m.TlsFingerprints
+	}
+	return nil
+}
+
+func (m *Rule) GetNotTlsFingerprints() []string {
+	if m != nil {
+		return m.NotTlsFingerprints
+	}
+	return nil
+}
+
+func (m *Rule) GetGrpcMatch() *GrpcMatch {
+	if m != nil {
+		return m.GrpcMatch
+	}
+	return nil
+}
+
+func (m *Rule) GetAllowHairpin() bool {
+	if m != nil {
+		return m.AllowHairpin
+	}
+	return false
+}
+
+func (m *Rule) GetDstListening() bool {
+	if m != nil {
+		return m.DstListening
+	}
+	return false
+}
+
+func (m *Rule) GetSrcPrincipalPrefixes() []string {
+	if m != nil {
+		return m.SrcPrincipalPrefixes
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcPrincipalSuffixes() []string {
+	if m != nil {
+		return m.SrcPrincipalSuffixes
+	}
+	return nil
+}
+
+func (m *Rule) GetDstPrincipalPrefixes() []string {
+	if m != nil {
+		return m.DstPrincipalPrefixes
+	}
+	return nil
+}
+
+func (m *Rule) GetDstPrincipalSuffixes() []string {
+	if m != nil {
+		return m.DstPrincipalSuffixes
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcZones() []string {
+	if m != nil {
+		return m.SrcZones
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcRegions() []string {
+	if m != nil {
+		return m.SrcRegions
+	}
+	return nil
+}
+
+func (m *Rule) GetDstReverseDnsNames() []string {
+	if m != nil {
+		return m.DstReverseDnsNames
+	}
+	return nil
+}
+
+func (m *Rule) GetNotDstIpPortSetIds() []string {
+	if m != nil {
+		return m.NotDstIpPortSetIds
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcIpPortSetIds() []string {
+	if m != nil {
+		return m.SrcIpPortSetIds
+	}
+	return nil
+}
+
+func (m *Rule) GetNotSrcIpPortSetIds() []string {
+	if m != nil {
+		return m.NotSrcIpPortSetIds
+	}
+	return nil
+}
+
+func (m *Rule) GetConnectionReused() bool {
+	if m != nil {
+		return m.ConnectionReused
+	}
+	return false
+}
+
+func (m *Rule) GetSrcAsNumbers() []uint32 {
+	if m != nil {
+		return m.SrcAsNumbers
+	}
+	return nil
+}
+
 func (m *Rule) GetRuleId() string {
 	if m != nil {
 		return m.RuleId
@@ -2588,6 +2935,12 @@ func _Rule_OneofSizer(msg proto1.Message) (n int) {
 type ServiceAccountMatch struct {
 	Selector string   `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
 	Names    []string `protobuf:"bytes,2,rep,name=names" json:"names,omitempty"`
+	// SPIFFE trust domains, e.g. "prod.example.com", one of which must have issued the peer's identity.  Empty matches
+	// any trust domain.
+	TrustDomains []string `protobuf:"bytes,3,rep,name=trust_domains,json=trustDomains" json:"trust_domains,omitempty"`
+	// If set, names may contain the glob wildcards "*", which matches any run of characters, and "?", which matches any
+	// single character, e.g. "payments-*".
+	GlobNames bool `protobuf:"varint,4,opt,name=glob_names,json=globNames,proto3" json:"glob_names,omitempty"`
 }
 
 func (m *ServiceAccountMatch) Reset()         { *m = ServiceAccountMatch{} }
@@ -2611,9 +2964,48 @@ func (m *ServiceAccountMatch) GetNames() []string {
 	return nil
 }
 
+func (m *ServiceAccountMatch) GetTrustDomains() []string {
+	if m != nil {
+		return m.TrustDomains
+	}
+	return nil
+}
+
+func (m *ServiceAccountMatch) GetGlobNames() bool {
+	if m != nil {
+		return m.GlobNames
+	}
+	return false
+}
+
 type HTTPMatch struct {
 	Methods []string               `protobuf:"bytes,1,rep,name=methods" json:"methods,omitempty"`
 	Paths   []*HTTPMatch_PathMatch `protobuf:"bytes,2,rep,name=paths" json:"paths,omitempty"`
+	// Media types (e.g. "application/json") that the request's Content-Type header must match.
+	ContentTypes []string `protobuf:"bytes,3,rep,name=content_types,json=contentTypes" json:"content_types,omitempty"`
+	// If set, exact path matches treat a path with a trailing slash as equivalent to the same path without one.
+	IgnoreTrailingSlash bool `protobuf:"varint,4,opt,name=ignore_trailing_slash,json=ignoreTrailingSlash,proto3" json:"ignore_trailing_slash,omitempty"`
+	// Query parameters that must all match the request's query string.
+	QueryParams []*HTTPMatch_QueryParamMatch `protobuf:"bytes,5,rep,name=query_params,json=queryParams" json:"query_params,omitempty"`
+	// If set, methods are compared case-insensitively, for proxies that don't preserve the case of the method.  HTTP
+	// methods are case-sensitive, so this is off by default.
+	CaseInsensitive bool `protobuf:"varint,6,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// Headers that must all match the request's headers.
+	Headers []*HTTPMatch_HeaderMatch `protobuf:"bytes,7,rep,name=headers" json:"headers,omitempty"`
+	// Protocols (e.g. "websocket"), compared case-insensitively, one of which the request must be upgrading to, either
+	// with an HTTP/1.1 Upgrade header or an HTTP/2 extended CONNECT.  Requests that aren't upgrades don't match.
+	Upgrades []string `protobuf:"bytes,8,rep,name=upgrades" json:"upgrades,omitempty"`
+	// Methods and paths that the request must not match.  They are compared in the same way as methods and paths.
+	NotMethods []string               `protobuf:"bytes,9,rep,name=not_methods,json=notMethods" json:"not_methods,omitempty"`
+	NotPaths   []*HTTPMatch_PathMatch `protobuf:"bytes,10,rep,name=not_paths,json=notPaths" json:"not_paths,omitempty"`
+	// If non-zero, the maximum size of the request body in bytes.  Requests whose size Envoy doesn't know match.
+	MaxBodyBytes uint64 `protobuf:"varint,11,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	// Response codes, one of which the response must have, and bounds on the duration of the request in milliseconds.
+	// These can only be evaluated for checks made after the request has completed, which Dikastes must be configured to
+	// expect; for other checks they are unknown.
+	ResponseCodes []uint32 `protobuf:"varint,12,rep,packed,name=response_codes,json=responseCodes" json:"response_codes,omitempty"`
+	MinDurationMs uint64   `protobuf:"varint,13,opt,name=min_duration_ms,json=minDurationMs,proto3" json:"min_duration_ms,omitempty"`
+	MaxDurationMs uint64   `protobuf:"varint,14,opt,name=max_duration_ms,json=maxDurationMs,proto3" json:"max_duration_ms,omitempty"`
 }
 
 func (m *HTTPMatch) Reset()                    { *m = HTTPMatch{} }
@@ -2635,66 +3027,163 @@ func (m *HTTPMatch) GetPaths() []*HTTPMatch_PathMatch {
 	return nil
 }
 
-type HTTPMatch_PathMatch struct {
-	// Types that are valid to be assigned to PathMatch:
-	//	*HTTPMatch_PathMatch_Exact
-	//	*HTTPMatch_PathMatch_Prefix
-	PathMatch isHTTPMatch_PathMatch_PathMatch `protobuf_oneof:"path_match"`
+func (m *HTTPMatch) GetContentTypes() []string {
+	if m != nil {
+		return m.ContentTypes
+	}
+	return nil
 }
 
-func (m *HTTPMatch_PathMatch) Reset()         { *m = HTTPMatch_PathMatch{} }
-func (m *HTTPMatch_PathMatch) String() string { return proto1.CompactTextString(m) }
-func (*HTTPMatch_PathMatch) ProtoMessage()    {}
-func (*HTTPMatch_PathMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{19, 0}
+func (m *HTTPMatch) GetIgnoreTrailingSlash() bool {
+	if m != nil {
+		return m.IgnoreTrailingSlash
+	}
+	return false
 }
 
-type isHTTPMatch_PathMatch_PathMatch interface {
-	isHTTPMatch_PathMatch_PathMatch()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *HTTPMatch) GetQueryParams() []*HTTPMatch_QueryParamMatch {
+	if m != nil {
+		return m.QueryParams
+	}
+	return nil
 }
 
-type HTTPMatch_PathMatch_Exact struct {
-	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
-}
-type HTTPMatch_PathMatch_Prefix struct {
-	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+func (m *HTTPMatch) GetCaseInsensitive() bool {
+	if m != nil {
+		return m.CaseInsensitive
+	}
+	return false
 }
 
-func (*HTTPMatch_PathMatch_Exact) isHTTPMatch_PathMatch_PathMatch()  {}
-func (*HTTPMatch_PathMatch_Prefix) isHTTPMatch_PathMatch_PathMatch() {}
+func (m *HTTPMatch) GetHeaders() []*HTTPMatch_HeaderMatch {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
 
-func (m *HTTPMatch_PathMatch) GetPathMatch() isHTTPMatch_PathMatch_PathMatch {
+func (m *HTTPMatch) GetUpgrades() []string {
 	if m != nil {
-		return m.PathMatch
+		return m.Upgrades
 	}
 	return nil
 }
 
-func (m *HTTPMatch_PathMatch) GetExact() string {
-	if x, ok := m.GetPathMatch().(*HTTPMatch_PathMatch_Exact); ok {
-		return x.Exact
+func (m *HTTPMatch) GetNotMethods() []string {
+	if m != nil {
+		return m.NotMethods
 	}
-	return ""
+	return nil
 }
 
-func (m *HTTPMatch_PathMatch) GetPrefix() string {
-	if x, ok := m.GetPathMatch().(*HTTPMatch_PathMatch_Prefix); ok {
-		return x.Prefix
+func (m *HTTPMatch) GetNotPaths() []*HTTPMatch_PathMatch {
+	if m != nil {
+		return m.NotPaths
 	}
-	return ""
+	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*HTTPMatch_PathMatch) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
-	return _HTTPMatch_PathMatch_OneofMarshaler, _HTTPMatch_PathMatch_OneofUnmarshaler, _HTTPMatch_PathMatch_OneofSizer, []interface{}{
-		(*HTTPMatch_PathMatch_Exact)(nil),
-		(*HTTPMatch_PathMatch_Prefix)(nil),
+func (m *HTTPMatch) GetMaxBodyBytes() uint64 {
+	if m != nil {
+		return m.MaxBodyBytes
 	}
+	return 0
 }
 
-func _HTTPMatch_PathMatch_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
+func (m *HTTPMatch) GetResponseCodes() []uint32 {
+	if m != nil {
+		return m.ResponseCodes
+	}
+	return nil
+}
+
+func (m *HTTPMatch) GetMinDurationMs() uint64 {
+	if m != nil {
+		return m.MinDurationMs
+	}
+	return 0
+}
+
+func (m *HTTPMatch) GetMaxDurationMs() uint64 {
+	if m != nil {
+		return m.MaxDurationMs
+	}
+	return 0
+}
+
+type HTTPMatch_PathMatch struct {
+	// Types that are valid to be assigned to PathMatch:
+	//	*HTTPMatch_PathMatch_Exact
+	//	*HTTPMatch_PathMatch_Prefix
+	//	*HTTPMatch_PathMatch_Regex
+	PathMatch isHTTPMatch_PathMatch_PathMatch `protobuf_oneof:"path_match"`
+}
+
+func (m *HTTPMatch_PathMatch) Reset()         { *m = HTTPMatch_PathMatch{} }
+func (m *HTTPMatch_PathMatch) String() string { return proto1.CompactTextString(m) }
+func (*HTTPMatch_PathMatch) ProtoMessage()    {}
+func (*HTTPMatch_PathMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{19, 0}
+}
+
+type isHTTPMatch_PathMatch_PathMatch interface {
+	isHTTPMatch_PathMatch_PathMatch()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type HTTPMatch_PathMatch_Exact struct {
+	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+type HTTPMatch_PathMatch_Prefix struct {
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+type HTTPMatch_PathMatch_Regex struct {
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof"`
+}
+
+func (*HTTPMatch_PathMatch_Exact) isHTTPMatch_PathMatch_PathMatch()  {}
+func (*HTTPMatch_PathMatch_Prefix) isHTTPMatch_PathMatch_PathMatch() {}
+func (*HTTPMatch_PathMatch_Regex) isHTTPMatch_PathMatch_PathMatch()  {}
+
+func (m *HTTPMatch_PathMatch) GetPathMatch() isHTTPMatch_PathMatch_PathMatch {
+	if m != nil {
+		return m.PathMatch
+	}
+	return nil
+}
+
+func (m *HTTPMatch_PathMatch) GetExact() string {
+	if x, ok := m.GetPathMatch().(*HTTPMatch_PathMatch_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (m *HTTPMatch_PathMatch) GetPrefix() string {
+	if x, ok := m.GetPathMatch().(*HTTPMatch_PathMatch_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (m *HTTPMatch_PathMatch) GetRegex() string {
+	if x, ok := m.GetPathMatch().(*HTTPMatch_PathMatch_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HTTPMatch_PathMatch) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
+	return _HTTPMatch_PathMatch_OneofMarshaler, _HTTPMatch_PathMatch_OneofUnmarshaler, _HTTPMatch_PathMatch_OneofSizer, []interface{}{
+		(*HTTPMatch_PathMatch_Exact)(nil),
+		(*HTTPMatch_PathMatch_Prefix)(nil),
+		(*HTTPMatch_PathMatch_Regex)(nil),
+	}
+}
+
+func _HTTPMatch_PathMatch_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 	m := msg.(*HTTPMatch_PathMatch)
 	// path_match
 	switch x := m.PathMatch.(type) {
@@ -2704,6 +3193,9 @@ func _HTTPMatch_PathMatch_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) e
 	case *HTTPMatch_PathMatch_Prefix:
 		_ = b.EncodeVarint(2<<3 | proto1.WireBytes)
 		_ = b.EncodeStringBytes(x.Prefix)
+	case *HTTPMatch_PathMatch_Regex:
+		_ = b.EncodeVarint(3<<3 | proto1.WireBytes)
+		_ = b.EncodeStringBytes(x.Regex)
 	case nil:
 	default:
 		return fmt.Errorf("HTTPMatch_PathMatch.PathMatch has unexpected type %T", x)
@@ -2728,6 +3220,13 @@ func _HTTPMatch_PathMatch_OneofUnmarshaler(msg proto1.Message, tag, wire int, b
 		x, err := b.DecodeStringBytes()
 		m.PathMatch = &HTTPMatch_PathMatch_Prefix{x}
 		return true, err
+	case 3: // path_match.regex
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.PathMatch = &HTTPMatch_PathMatch_Regex{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2745,6 +3244,10 @@ func _HTTPMatch_PathMatch_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(2<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(len(x.Prefix)))
 		n += len(x.Prefix)
+	case *HTTPMatch_PathMatch_Regex:
+		n += proto1.SizeVarint(3<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.Regex)))
+		n += len(x.Regex)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2752,153 +3255,129 @@ func _HTTPMatch_PathMatch_OneofSizer(msg proto1.Message) (n int) {
 	return n
 }
 
-type RuleMetadata struct {
-	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (m *RuleMetadata) Reset()                    { *m = RuleMetadata{} }
-func (m *RuleMetadata) String() string            { return proto1.CompactTextString(m) }
-func (*RuleMetadata) ProtoMessage()               {}
-func (*RuleMetadata) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{20} }
-
-func (m *RuleMetadata) GetAnnotations() map[string]string {
-	if m != nil {
-		return m.Annotations
-	}
-	return nil
-}
-
-type IcmpTypeAndCode struct {
-	Type int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-}
-
-func (m *IcmpTypeAndCode) Reset()                    { *m = IcmpTypeAndCode{} }
-func (m *IcmpTypeAndCode) String() string            { return proto1.CompactTextString(m) }
-func (*IcmpTypeAndCode) ProtoMessage()               {}
-func (*IcmpTypeAndCode) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{21} }
-
-func (m *IcmpTypeAndCode) GetType() int32 {
-	if m != nil {
-		return m.Type
-	}
-	return 0
-}
-
-func (m *IcmpTypeAndCode) GetCode() int32 {
-	if m != nil {
-		return m.Code
-	}
-	return 0
+type HTTPMatch_QueryParamMatch struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If neither is set, the parameter only needs to be present.
+	//
+	// Types that are valid to be assigned to ValueMatch:
+	//	*HTTPMatch_QueryParamMatch_Exact
+	//	*HTTPMatch_QueryParamMatch_Present
+	ValueMatch isHTTPMatch_QueryParamMatch_ValueMatch `protobuf_oneof:"value_match"`
 }
 
-type Protocol struct {
-	// Types that are valid to be assigned to NumberOrName:
-	//	*Protocol_Number
-	//	*Protocol_Name
-	NumberOrName isProtocol_NumberOrName `protobuf_oneof:"number_or_name"`
+func (m *HTTPMatch_QueryParamMatch) Reset()         { *m = HTTPMatch_QueryParamMatch{} }
+func (m *HTTPMatch_QueryParamMatch) String() string { return proto1.CompactTextString(m) }
+func (*HTTPMatch_QueryParamMatch) ProtoMessage()    {}
+func (*HTTPMatch_QueryParamMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{19, 1}
 }
 
-func (m *Protocol) Reset()                    { *m = Protocol{} }
-func (m *Protocol) String() string            { return proto1.CompactTextString(m) }
-func (*Protocol) ProtoMessage()               {}
-func (*Protocol) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{22} }
-
-type isProtocol_NumberOrName interface {
-	isProtocol_NumberOrName()
+type isHTTPMatch_QueryParamMatch_ValueMatch interface {
+	isHTTPMatch_QueryParamMatch_ValueMatch()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type Protocol_Number struct {
-	Number int32 `protobuf:"varint,1,opt,name=number,proto3,oneof"`
+type HTTPMatch_QueryParamMatch_Exact struct {
+	Exact string `protobuf:"bytes,2,opt,name=exact,proto3,oneof"`
 }
-type Protocol_Name struct {
-	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+type HTTPMatch_QueryParamMatch_Present struct {
+	Present bool `protobuf:"varint,3,opt,name=present,proto3,oneof"`
 }
 
-func (*Protocol_Number) isProtocol_NumberOrName() {}
-func (*Protocol_Name) isProtocol_NumberOrName()   {}
+func (*HTTPMatch_QueryParamMatch_Exact) isHTTPMatch_QueryParamMatch_ValueMatch()   {}
+func (*HTTPMatch_QueryParamMatch_Present) isHTTPMatch_QueryParamMatch_ValueMatch() {}
 
-func (m *Protocol) GetNumberOrName() isProtocol_NumberOrName {
+func (m *HTTPMatch_QueryParamMatch) GetValueMatch() isHTTPMatch_QueryParamMatch_ValueMatch {
 	if m != nil {
-		return m.NumberOrName
+		return m.ValueMatch
 	}
 	return nil
 }
 
-func (m *Protocol) GetNumber() int32 {
-	if x, ok := m.GetNumberOrName().(*Protocol_Number); ok {
-		return x.Number
+func (m *HTTPMatch_QueryParamMatch) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Protocol) GetName() string {
-	if x, ok := m.GetNumberOrName().(*Protocol_Name); ok {
-		return x.Name
+func (m *HTTPMatch_QueryParamMatch) GetExact() string {
+	if x, ok := m.GetValueMatch().(*HTTPMatch_QueryParamMatch_Exact); ok {
+		return x.Exact
 	}
 	return ""
 }
 
+func (m *HTTPMatch_QueryParamMatch) GetPresent() bool {
+	if x, ok := m.GetValueMatch().(*HTTPMatch_QueryParamMatch_Present); ok {
+		return x.Present
+	}
+	return false
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*Protocol) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
-	return _Protocol_OneofMarshaler, _Protocol_OneofUnmarshaler, _Protocol_OneofSizer, []interface{}{
-		(*Protocol_Number)(nil),
-		(*Protocol_Name)(nil),
+func (*HTTPMatch_QueryParamMatch) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
+	return _HTTPMatch_QueryParamMatch_OneofMarshaler, _HTTPMatch_QueryParamMatch_OneofUnmarshaler, _HTTPMatch_QueryParamMatch_OneofSizer, []interface{}{
+		(*HTTPMatch_QueryParamMatch_Exact)(nil),
+		(*HTTPMatch_QueryParamMatch_Present)(nil),
 	}
 }
 
-func _Protocol_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
-	m := msg.(*Protocol)
-	// number_or_name
-	switch x := m.NumberOrName.(type) {
-	case *Protocol_Number:
-		_ = b.EncodeVarint(1<<3 | proto1.WireVarint)
-		_ = b.EncodeVarint(uint64(x.Number))
-	case *Protocol_Name:
+func _HTTPMatch_QueryParamMatch_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
+	m := msg.(*HTTPMatch_QueryParamMatch)
+	// value_match
+	switch x := m.ValueMatch.(type) {
+	case *HTTPMatch_QueryParamMatch_Exact:
 		_ = b.EncodeVarint(2<<3 | proto1.WireBytes)
-		_ = b.EncodeStringBytes(x.Name)
+		_ = b.EncodeStringBytes(x.Exact)
+	case *HTTPMatch_QueryParamMatch_Present:
+		t := uint64(0)
+		if x.Present {
+			t = 1
+		}
+		_ = b.EncodeVarint(3<<3 | proto1.WireVarint)
+		_ = b.EncodeVarint(t)
 	case nil:
 	default:
-		return fmt.Errorf("Protocol.NumberOrName has unexpected type %T", x)
+		return fmt.Errorf("HTTPMatch_QueryParamMatch.ValueMatch has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _Protocol_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error) {
-	m := msg.(*Protocol)
+func _HTTPMatch_QueryParamMatch_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error) {
+	m := msg.(*HTTPMatch_QueryParamMatch)
 	switch tag {
-	case 1: // number_or_name.number
-		if wire != proto1.WireVarint {
+	case 2: // value_match.exact
+		if wire != proto1.WireBytes {
 			return true, proto1.ErrInternalBadWireType
 		}
-		x, err := b.DecodeVarint()
-		m.NumberOrName = &Protocol_Number{int32(x)}
+		x, err := b.DecodeStringBytes()
+		m.ValueMatch = &HTTPMatch_QueryParamMatch_Exact{x}
 		return true, err
-	case 2: // number_or_name.name
-		if wire != proto1.WireBytes {
+	case 3: // value_match.present
+		if wire != proto1.WireVarint {
 			return true, proto1.ErrInternalBadWireType
 		}
-		x, err := b.DecodeStringBytes()
-		m.NumberOrName = &Protocol_Name{x}
+		x, err := b.DecodeVarint()
+		m.ValueMatch = &HTTPMatch_QueryParamMatch_Present{x != 0}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _Protocol_OneofSizer(msg proto1.Message) (n int) {
-	m := msg.(*Protocol)
-	// number_or_name
-	switch x := m.NumberOrName.(type) {
-	case *Protocol_Number:
-		n += proto1.SizeVarint(1<<3 | proto1.WireVarint)
-		n += proto1.SizeVarint(uint64(x.Number))
-	case *Protocol_Name:
+func _HTTPMatch_QueryParamMatch_OneofSizer(msg proto1.Message) (n int) {
+	m := msg.(*HTTPMatch_QueryParamMatch)
+	// value_match
+	switch x := m.ValueMatch.(type) {
+	case *HTTPMatch_QueryParamMatch_Exact:
 		n += proto1.SizeVarint(2<<3 | proto1.WireBytes)
-		n += proto1.SizeVarint(uint64(len(x.Name)))
-		n += len(x.Name)
+		n += proto1.SizeVarint(uint64(len(x.Exact)))
+		n += len(x.Exact)
+	case *HTTPMatch_QueryParamMatch_Present:
+		n += proto1.SizeVarint(3<<3 | proto1.WireVarint)
+		n += 1
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2906,2627 +3385,2712 @@ func _Protocol_OneofSizer(msg proto1.Message) (n int) {
 	return n
 }
 
-// Individual ports are sent with first == last.
-type PortRange struct {
-	First int32 `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
-	Last  int32 `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+type HTTPMatch_HeaderMatch struct {
+	// The header name, compared case-insensitively.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If none is set, the header only needs to be present.
+	//
+	// Types that are valid to be assigned to ValueMatch:
+	//	*HTTPMatch_HeaderMatch_Exact
+	//	*HTTPMatch_HeaderMatch_Prefix
+	//	*HTTPMatch_HeaderMatch_Regex
+	//	*HTTPMatch_HeaderMatch_Present
+	ValueMatch isHTTPMatch_HeaderMatch_ValueMatch `protobuf_oneof:"value_match"`
 }
 
-func (m *PortRange) Reset()                    { *m = PortRange{} }
-func (m *PortRange) String() string            { return proto1.CompactTextString(m) }
-func (*PortRange) ProtoMessage()               {}
-func (*PortRange) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{23} }
-
-func (m *PortRange) GetFirst() int32 {
-	if m != nil {
-		return m.First
-	}
-	return 0
+func (m *HTTPMatch_HeaderMatch) Reset()         { *m = HTTPMatch_HeaderMatch{} }
+func (m *HTTPMatch_HeaderMatch) String() string { return proto1.CompactTextString(m) }
+func (*HTTPMatch_HeaderMatch) ProtoMessage()    {}
+func (*HTTPMatch_HeaderMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{19, 2}
 }
 
-func (m *PortRange) GetLast() int32 {
-	if m != nil {
-		return m.Last
-	}
-	return 0
+type isHTTPMatch_HeaderMatch_ValueMatch interface {
+	isHTTPMatch_HeaderMatch_ValueMatch()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type WorkloadEndpointID struct {
-	OrchestratorId string `protobuf:"bytes,2,opt,name=orchestrator_id,json=orchestratorId,proto3" json:"orchestrator_id,omitempty"`
-	WorkloadId     string `protobuf:"bytes,3,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
-	EndpointId     string `protobuf:"bytes,4,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+type HTTPMatch_HeaderMatch_Exact struct {
+	Exact string `protobuf:"bytes,2,opt,name=exact,proto3,oneof"`
+}
+type HTTPMatch_HeaderMatch_Prefix struct {
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3,oneof"`
+}
+type HTTPMatch_HeaderMatch_Regex struct {
+	Regex string `protobuf:"bytes,4,opt,name=regex,proto3,oneof"`
+}
+type HTTPMatch_HeaderMatch_Present struct {
+	Present bool `protobuf:"varint,5,opt,name=present,proto3,oneof"`
 }
 
-func (m *WorkloadEndpointID) Reset()                    { *m = WorkloadEndpointID{} }
-func (m *WorkloadEndpointID) String() string            { return proto1.CompactTextString(m) }
-func (*WorkloadEndpointID) ProtoMessage()               {}
-func (*WorkloadEndpointID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{24} }
+func (*HTTPMatch_HeaderMatch_Exact) isHTTPMatch_HeaderMatch_ValueMatch()   {}
+func (*HTTPMatch_HeaderMatch_Prefix) isHTTPMatch_HeaderMatch_ValueMatch()  {}
+func (*HTTPMatch_HeaderMatch_Regex) isHTTPMatch_HeaderMatch_ValueMatch()   {}
+func (*HTTPMatch_HeaderMatch_Present) isHTTPMatch_HeaderMatch_ValueMatch() {}
 
-func (m *WorkloadEndpointID) GetOrchestratorId() string {
+func (m *HTTPMatch_HeaderMatch) GetValueMatch() isHTTPMatch_HeaderMatch_ValueMatch {
 	if m != nil {
-		return m.OrchestratorId
+		return m.ValueMatch
 	}
-	return ""
+	return nil
 }
 
-func (m *WorkloadEndpointID) GetWorkloadId() string {
+func (m *HTTPMatch_HeaderMatch) GetName() string {
 	if m != nil {
-		return m.WorkloadId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *WorkloadEndpointID) GetEndpointId() string {
-	if m != nil {
-		return m.EndpointId
+func (m *HTTPMatch_HeaderMatch) GetExact() string {
+	if x, ok := m.GetValueMatch().(*HTTPMatch_HeaderMatch_Exact); ok {
+		return x.Exact
 	}
 	return ""
 }
 
-type WorkloadEndpointUpdate struct {
-	Id       *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Endpoint *WorkloadEndpoint   `protobuf:"bytes,5,opt,name=endpoint" json:"endpoint,omitempty"`
+func (m *HTTPMatch_HeaderMatch) GetPrefix() string {
+	if x, ok := m.GetValueMatch().(*HTTPMatch_HeaderMatch_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
 }
 
-func (m *WorkloadEndpointUpdate) Reset()         { *m = WorkloadEndpointUpdate{} }
-func (m *WorkloadEndpointUpdate) String() string { return proto1.CompactTextString(m) }
-func (*WorkloadEndpointUpdate) ProtoMessage()    {}
-func (*WorkloadEndpointUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{25}
+func (m *HTTPMatch_HeaderMatch) GetRegex() string {
+	if x, ok := m.GetValueMatch().(*HTTPMatch_HeaderMatch_Regex); ok {
+		return x.Regex
+	}
+	return ""
 }
 
-func (m *WorkloadEndpointUpdate) GetId() *WorkloadEndpointID {
-	if m != nil {
-		return m.Id
-	}
-	return nil
-}
-
-func (m *WorkloadEndpointUpdate) GetEndpoint() *WorkloadEndpoint {
-	if m != nil {
-		return m.Endpoint
+func (m *HTTPMatch_HeaderMatch) GetPresent() bool {
+	if x, ok := m.GetValueMatch().(*HTTPMatch_HeaderMatch_Present); ok {
+		return x.Present
 	}
-	return nil
-}
-
-type WorkloadEndpoint struct {
-	State                      string            `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Name                       string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Mac                        string            `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
-	ProfileIds                 []string          `protobuf:"bytes,4,rep,name=profile_ids,json=profileIds" json:"profile_ids,omitempty"`
-	Ipv4Nets                   []string          `protobuf:"bytes,5,rep,name=ipv4_nets,json=ipv4Nets" json:"ipv4_nets,omitempty"`
-	Ipv6Nets                   []string          `protobuf:"bytes,6,rep,name=ipv6_nets,json=ipv6Nets" json:"ipv6_nets,omitempty"`
-	Tiers                      []*TierInfo       `protobuf:"bytes,7,rep,name=tiers" json:"tiers,omitempty"`
-	Ipv4Nat                    []*NatInfo        `protobuf:"bytes,8,rep,name=ipv4_nat,json=ipv4Nat" json:"ipv4_nat,omitempty"`
-	Ipv6Nat                    []*NatInfo        `protobuf:"bytes,9,rep,name=ipv6_nat,json=ipv6Nat" json:"ipv6_nat,omitempty"`
-	AllowSpoofedSourcePrefixes []string          `protobuf:"bytes,10,rep,name=allow_spoofed_source_prefixes,json=allowSpoofedSourcePrefixes" json:"allow_spoofed_source_prefixes,omitempty"`
-	Annotations                map[string]string `protobuf:"bytes,11,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	return false
 }
 
-func (m *WorkloadEndpoint) Reset()                    { *m = WorkloadEndpoint{} }
-func (m *WorkloadEndpoint) String() string            { return proto1.CompactTextString(m) }
-func (*WorkloadEndpoint) ProtoMessage()               {}
-func (*WorkloadEndpoint) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{26} }
-
-func (m *WorkloadEndpoint) GetState() string {
-	if m != nil {
-		return m.State
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HTTPMatch_HeaderMatch) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
+	return _HTTPMatch_HeaderMatch_OneofMarshaler, _HTTPMatch_HeaderMatch_OneofUnmarshaler, _HTTPMatch_HeaderMatch_OneofSizer, []interface{}{
+		(*HTTPMatch_HeaderMatch_Exact)(nil),
+		(*HTTPMatch_HeaderMatch_Prefix)(nil),
+		(*HTTPMatch_HeaderMatch_Regex)(nil),
+		(*HTTPMatch_HeaderMatch_Present)(nil),
 	}
-	return ""
 }
 
-func (m *WorkloadEndpoint) GetName() string {
-	if m != nil {
-		return m.Name
+func _HTTPMatch_HeaderMatch_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
+	m := msg.(*HTTPMatch_HeaderMatch)
+	// value_match
+	switch x := m.ValueMatch.(type) {
+	case *HTTPMatch_HeaderMatch_Exact:
+		_ = b.EncodeVarint(2<<3 | proto1.WireBytes)
+		_ = b.EncodeStringBytes(x.Exact)
+	case *HTTPMatch_HeaderMatch_Prefix:
+		_ = b.EncodeVarint(3<<3 | proto1.WireBytes)
+		_ = b.EncodeStringBytes(x.Prefix)
+	case *HTTPMatch_HeaderMatch_Regex:
+		_ = b.EncodeVarint(4<<3 | proto1.WireBytes)
+		_ = b.EncodeStringBytes(x.Regex)
+	case *HTTPMatch_HeaderMatch_Present:
+		t := uint64(0)
+		if x.Present {
+			t = 1
+		}
+		_ = b.EncodeVarint(5<<3 | proto1.WireVarint)
+		_ = b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("HTTPMatch_HeaderMatch.ValueMatch has unexpected type %T", x)
 	}
-	return ""
+	return nil
 }
 
-func (m *WorkloadEndpoint) GetMac() string {
-	if m != nil {
-		return m.Mac
+func _HTTPMatch_HeaderMatch_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error) {
+	m := msg.(*HTTPMatch_HeaderMatch)
+	switch tag {
+	case 2: // value_match.exact
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.ValueMatch = &HTTPMatch_HeaderMatch_Exact{x}
+		return true, err
+	case 3: // value_match.prefix
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.ValueMatch = &HTTPMatch_HeaderMatch_Prefix{x}
+		return true, err
+	case 4: // value_match.regex
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.ValueMatch = &HTTPMatch_HeaderMatch_Regex{x}
+		return true, err
+	case 5: // value_match.present
+		if wire != proto1.WireVarint {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.ValueMatch = &HTTPMatch_HeaderMatch_Present{x != 0}
+		return true, err
+	default:
+		return false, nil
 	}
-	return ""
 }
 
-func (m *WorkloadEndpoint) GetProfileIds() []string {
-	if m != nil {
-		return m.ProfileIds
+func _HTTPMatch_HeaderMatch_OneofSizer(msg proto1.Message) (n int) {
+	m := msg.(*HTTPMatch_HeaderMatch)
+	// value_match
+	switch x := m.ValueMatch.(type) {
+	case *HTTPMatch_HeaderMatch_Exact:
+		n += proto1.SizeVarint(2<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.Exact)))
+		n += len(x.Exact)
+	case *HTTPMatch_HeaderMatch_Prefix:
+		n += proto1.SizeVarint(3<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.Prefix)))
+		n += len(x.Prefix)
+	case *HTTPMatch_HeaderMatch_Regex:
+		n += proto1.SizeVarint(4<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.Regex)))
+		n += len(x.Regex)
+	case *HTTPMatch_HeaderMatch_Present:
+		n += proto1.SizeVarint(5<<3 | proto1.WireVarint)
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return nil
+	return n
 }
 
-func (m *WorkloadEndpoint) GetIpv4Nets() []string {
-	if m != nil {
-		return m.Ipv4Nets
-	}
-	return nil
+type GrpcMatch struct {
+	// Fully-qualified service names, e.g. "helloworld.Greeter", one of which the call must be to.
+	Services []string `protobuf:"bytes,1,rep,name=services" json:"services,omitempty"`
+	// Method names, e.g. "SayHello", one of which the call must be to.
+	Methods []string `protobuf:"bytes,2,rep,name=methods" json:"methods,omitempty"`
 }
 
-func (m *WorkloadEndpoint) GetIpv6Nets() []string {
-	if m != nil {
-		return m.Ipv6Nets
-	}
-	return nil
-}
+func (m *GrpcMatch) Reset()                    { *m = GrpcMatch{} }
+func (m *GrpcMatch) String() string            { return proto1.CompactTextString(m) }
+func (*GrpcMatch) ProtoMessage()               {}
+func (*GrpcMatch) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{20} }
 
-func (m *WorkloadEndpoint) GetTiers() []*TierInfo {
+func (m *GrpcMatch) GetServices() []string {
 	if m != nil {
-		return m.Tiers
+		return m.Services
 	}
 	return nil
 }
 
-func (m *WorkloadEndpoint) GetIpv4Nat() []*NatInfo {
+func (m *GrpcMatch) GetMethods() []string {
 	if m != nil {
-		return m.Ipv4Nat
+		return m.Methods
 	}
 	return nil
 }
 
-func (m *WorkloadEndpoint) GetIpv6Nat() []*NatInfo {
-	if m != nil {
-		return m.Ipv6Nat
-	}
-	return nil
+type RuleMetadata struct {
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *WorkloadEndpoint) GetAllowSpoofedSourcePrefixes() []string {
-	if m != nil {
-		return m.AllowSpoofedSourcePrefixes
-	}
-	return nil
-}
+func (m *RuleMetadata) Reset()                    { *m = RuleMetadata{} }
+func (m *RuleMetadata) String() string            { return proto1.CompactTextString(m) }
+func (*RuleMetadata) ProtoMessage()               {}
+func (*RuleMetadata) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{21} }
 
-func (m *WorkloadEndpoint) GetAnnotations() map[string]string {
+func (m *RuleMetadata) GetAnnotations() map[string]string {
 	if m != nil {
 		return m.Annotations
 	}
 	return nil
 }
 
-type WorkloadEndpointRemove struct {
-	Id *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+type IcmpTypeAndCode struct {
+	Type int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (m *WorkloadEndpointRemove) Reset()         { *m = WorkloadEndpointRemove{} }
-func (m *WorkloadEndpointRemove) String() string { return proto1.CompactTextString(m) }
-func (*WorkloadEndpointRemove) ProtoMessage()    {}
-func (*WorkloadEndpointRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{27}
-}
+func (m *IcmpTypeAndCode) Reset()                    { *m = IcmpTypeAndCode{} }
+func (m *IcmpTypeAndCode) String() string            { return proto1.CompactTextString(m) }
+func (*IcmpTypeAndCode) ProtoMessage()               {}
+func (*IcmpTypeAndCode) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{22} }
 
-func (m *WorkloadEndpointRemove) GetId() *WorkloadEndpointID {
+func (m *IcmpTypeAndCode) GetType() int32 {
 	if m != nil {
-		return m.Id
+		return m.Type
 	}
-	return nil
-}
-
-type HostEndpointID struct {
-	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	return 0
 }
 
-func (m *HostEndpointID) Reset()                    { *m = HostEndpointID{} }
-func (m *HostEndpointID) String() string            { return proto1.CompactTextString(m) }
-func (*HostEndpointID) ProtoMessage()               {}
-func (*HostEndpointID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{28} }
-
-func (m *HostEndpointID) GetEndpointId() string {
+func (m *IcmpTypeAndCode) GetCode() int32 {
 	if m != nil {
-		return m.EndpointId
+		return m.Code
 	}
-	return ""
+	return 0
 }
 
-type HostEndpointUpdate struct {
-	Id       *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Endpoint *HostEndpoint   `protobuf:"bytes,3,opt,name=endpoint" json:"endpoint,omitempty"`
+type Protocol struct {
+	// Types that are valid to be assigned to NumberOrName:
+	//	*Protocol_Number
+	//	*Protocol_Name
+	NumberOrName isProtocol_NumberOrName `protobuf_oneof:"number_or_name"`
 }
 
-func (m *HostEndpointUpdate) Reset()                    { *m = HostEndpointUpdate{} }
-func (m *HostEndpointUpdate) String() string            { return proto1.CompactTextString(m) }
-func (*HostEndpointUpdate) ProtoMessage()               {}
-func (*HostEndpointUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{29} }
+func (m *Protocol) Reset()                    { *m = Protocol{} }
+func (m *Protocol) String() string            { return proto1.CompactTextString(m) }
+func (*Protocol) ProtoMessage()               {}
+func (*Protocol) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{23} }
 
-func (m *HostEndpointUpdate) GetId() *HostEndpointID {
-	if m != nil {
-		return m.Id
-	}
-	return nil
+type isProtocol_NumberOrName interface {
+	isProtocol_NumberOrName()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *HostEndpointUpdate) GetEndpoint() *HostEndpoint {
-	if m != nil {
-		return m.Endpoint
-	}
-	return nil
+type Protocol_Number struct {
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3,oneof"`
 }
-
-type HostEndpoint struct {
-	Name              string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ProfileIds        []string    `protobuf:"bytes,2,rep,name=profile_ids,json=profileIds" json:"profile_ids,omitempty"`
-	Tiers             []*TierInfo `protobuf:"bytes,3,rep,name=tiers" json:"tiers,omitempty"`
-	UntrackedTiers    []*TierInfo `protobuf:"bytes,6,rep,name=untracked_tiers,json=untrackedTiers" json:"untracked_tiers,omitempty"`
-	PreDnatTiers      []*TierInfo `protobuf:"bytes,7,rep,name=pre_dnat_tiers,json=preDnatTiers" json:"pre_dnat_tiers,omitempty"`
-	ForwardTiers      []*TierInfo `protobuf:"bytes,8,rep,name=forward_tiers,json=forwardTiers" json:"forward_tiers,omitempty"`
-	ExpectedIpv4Addrs []string    `protobuf:"bytes,4,rep,name=expected_ipv4_addrs,json=expectedIpv4Addrs" json:"expected_ipv4_addrs,omitempty"`
-	ExpectedIpv6Addrs []string    `protobuf:"bytes,5,rep,name=expected_ipv6_addrs,json=expectedIpv6Addrs" json:"expected_ipv6_addrs,omitempty"`
+type Protocol_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
 }
 
-func (m *HostEndpoint) Reset()                    { *m = HostEndpoint{} }
-func (m *HostEndpoint) String() string            { return proto1.CompactTextString(m) }
-func (*HostEndpoint) ProtoMessage()               {}
-func (*HostEndpoint) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{30} }
-
-func (m *HostEndpoint) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+func (*Protocol_Number) isProtocol_NumberOrName() {}
+func (*Protocol_Name) isProtocol_NumberOrName()   {}
 
-func (m *HostEndpoint) GetProfileIds() []string {
+func (m *Protocol) GetNumberOrName() isProtocol_NumberOrName {
 	if m != nil {
-		return m.ProfileIds
+		return m.NumberOrName
 	}
 	return nil
 }
 
-func (m *HostEndpoint) GetTiers() []*TierInfo {
-	if m != nil {
-		return m.Tiers
+func (m *Protocol) GetNumber() int32 {
+	if x, ok := m.GetNumberOrName().(*Protocol_Number); ok {
+		return x.Number
 	}
-	return nil
+	return 0
 }
 
-func (m *HostEndpoint) GetUntrackedTiers() []*TierInfo {
-	if m != nil {
-		return m.UntrackedTiers
+func (m *Protocol) GetName() string {
+	if x, ok := m.GetNumberOrName().(*Protocol_Name); ok {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *HostEndpoint) GetPreDnatTiers() []*TierInfo {
-	if m != nil {
-		return m.PreDnatTiers
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Protocol) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
+	return _Protocol_OneofMarshaler, _Protocol_OneofUnmarshaler, _Protocol_OneofSizer, []interface{}{
+		(*Protocol_Number)(nil),
+		(*Protocol_Name)(nil),
 	}
-	return nil
 }
 
-func (m *HostEndpoint) GetForwardTiers() []*TierInfo {
-	if m != nil {
-		return m.ForwardTiers
+func _Protocol_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
+	m := msg.(*Protocol)
+	// number_or_name
+	switch x := m.NumberOrName.(type) {
+	case *Protocol_Number:
+		_ = b.EncodeVarint(1<<3 | proto1.WireVarint)
+		_ = b.EncodeVarint(uint64(x.Number))
+	case *Protocol_Name:
+		_ = b.EncodeVarint(2<<3 | proto1.WireBytes)
+		_ = b.EncodeStringBytes(x.Name)
+	case nil:
+	default:
+		return fmt.Errorf("Protocol.NumberOrName has unexpected type %T", x)
 	}
 	return nil
 }
 
-func (m *HostEndpoint) GetExpectedIpv4Addrs() []string {
-	if m != nil {
-		return m.ExpectedIpv4Addrs
+func _Protocol_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error) {
+	m := msg.(*Protocol)
+	switch tag {
+	case 1: // number_or_name.number
+		if wire != proto1.WireVarint {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.NumberOrName = &Protocol_Number{int32(x)}
+		return true, err
+	case 2: // number_or_name.name
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.NumberOrName = &Protocol_Name{x}
+		return true, err
+	default:
+		return false, nil
 	}
-	return nil
 }
 
-func (m *HostEndpoint) GetExpectedIpv6Addrs() []string {
-	if m != nil {
-		return m.ExpectedIpv6Addrs
+func _Protocol_OneofSizer(msg proto1.Message) (n int) {
+	m := msg.(*Protocol)
+	// number_or_name
+	switch x := m.NumberOrName.(type) {
+	case *Protocol_Number:
+		n += proto1.SizeVarint(1<<3 | proto1.WireVarint)
+		n += proto1.SizeVarint(uint64(x.Number))
+	case *Protocol_Name:
+		n += proto1.SizeVarint(2<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.Name)))
+		n += len(x.Name)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return nil
+	return n
 }
 
-type HostEndpointRemove struct {
-	Id *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+// Individual ports are sent with first == last.
+type PortRange struct {
+	First int32 `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	Last  int32 `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
 }
 
-func (m *HostEndpointRemove) Reset()                    { *m = HostEndpointRemove{} }
-func (m *HostEndpointRemove) String() string            { return proto1.CompactTextString(m) }
-func (*HostEndpointRemove) ProtoMessage()               {}
-func (*HostEndpointRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{31} }
+func (m *PortRange) Reset()                    { *m = PortRange{} }
+func (m *PortRange) String() string            { return proto1.CompactTextString(m) }
+func (*PortRange) ProtoMessage()               {}
+func (*PortRange) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{24} }
 
-func (m *HostEndpointRemove) GetId() *HostEndpointID {
+func (m *PortRange) GetFirst() int32 {
 	if m != nil {
-		return m.Id
+		return m.First
 	}
-	return nil
+	return 0
 }
 
-type TierInfo struct {
-	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	IngressPolicies []string `protobuf:"bytes,2,rep,name=ingress_policies,json=ingressPolicies" json:"ingress_policies,omitempty"`
-	EgressPolicies  []string `protobuf:"bytes,3,rep,name=egress_policies,json=egressPolicies" json:"egress_policies,omitempty"`
+func (m *PortRange) GetLast() int32 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
 }
 
-func (m *TierInfo) Reset()                    { *m = TierInfo{} }
-func (m *TierInfo) String() string            { return proto1.CompactTextString(m) }
-func (*TierInfo) ProtoMessage()               {}
-func (*TierInfo) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{32} }
+type WorkloadEndpointID struct {
+	OrchestratorId string `protobuf:"bytes,2,opt,name=orchestrator_id,json=orchestratorId,proto3" json:"orchestrator_id,omitempty"`
+	WorkloadId     string `protobuf:"bytes,3,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	EndpointId     string `protobuf:"bytes,4,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+}
 
-func (m *TierInfo) GetName() string {
+func (m *WorkloadEndpointID) Reset()                    { *m = WorkloadEndpointID{} }
+func (m *WorkloadEndpointID) String() string            { return proto1.CompactTextString(m) }
+func (*WorkloadEndpointID) ProtoMessage()               {}
+func (*WorkloadEndpointID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{25} }
+
+func (m *WorkloadEndpointID) GetOrchestratorId() string {
 	if m != nil {
-		return m.Name
+		return m.OrchestratorId
 	}
 	return ""
 }
 
-func (m *TierInfo) GetIngressPolicies() []string {
+func (m *WorkloadEndpointID) GetWorkloadId() string {
 	if m != nil {
-		return m.IngressPolicies
+		return m.WorkloadId
 	}
-	return nil
+	return ""
 }
 
-func (m *TierInfo) GetEgressPolicies() []string {
+func (m *WorkloadEndpointID) GetEndpointId() string {
 	if m != nil {
-		return m.EgressPolicies
+		return m.EndpointId
 	}
-	return nil
+	return ""
 }
 
-type NatInfo struct {
-	ExtIp string `protobuf:"bytes,1,opt,name=ext_ip,json=extIp,proto3" json:"ext_ip,omitempty"`
-	IntIp string `protobuf:"bytes,2,opt,name=int_ip,json=intIp,proto3" json:"int_ip,omitempty"`
+type WorkloadEndpointUpdate struct {
+	Id       *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Endpoint *WorkloadEndpoint   `protobuf:"bytes,5,opt,name=endpoint" json:"endpoint,omitempty"`
 }
 
-func (m *NatInfo) Reset()                    { *m = NatInfo{} }
-func (m *NatInfo) String() string            { return proto1.CompactTextString(m) }
-func (*NatInfo) ProtoMessage()               {}
-func (*NatInfo) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{33} }
+func (m *WorkloadEndpointUpdate) Reset()         { *m = WorkloadEndpointUpdate{} }
+func (m *WorkloadEndpointUpdate) String() string { return proto1.CompactTextString(m) }
+func (*WorkloadEndpointUpdate) ProtoMessage()    {}
+func (*WorkloadEndpointUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{26}
+}
 
-func (m *NatInfo) GetExtIp() string {
+func (m *WorkloadEndpointUpdate) GetId() *WorkloadEndpointID {
 	if m != nil {
-		return m.ExtIp
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *NatInfo) GetIntIp() string {
+func (m *WorkloadEndpointUpdate) GetEndpoint() *WorkloadEndpoint {
 	if m != nil {
-		return m.IntIp
+		return m.Endpoint
 	}
-	return ""
+	return nil
 }
 
-type ProcessStatusUpdate struct {
-	IsoTimestamp string  `protobuf:"bytes,1,opt,name=iso_timestamp,json=isoTimestamp,proto3" json:"iso_timestamp,omitempty"`
-	Uptime       float64 `protobuf:"fixed64,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+type WorkloadEndpoint struct {
+	State                      string            `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Name                       string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mac                        string            `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	ProfileIds                 []string          `protobuf:"bytes,4,rep,name=profile_ids,json=profileIds" json:"profile_ids,omitempty"`
+	Ipv4Nets                   []string          `protobuf:"bytes,5,rep,name=ipv4_nets,json=ipv4Nets" json:"ipv4_nets,omitempty"`
+	Ipv6Nets                   []string          `protobuf:"bytes,6,rep,name=ipv6_nets,json=ipv6Nets" json:"ipv6_nets,omitempty"`
+	Tiers                      []*TierInfo       `protobuf:"bytes,7,rep,name=tiers" json:"tiers,omitempty"`
+	Ipv4Nat                    []*NatInfo        `protobuf:"bytes,8,rep,name=ipv4_nat,json=ipv4Nat" json:"ipv4_nat,omitempty"`
+	Ipv6Nat                    []*NatInfo        `protobuf:"bytes,9,rep,name=ipv6_nat,json=ipv6Nat" json:"ipv6_nat,omitempty"`
+	AllowSpoofedSourcePrefixes []string          `protobuf:"bytes,10,rep,name=allow_spoofed_source_prefixes,json=allowSpoofedSourcePrefixes" json:"allow_spoofed_source_prefixes,omitempty"`
+	Annotations                map[string]string `protobuf:"bytes,11,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The ports that the workload declares that it listens on, e.g. the container ports of a pod.
+	Ports []*EndpointPort `protobuf:"bytes,13,rep,name=ports" json:"ports,omitempty"`
 }
 
-func (m *ProcessStatusUpdate) Reset()         { *m = ProcessStatusUpdate{} }
-func (m *ProcessStatusUpdate) String() string { return proto1.CompactTextString(m) }
-func (*ProcessStatusUpdate) ProtoMessage()    {}
-func (*ProcessStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{34}
-}
+func (m *WorkloadEndpoint) Reset()                    { *m = WorkloadEndpoint{} }
+func (m *WorkloadEndpoint) String() string            { return proto1.CompactTextString(m) }
+func (*WorkloadEndpoint) ProtoMessage()               {}
+func (*WorkloadEndpoint) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{27} }
 
-func (m *ProcessStatusUpdate) GetIsoTimestamp() string {
+func (m *WorkloadEndpoint) GetState() string {
 	if m != nil {
-		return m.IsoTimestamp
+		return m.State
 	}
 	return ""
 }
 
-func (m *ProcessStatusUpdate) GetUptime() float64 {
+func (m *WorkloadEndpoint) GetName() string {
 	if m != nil {
-		return m.Uptime
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-type HostEndpointStatusUpdate struct {
-	Id     *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Status *EndpointStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+func (m *WorkloadEndpoint) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
 }
 
-func (m *HostEndpointStatusUpdate) Reset()         { *m = HostEndpointStatusUpdate{} }
-func (m *HostEndpointStatusUpdate) String() string { return proto1.CompactTextString(m) }
-func (*HostEndpointStatusUpdate) ProtoMessage()    {}
-func (*HostEndpointStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{35}
+func (m *WorkloadEndpoint) GetProfileIds() []string {
+	if m != nil {
+		return m.ProfileIds
+	}
+	return nil
 }
 
-func (m *HostEndpointStatusUpdate) GetId() *HostEndpointID {
+func (m *WorkloadEndpoint) GetIpv4Nets() []string {
 	if m != nil {
-		return m.Id
+		return m.Ipv4Nets
 	}
 	return nil
 }
 
-func (m *HostEndpointStatusUpdate) GetStatus() *EndpointStatus {
+func (m *WorkloadEndpoint) GetIpv6Nets() []string {
 	if m != nil {
-		return m.Status
+		return m.Ipv6Nets
 	}
 	return nil
 }
 
-type EndpointStatus struct {
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (m *WorkloadEndpoint) GetTiers() []*TierInfo {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
 }
 
-func (m *EndpointStatus) Reset()                    { *m = EndpointStatus{} }
-func (m *EndpointStatus) String() string            { return proto1.CompactTextString(m) }
-func (*EndpointStatus) ProtoMessage()               {}
-func (*EndpointStatus) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{36} }
+func (m *WorkloadEndpoint) GetIpv4Nat() []*NatInfo {
+	if m != nil {
+		return m.Ipv4Nat
+	}
+	return nil
+}
 
-func (m *EndpointStatus) GetStatus() string {
+func (m *WorkloadEndpoint) GetIpv6Nat() []*NatInfo {
 	if m != nil {
-		return m.Status
+		return m.Ipv6Nat
 	}
-	return ""
+	return nil
 }
 
-type HostEndpointStatusRemove struct {
-	Id *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+func (m *WorkloadEndpoint) GetAllowSpoofedSourcePrefixes() []string {
+	if m != nil {
+		return m.AllowSpoofedSourcePrefixes
+	}
+	return nil
 }
 
-func (m *HostEndpointStatusRemove) Reset()         { *m = HostEndpointStatusRemove{} }
-func (m *HostEndpointStatusRemove) String() string { return proto1.CompactTextString(m) }
-func (*HostEndpointStatusRemove) ProtoMessage()    {}
-func (*HostEndpointStatusRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{37}
+func (m *WorkloadEndpoint) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
 }
 
-func (m *HostEndpointStatusRemove) GetId() *HostEndpointID {
+func (m *WorkloadEndpoint) GetPorts() []*EndpointPort {
 	if m != nil {
-		return m.Id
+		return m.Ports
 	}
 	return nil
 }
 
-type WorkloadEndpointStatusUpdate struct {
-	Id     *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Status *EndpointStatus     `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+type EndpointPort struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The protocol name, e.g. "TCP", or number.
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port     uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
 }
 
-func (m *WorkloadEndpointStatusUpdate) Reset()         { *m = WorkloadEndpointStatusUpdate{} }
-func (m *WorkloadEndpointStatusUpdate) String() string { return proto1.CompactTextString(m) }
-func (*WorkloadEndpointStatusUpdate) ProtoMessage()    {}
-func (*WorkloadEndpointStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{38}
+func (m *EndpointPort) Reset()                    { *m = EndpointPort{} }
+func (m *EndpointPort) String() string            { return proto1.CompactTextString(m) }
+func (*EndpointPort) ProtoMessage()               {}
+func (*EndpointPort) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{28} }
+
+func (m *EndpointPort) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *WorkloadEndpointStatusUpdate) GetId() *WorkloadEndpointID {
+func (m *EndpointPort) GetProtocol() string {
 	if m != nil {
-		return m.Id
+		return m.Protocol
 	}
-	return nil
+	return ""
 }
 
-func (m *WorkloadEndpointStatusUpdate) GetStatus() *EndpointStatus {
+func (m *EndpointPort) GetPort() uint32 {
 	if m != nil {
-		return m.Status
+		return m.Port
 	}
-	return nil
+	return 0
 }
 
-type WorkloadEndpointStatusRemove struct {
+type WorkloadEndpointRemove struct {
 	Id *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *WorkloadEndpointStatusRemove) Reset()         { *m = WorkloadEndpointStatusRemove{} }
-func (m *WorkloadEndpointStatusRemove) String() string { return proto1.CompactTextString(m) }
-func (*WorkloadEndpointStatusRemove) ProtoMessage()    {}
-func (*WorkloadEndpointStatusRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{39}
+func (m *WorkloadEndpointRemove) Reset()         { *m = WorkloadEndpointRemove{} }
+func (m *WorkloadEndpointRemove) String() string { return proto1.CompactTextString(m) }
+func (*WorkloadEndpointRemove) ProtoMessage()    {}
+func (*WorkloadEndpointRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{29}
 }
 
-func (m *WorkloadEndpointStatusRemove) GetId() *WorkloadEndpointID {
+func (m *WorkloadEndpointRemove) GetId() *WorkloadEndpointID {
 	if m != nil {
 		return m.Id
 	}
 	return nil
 }
 
-type WireguardStatusUpdate struct {
-	// Wireguard public-key set on the interface.
-	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	// The IP version of this update
-	IpVersion IPVersion `protobuf:"varint,2,opt,name=ip_version,json=ipVersion,proto3,enum=felix.IPVersion" json:"ip_version,omitempty"`
+type HostEndpointID struct {
+	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
 }
 
-func (m *WireguardStatusUpdate) Reset()         { *m = WireguardStatusUpdate{} }
-func (m *WireguardStatusUpdate) String() string { return proto1.CompactTextString(m) }
-func (*WireguardStatusUpdate) ProtoMessage()    {}
-func (*WireguardStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{40}
-}
+func (m *HostEndpointID) Reset()                    { *m = HostEndpointID{} }
+func (m *HostEndpointID) String() string            { return proto1.CompactTextString(m) }
+func (*HostEndpointID) ProtoMessage()               {}
+func (*HostEndpointID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{30} }
 
-func (m *WireguardStatusUpdate) GetPublicKey() string {
+func (m *HostEndpointID) GetEndpointId() string {
 	if m != nil {
-		return m.PublicKey
+		return m.EndpointId
 	}
 	return ""
 }
 
-func (m *WireguardStatusUpdate) GetIpVersion() IPVersion {
+type HostEndpointUpdate struct {
+	Id       *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Endpoint *HostEndpoint   `protobuf:"bytes,3,opt,name=endpoint" json:"endpoint,omitempty"`
+}
+
+func (m *HostEndpointUpdate) Reset()                    { *m = HostEndpointUpdate{} }
+func (m *HostEndpointUpdate) String() string            { return proto1.CompactTextString(m) }
+func (*HostEndpointUpdate) ProtoMessage()               {}
+func (*HostEndpointUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{31} }
+
+func (m *HostEndpointUpdate) GetId() *HostEndpointID {
 	if m != nil {
-		return m.IpVersion
+		return m.Id
 	}
-	return IPVersion_ANY
+	return nil
 }
 
-type DataplaneInSync struct {
+func (m *HostEndpointUpdate) GetEndpoint() *HostEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
 }
 
-func (m *DataplaneInSync) Reset()                    { *m = DataplaneInSync{} }
-func (m *DataplaneInSync) String() string            { return proto1.CompactTextString(m) }
-func (*DataplaneInSync) ProtoMessage()               {}
-func (*DataplaneInSync) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{41} }
-
-type HostMetadataV4V6Update struct {
-	Hostname string            `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ipv4Addr string            `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
-	Ipv6Addr string            `protobuf:"bytes,3,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
-	Asnumber string            `protobuf:"bytes,4,opt,name=asnumber,proto3" json:"asnumber,omitempty"`
-	Labels   map[string]string `protobuf:"bytes,5,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type HostEndpoint struct {
+	Name              string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProfileIds        []string    `protobuf:"bytes,2,rep,name=profile_ids,json=profileIds" json:"profile_ids,omitempty"`
+	Tiers             []*TierInfo `protobuf:"bytes,3,rep,name=tiers" json:"tiers,omitempty"`
+	UntrackedTiers    []*TierInfo `protobuf:"bytes,6,rep,name=untracked_tiers,json=untrackedTiers" json:"untracked_tiers,omitempty"`
+	PreDnatTiers      []*TierInfo `protobuf:"bytes,7,rep,name=pre_dnat_tiers,json=preDnatTiers" json:"pre_dnat_tiers,omitempty"`
+	ForwardTiers      []*TierInfo `protobuf:"bytes,8,rep,name=forward_tiers,json=forwardTiers" json:"forward_tiers,omitempty"`
+	ExpectedIpv4Addrs []string    `protobuf:"bytes,4,rep,name=expected_ipv4_addrs,json=expectedIpv4Addrs" json:"expected_ipv4_addrs,omitempty"`
+	ExpectedIpv6Addrs []string    `protobuf:"bytes,5,rep,name=expected_ipv6_addrs,json=expectedIpv6Addrs" json:"expected_ipv6_addrs,omitempty"`
 }
 
-func (m *HostMetadataV4V6Update) Reset()         { *m = HostMetadataV4V6Update{} }
-func (m *HostMetadataV4V6Update) String() string { return proto1.CompactTextString(m) }
-func (*HostMetadataV4V6Update) ProtoMessage()    {}
-func (*HostMetadataV4V6Update) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{42}
-}
+func (m *HostEndpoint) Reset()                    { *m = HostEndpoint{} }
+func (m *HostEndpoint) String() string            { return proto1.CompactTextString(m) }
+func (*HostEndpoint) ProtoMessage()               {}
+func (*HostEndpoint) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{32} }
 
-func (m *HostMetadataV4V6Update) GetHostname() string {
+func (m *HostEndpoint) GetName() string {
 	if m != nil {
-		return m.Hostname
+		return m.Name
 	}
 	return ""
 }
 
-func (m *HostMetadataV4V6Update) GetIpv4Addr() string {
+func (m *HostEndpoint) GetProfileIds() []string {
 	if m != nil {
-		return m.Ipv4Addr
+		return m.ProfileIds
 	}
-	return ""
+	return nil
 }
 
-func (m *HostMetadataV4V6Update) GetIpv6Addr() string {
+func (m *HostEndpoint) GetTiers() []*TierInfo {
 	if m != nil {
-		return m.Ipv6Addr
+		return m.Tiers
 	}
-	return ""
+	return nil
 }
 
-func (m *HostMetadataV4V6Update) GetAsnumber() string {
+func (m *HostEndpoint) GetUntrackedTiers() []*TierInfo {
 	if m != nil {
-		return m.Asnumber
+		return m.UntrackedTiers
 	}
-	return ""
+	return nil
 }
 
-func (m *HostMetadataV4V6Update) GetLabels() map[string]string {
+func (m *HostEndpoint) GetPreDnatTiers() []*TierInfo {
 	if m != nil {
-		return m.Labels
+		return m.PreDnatTiers
 	}
 	return nil
 }
 
-type HostMetadataV4V6Remove struct {
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
-}
-
-func (m *HostMetadataV4V6Remove) Reset()         { *m = HostMetadataV4V6Remove{} }
-func (m *HostMetadataV4V6Remove) String() string { return proto1.CompactTextString(m) }
-func (*HostMetadataV4V6Remove) ProtoMessage()    {}
-func (*HostMetadataV4V6Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{43}
+func (m *HostEndpoint) GetForwardTiers() []*TierInfo {
+	if m != nil {
+		return m.ForwardTiers
+	}
+	return nil
 }
 
-func (m *HostMetadataV4V6Remove) GetHostname() string {
+func (m *HostEndpoint) GetExpectedIpv4Addrs() []string {
 	if m != nil {
-		return m.Hostname
+		return m.ExpectedIpv4Addrs
 	}
-	return ""
+	return nil
 }
 
-func (m *HostMetadataV4V6Remove) GetIpv4Addr() string {
+func (m *HostEndpoint) GetExpectedIpv6Addrs() []string {
 	if m != nil {
-		return m.Ipv4Addr
+		return m.ExpectedIpv6Addrs
 	}
-	return ""
+	return nil
 }
 
-type HostMetadataUpdate struct {
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
+type HostEndpointRemove struct {
+	Id *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *HostMetadataUpdate) Reset()                    { *m = HostMetadataUpdate{} }
-func (m *HostMetadataUpdate) String() string            { return proto1.CompactTextString(m) }
-func (*HostMetadataUpdate) ProtoMessage()               {}
-func (*HostMetadataUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{44} }
-
-func (m *HostMetadataUpdate) GetHostname() string {
-	if m != nil {
-		return m.Hostname
-	}
-	return ""
-}
+func (m *HostEndpointRemove) Reset()                    { *m = HostEndpointRemove{} }
+func (m *HostEndpointRemove) String() string            { return proto1.CompactTextString(m) }
+func (*HostEndpointRemove) ProtoMessage()               {}
+func (*HostEndpointRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{33} }
 
-func (m *HostMetadataUpdate) GetIpv4Addr() string {
+func (m *HostEndpointRemove) GetId() *HostEndpointID {
 	if m != nil {
-		return m.Ipv4Addr
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-type HostMetadataRemove struct {
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
+type TierInfo struct {
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IngressPolicies []string `protobuf:"bytes,2,rep,name=ingress_policies,json=ingressPolicies" json:"ingress_policies,omitempty"`
+	EgressPolicies  []string `protobuf:"bytes,3,rep,name=egress_policies,json=egressPolicies" json:"egress_policies,omitempty"`
 }
 
-func (m *HostMetadataRemove) Reset()                    { *m = HostMetadataRemove{} }
-func (m *HostMetadataRemove) String() string            { return proto1.CompactTextString(m) }
-func (*HostMetadataRemove) ProtoMessage()               {}
-func (*HostMetadataRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{45} }
+func (m *TierInfo) Reset()                    { *m = TierInfo{} }
+func (m *TierInfo) String() string            { return proto1.CompactTextString(m) }
+func (*TierInfo) ProtoMessage()               {}
+func (*TierInfo) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{34} }
 
-func (m *HostMetadataRemove) GetHostname() string {
+func (m *TierInfo) GetName() string {
 	if m != nil {
-		return m.Hostname
+		return m.Name
 	}
 	return ""
 }
 
-func (m *HostMetadataRemove) GetIpv4Addr() string {
+func (m *TierInfo) GetIngressPolicies() []string {
 	if m != nil {
-		return m.Ipv4Addr
+		return m.IngressPolicies
 	}
-	return ""
+	return nil
 }
 
-type HostMetadataV6Update struct {
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ipv6Addr string `protobuf:"bytes,2,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+func (m *TierInfo) GetEgressPolicies() []string {
+	if m != nil {
+		return m.EgressPolicies
+	}
+	return nil
 }
 
-func (m *HostMetadataV6Update) Reset()         { *m = HostMetadataV6Update{} }
-func (m *HostMetadataV6Update) String() string { return proto1.CompactTextString(m) }
-func (*HostMetadataV6Update) ProtoMessage()    {}
-func (*HostMetadataV6Update) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{46}
+type NatInfo struct {
+	ExtIp string `protobuf:"bytes,1,opt,name=ext_ip,json=extIp,proto3" json:"ext_ip,omitempty"`
+	IntIp string `protobuf:"bytes,2,opt,name=int_ip,json=intIp,proto3" json:"int_ip,omitempty"`
 }
 
-func (m *HostMetadataV6Update) GetHostname() string {
+func (m *NatInfo) Reset()                    { *m = NatInfo{} }
+func (m *NatInfo) String() string            { return proto1.CompactTextString(m) }
+func (*NatInfo) ProtoMessage()               {}
+func (*NatInfo) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{35} }
+
+func (m *NatInfo) GetExtIp() string {
 	if m != nil {
-		return m.Hostname
+		return m.ExtIp
 	}
 	return ""
 }
 
-func (m *HostMetadataV6Update) GetIpv6Addr() string {
+func (m *NatInfo) GetIntIp() string {
 	if m != nil {
-		return m.Ipv6Addr
+		return m.IntIp
 	}
 	return ""
 }
 
-type HostMetadataV6Remove struct {
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ipv6Addr string `protobuf:"bytes,2,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+type ProcessStatusUpdate struct {
+	IsoTimestamp string  `protobuf:"bytes,1,opt,name=iso_timestamp,json=isoTimestamp,proto3" json:"iso_timestamp,omitempty"`
+	Uptime       float64 `protobuf:"fixed64,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
 }
 
-func (m *HostMetadataV6Remove) Reset()         { *m = HostMetadataV6Remove{} }
-func (m *HostMetadataV6Remove) String() string { return proto1.CompactTextString(m) }
-func (*HostMetadataV6Remove) ProtoMessage()    {}
-func (*HostMetadataV6Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{47}
+func (m *ProcessStatusUpdate) Reset()         { *m = ProcessStatusUpdate{} }
+func (m *ProcessStatusUpdate) String() string { return proto1.CompactTextString(m) }
+func (*ProcessStatusUpdate) ProtoMessage()    {}
+func (*ProcessStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{36}
 }
 
-func (m *HostMetadataV6Remove) GetHostname() string {
+func (m *ProcessStatusUpdate) GetIsoTimestamp() string {
 	if m != nil {
-		return m.Hostname
+		return m.IsoTimestamp
 	}
 	return ""
 }
 
-func (m *HostMetadataV6Remove) GetIpv6Addr() string {
+func (m *ProcessStatusUpdate) GetUptime() float64 {
 	if m != nil {
-		return m.Ipv6Addr
+		return m.Uptime
 	}
-	return ""
+	return 0
 }
 
-type IPAMPoolUpdate struct {
-	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pool *IPAMPool `protobuf:"bytes,2,opt,name=pool" json:"pool,omitempty"`
+type HostEndpointStatusUpdate struct {
+	Id     *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Status *EndpointStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
 }
 
-func (m *IPAMPoolUpdate) Reset()                    { *m = IPAMPoolUpdate{} }
-func (m *IPAMPoolUpdate) String() string            { return proto1.CompactTextString(m) }
-func (*IPAMPoolUpdate) ProtoMessage()               {}
-func (*IPAMPoolUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{48} }
+func (m *HostEndpointStatusUpdate) Reset()         { *m = HostEndpointStatusUpdate{} }
+func (m *HostEndpointStatusUpdate) String() string { return proto1.CompactTextString(m) }
+func (*HostEndpointStatusUpdate) ProtoMessage()    {}
+func (*HostEndpointStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{37}
+}
 
-func (m *IPAMPoolUpdate) GetId() string {
+func (m *HostEndpointStatusUpdate) GetId() *HostEndpointID {
 	if m != nil {
 		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *IPAMPoolUpdate) GetPool() *IPAMPool {
+func (m *HostEndpointStatusUpdate) GetStatus() *EndpointStatus {
 	if m != nil {
-		return m.Pool
+		return m.Status
 	}
 	return nil
 }
 
-type IPAMPoolRemove struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type EndpointStatus struct {
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *IPAMPoolRemove) Reset()                    { *m = IPAMPoolRemove{} }
-func (m *IPAMPoolRemove) String() string            { return proto1.CompactTextString(m) }
-func (*IPAMPoolRemove) ProtoMessage()               {}
-func (*IPAMPoolRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{49} }
+func (m *EndpointStatus) Reset()                    { *m = EndpointStatus{} }
+func (m *EndpointStatus) String() string            { return proto1.CompactTextString(m) }
+func (*EndpointStatus) ProtoMessage()               {}
+func (*EndpointStatus) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{38} }
 
-func (m *IPAMPoolRemove) GetId() string {
+func (m *EndpointStatus) GetStatus() string {
 	if m != nil {
-		return m.Id
+		return m.Status
 	}
 	return ""
 }
 
-type IPAMPool struct {
-	Cidr       string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
-	Masquerade bool   `protobuf:"varint,2,opt,name=masquerade,proto3" json:"masquerade,omitempty"`
-	IpipMode   string `protobuf:"bytes,3,opt,name=ipip_mode,json=ipipMode,proto3" json:"ipip_mode,omitempty"`
-	VxlanMode  string `protobuf:"bytes,4,opt,name=vxlan_mode,json=vxlanMode,proto3" json:"vxlan_mode,omitempty"`
+type HostEndpointStatusRemove struct {
+	Id *HostEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *IPAMPool) Reset()                    { *m = IPAMPool{} }
-func (m *IPAMPool) String() string            { return proto1.CompactTextString(m) }
-func (*IPAMPool) ProtoMessage()               {}
-func (*IPAMPool) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{50} }
+func (m *HostEndpointStatusRemove) Reset()         { *m = HostEndpointStatusRemove{} }
+func (m *HostEndpointStatusRemove) String() string { return proto1.CompactTextString(m) }
+func (*HostEndpointStatusRemove) ProtoMessage()    {}
+func (*HostEndpointStatusRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{39}
+}
 
-func (m *IPAMPool) GetCidr() string {
+func (m *HostEndpointStatusRemove) GetId() *HostEndpointID {
 	if m != nil {
-		return m.Cidr
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *IPAMPool) GetMasquerade() bool {
-	if m != nil {
-		return m.Masquerade
-	}
-	return false
+type WorkloadEndpointStatusUpdate struct {
+	Id     *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Status *EndpointStatus     `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
 }
 
-func (m *IPAMPool) GetIpipMode() string {
+func (m *WorkloadEndpointStatusUpdate) Reset()         { *m = WorkloadEndpointStatusUpdate{} }
+func (m *WorkloadEndpointStatusUpdate) String() string { return proto1.CompactTextString(m) }
+func (*WorkloadEndpointStatusUpdate) ProtoMessage()    {}
+func (*WorkloadEndpointStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{40}
+}
+
+func (m *WorkloadEndpointStatusUpdate) GetId() *WorkloadEndpointID {
 	if m != nil {
-		return m.IpipMode
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *IPAMPool) GetVxlanMode() string {
+func (m *WorkloadEndpointStatusUpdate) GetStatus() *EndpointStatus {
 	if m != nil {
-		return m.VxlanMode
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-type Encapsulation struct {
-	IpipEnabled    bool `protobuf:"varint,1,opt,name=ipip_enabled,json=ipipEnabled,proto3" json:"ipip_enabled,omitempty"`
-	VxlanEnabled   bool `protobuf:"varint,2,opt,name=vxlan_enabled,json=vxlanEnabled,proto3" json:"vxlan_enabled,omitempty"`
-	VxlanEnabledV6 bool `protobuf:"varint,3,opt,name=vxlan_enabled_v6,json=vxlanEnabledV6,proto3" json:"vxlan_enabled_v6,omitempty"`
+type WorkloadEndpointStatusRemove struct {
+	Id *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *Encapsulation) Reset()                    { *m = Encapsulation{} }
-func (m *Encapsulation) String() string            { return proto1.CompactTextString(m) }
-func (*Encapsulation) ProtoMessage()               {}
-func (*Encapsulation) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{51} }
+func (m *WorkloadEndpointStatusRemove) Reset()         { *m = WorkloadEndpointStatusRemove{} }
+func (m *WorkloadEndpointStatusRemove) String() string { return proto1.CompactTextString(m) }
+func (*WorkloadEndpointStatusRemove) ProtoMessage()    {}
+func (*WorkloadEndpointStatusRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{41}
+}
 
-func (m *Encapsulation) GetIpipEnabled() bool {
+func (m *WorkloadEndpointStatusRemove) GetId() *WorkloadEndpointID {
 	if m != nil {
-		return m.IpipEnabled
+		return m.Id
 	}
-	return false
+	return nil
 }
 
-func (m *Encapsulation) GetVxlanEnabled() bool {
+type WireguardStatusUpdate struct {
+	// Wireguard public-key set on the interface.
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The IP version of this update
+	IpVersion IPVersion `protobuf:"varint,2,opt,name=ip_version,json=ipVersion,proto3,enum=felix.IPVersion" json:"ip_version,omitempty"`
+}
+
+func (m *WireguardStatusUpdate) Reset()         { *m = WireguardStatusUpdate{} }
+func (m *WireguardStatusUpdate) String() string { return proto1.CompactTextString(m) }
+func (*WireguardStatusUpdate) ProtoMessage()    {}
+func (*WireguardStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{42}
+}
+
+func (m *WireguardStatusUpdate) GetPublicKey() string {
 	if m != nil {
-		return m.VxlanEnabled
+		return m.PublicKey
 	}
-	return false
+	return ""
 }
 
-func (m *Encapsulation) GetVxlanEnabledV6() bool {
+func (m *WireguardStatusUpdate) GetIpVersion() IPVersion {
 	if m != nil {
-		return m.VxlanEnabledV6
+		return m.IpVersion
 	}
-	return false
+	return IPVersion_ANY
 }
 
-type ServiceAccountUpdate struct {
-	Id     *ServiceAccountID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type DataplaneInSync struct {
 }
 
-func (m *ServiceAccountUpdate) Reset()         { *m = ServiceAccountUpdate{} }
-func (m *ServiceAccountUpdate) String() string { return proto1.CompactTextString(m) }
-func (*ServiceAccountUpdate) ProtoMessage()    {}
-func (*ServiceAccountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{52}
+func (m *DataplaneInSync) Reset()                    { *m = DataplaneInSync{} }
+func (m *DataplaneInSync) String() string            { return proto1.CompactTextString(m) }
+func (*DataplaneInSync) ProtoMessage()               {}
+func (*DataplaneInSync) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{43} }
+
+type HostMetadataV4V6Update struct {
+	Hostname string            `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv4Addr string            `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
+	Ipv6Addr string            `protobuf:"bytes,3,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+	Asnumber string            `protobuf:"bytes,4,opt,name=asnumber,proto3" json:"asnumber,omitempty"`
+	Labels   map[string]string `protobuf:"bytes,5,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *ServiceAccountUpdate) GetId() *ServiceAccountID {
+func (m *HostMetadataV4V6Update) Reset()         { *m = HostMetadataV4V6Update{} }
+func (m *HostMetadataV4V6Update) String() string { return proto1.CompactTextString(m) }
+func (*HostMetadataV4V6Update) ProtoMessage()    {}
+func (*HostMetadataV4V6Update) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{44}
+}
+
+func (m *HostMetadataV4V6Update) GetHostname() string {
 	if m != nil {
-		return m.Id
+		return m.Hostname
 	}
-	return nil
+	return ""
 }
 
-func (m *ServiceAccountUpdate) GetLabels() map[string]string {
+func (m *HostMetadataV4V6Update) GetIpv4Addr() string {
 	if m != nil {
-		return m.Labels
+		return m.Ipv4Addr
 	}
-	return nil
+	return ""
 }
 
-type ServiceAccountRemove struct {
-	Id *ServiceAccountID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+func (m *HostMetadataV4V6Update) GetIpv6Addr() string {
+	if m != nil {
+		return m.Ipv6Addr
+	}
+	return ""
 }
 
-func (m *ServiceAccountRemove) Reset()         { *m = ServiceAccountRemove{} }
-func (m *ServiceAccountRemove) String() string { return proto1.CompactTextString(m) }
-func (*ServiceAccountRemove) ProtoMessage()    {}
-func (*ServiceAccountRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{53}
+func (m *HostMetadataV4V6Update) GetAsnumber() string {
+	if m != nil {
+		return m.Asnumber
+	}
+	return ""
 }
 
-func (m *ServiceAccountRemove) GetId() *ServiceAccountID {
+func (m *HostMetadataV4V6Update) GetLabels() map[string]string {
 	if m != nil {
-		return m.Id
+		return m.Labels
 	}
 	return nil
 }
 
-type ServiceAccountID struct {
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type HostMetadataV4V6Remove struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
 }
 
-func (m *ServiceAccountID) Reset()                    { *m = ServiceAccountID{} }
-func (m *ServiceAccountID) String() string            { return proto1.CompactTextString(m) }
-func (*ServiceAccountID) ProtoMessage()               {}
-func (*ServiceAccountID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{54} }
+func (m *HostMetadataV4V6Remove) Reset()         { *m = HostMetadataV4V6Remove{} }
+func (m *HostMetadataV4V6Remove) String() string { return proto1.CompactTextString(m) }
+func (*HostMetadataV4V6Remove) ProtoMessage()    {}
+func (*HostMetadataV4V6Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{45}
+}
 
-func (m *ServiceAccountID) GetNamespace() string {
+func (m *HostMetadataV4V6Remove) GetHostname() string {
 	if m != nil {
-		return m.Namespace
+		return m.Hostname
 	}
 	return ""
 }
 
-func (m *ServiceAccountID) GetName() string {
+func (m *HostMetadataV4V6Remove) GetIpv4Addr() string {
 	if m != nil {
-		return m.Name
+		return m.Ipv4Addr
 	}
 	return ""
 }
 
-type NamespaceUpdate struct {
-	Id     *NamespaceID      `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type HostMetadataUpdate struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
 }
 
-func (m *NamespaceUpdate) Reset()                    { *m = NamespaceUpdate{} }
-func (m *NamespaceUpdate) String() string            { return proto1.CompactTextString(m) }
-func (*NamespaceUpdate) ProtoMessage()               {}
-func (*NamespaceUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{55} }
+func (m *HostMetadataUpdate) Reset()                    { *m = HostMetadataUpdate{} }
+func (m *HostMetadataUpdate) String() string            { return proto1.CompactTextString(m) }
+func (*HostMetadataUpdate) ProtoMessage()               {}
+func (*HostMetadataUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{46} }
 
-func (m *NamespaceUpdate) GetId() *NamespaceID {
+func (m *HostMetadataUpdate) GetHostname() string {
 	if m != nil {
-		return m.Id
+		return m.Hostname
 	}
-	return nil
+	return ""
 }
 
-func (m *NamespaceUpdate) GetLabels() map[string]string {
+func (m *HostMetadataUpdate) GetIpv4Addr() string {
 	if m != nil {
-		return m.Labels
+		return m.Ipv4Addr
 	}
-	return nil
+	return ""
 }
 
-type NamespaceRemove struct {
-	Id *NamespaceID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+type HostMetadataRemove struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
 }
 
-func (m *NamespaceRemove) Reset()                    { *m = NamespaceRemove{} }
-func (m *NamespaceRemove) String() string            { return proto1.CompactTextString(m) }
-func (*NamespaceRemove) ProtoMessage()               {}
-func (*NamespaceRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{56} }
+func (m *HostMetadataRemove) Reset()                    { *m = HostMetadataRemove{} }
+func (m *HostMetadataRemove) String() string            { return proto1.CompactTextString(m) }
+func (*HostMetadataRemove) ProtoMessage()               {}
+func (*HostMetadataRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{47} }
 
-func (m *NamespaceRemove) GetId() *NamespaceID {
+func (m *HostMetadataRemove) GetHostname() string {
 	if m != nil {
-		return m.Id
+		return m.Hostname
 	}
-	return nil
-}
-
-type NamespaceID struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	return ""
 }
 
-func (m *NamespaceID) Reset()                    { *m = NamespaceID{} }
-func (m *NamespaceID) String() string            { return proto1.CompactTextString(m) }
-func (*NamespaceID) ProtoMessage()               {}
-func (*NamespaceID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{57} }
-
-func (m *NamespaceID) GetName() string {
+func (m *HostMetadataRemove) GetIpv4Addr() string {
 	if m != nil {
-		return m.Name
+		return m.Ipv4Addr
 	}
 	return ""
 }
 
-type TunnelType struct {
-	Ipip      bool `protobuf:"varint,1,opt,name=ipip,proto3" json:"ipip,omitempty"`
-	Vxlan     bool `protobuf:"varint,2,opt,name=vxlan,proto3" json:"vxlan,omitempty"`
-	Wireguard bool `protobuf:"varint,3,opt,name=wireguard,proto3" json:"wireguard,omitempty"`
+type HostMetadataV6Update struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv6Addr string `protobuf:"bytes,2,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
 }
 
-func (m *TunnelType) Reset()                    { *m = TunnelType{} }
-func (m *TunnelType) String() string            { return proto1.CompactTextString(m) }
-func (*TunnelType) ProtoMessage()               {}
-func (*TunnelType) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{58} }
+func (m *HostMetadataV6Update) Reset()         { *m = HostMetadataV6Update{} }
+func (m *HostMetadataV6Update) String() string { return proto1.CompactTextString(m) }
+func (*HostMetadataV6Update) ProtoMessage()    {}
+func (*HostMetadataV6Update) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{48}
+}
 
-func (m *TunnelType) GetIpip() bool {
+func (m *HostMetadataV6Update) GetHostname() string {
 	if m != nil {
-		return m.Ipip
+		return m.Hostname
 	}
-	return false
+	return ""
 }
 
-func (m *TunnelType) GetVxlan() bool {
+func (m *HostMetadataV6Update) GetIpv6Addr() string {
 	if m != nil {
-		return m.Vxlan
+		return m.Ipv6Addr
 	}
-	return false
+	return ""
 }
 
-func (m *TunnelType) GetWireguard() bool {
-	if m != nil {
-		return m.Wireguard
-	}
-	return false
+type HostMetadataV6Remove struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv6Addr string `protobuf:"bytes,2,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
 }
 
-type RouteUpdate struct {
-	Type       RouteType  `protobuf:"varint,1,opt,name=type,proto3,enum=felix.RouteType" json:"type,omitempty"`
-	IpPoolType IPPoolType `protobuf:"varint,2,opt,name=ip_pool_type,json=ipPoolType,proto3,enum=felix.IPPoolType" json:"ip_pool_type,omitempty"`
-	Dst        string     `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
-	// The name of the node holding this destination, if this route targets a calico node.
-	DstNodeName string `protobuf:"bytes,4,opt,name=dst_node_name,json=dstNodeName,proto3" json:"dst_node_name,omitempty"`
-	// IP of the node holding this destination.
-	DstNodeIp     string      `protobuf:"bytes,5,opt,name=dst_node_ip,json=dstNodeIp,proto3" json:"dst_node_ip,omitempty"`
-	SameSubnet    bool        `protobuf:"varint,7,opt,name=same_subnet,json=sameSubnet,proto3" json:"same_subnet,omitempty"`
-	NatOutgoing   bool        `protobuf:"varint,8,opt,name=nat_outgoing,json=natOutgoing,proto3" json:"nat_outgoing,omitempty"`
-	LocalWorkload bool        `protobuf:"varint,9,opt,name=local_workload,json=localWorkload,proto3" json:"local_workload,omitempty"`
-	TunnelType    *TunnelType `protobuf:"bytes,10,opt,name=tunnel_type,json=tunnelType" json:"tunnel_type,omitempty"`
+func (m *HostMetadataV6Remove) Reset()         { *m = HostMetadataV6Remove{} }
+func (m *HostMetadataV6Remove) String() string { return proto1.CompactTextString(m) }
+func (*HostMetadataV6Remove) ProtoMessage()    {}
+func (*HostMetadataV6Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{49}
 }
 
-func (m *RouteUpdate) Reset()                    { *m = RouteUpdate{} }
-func (m *RouteUpdate) String() string            { return proto1.CompactTextString(m) }
-func (*RouteUpdate) ProtoMessage()               {}
-func (*RouteUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{59} }
-
-func (m *RouteUpdate) GetType() RouteType {
+func (m *HostMetadataV6Remove) GetHostname() string {
 	if m != nil {
-		return m.Type
+		return m.Hostname
 	}
-	return RouteType_CIDR_INFO
+	return ""
 }
 
-func (m *RouteUpdate) GetIpPoolType() IPPoolType {
+func (m *HostMetadataV6Remove) GetIpv6Addr() string {
 	if m != nil {
-		return m.IpPoolType
+		return m.Ipv6Addr
 	}
-	return IPPoolType_NONE
+	return ""
 }
 
-func (m *RouteUpdate) GetDst() string {
+type IPAMPoolUpdate struct {
+	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pool *IPAMPool `protobuf:"bytes,2,opt,name=pool" json:"pool,omitempty"`
+}
+
+func (m *IPAMPoolUpdate) Reset()                    { *m = IPAMPoolUpdate{} }
+func (m *IPAMPoolUpdate) String() string            { return proto1.CompactTextString(m) }
+func (*IPAMPoolUpdate) ProtoMessage()               {}
+func (*IPAMPoolUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{50} }
+
+func (m *IPAMPoolUpdate) GetId() string {
 	if m != nil {
-		return m.Dst
+		return m.Id
 	}
 	return ""
 }
 
-func (m *RouteUpdate) GetDstNodeName() string {
+func (m *IPAMPoolUpdate) GetPool() *IPAMPool {
 	if m != nil {
-		return m.DstNodeName
+		return m.Pool
 	}
-	return ""
+	return nil
 }
 
-func (m *RouteUpdate) GetDstNodeIp() string {
+type IPAMPoolRemove struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *IPAMPoolRemove) Reset()                    { *m = IPAMPoolRemove{} }
+func (m *IPAMPoolRemove) String() string            { return proto1.CompactTextString(m) }
+func (*IPAMPoolRemove) ProtoMessage()               {}
+func (*IPAMPoolRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{51} }
+
+func (m *IPAMPoolRemove) GetId() string {
 	if m != nil {
-		return m.DstNodeIp
+		return m.Id
 	}
 	return ""
 }
 
-func (m *RouteUpdate) GetSameSubnet() bool {
-	if m != nil {
-		return m.SameSubnet
-	}
-	return false
+type IPAMPool struct {
+	Cidr       string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Masquerade bool   `protobuf:"varint,2,opt,name=masquerade,proto3" json:"masquerade,omitempty"`
+	IpipMode   string `protobuf:"bytes,3,opt,name=ipip_mode,json=ipipMode,proto3" json:"ipip_mode,omitempty"`
+	VxlanMode  string `protobuf:"bytes,4,opt,name=vxlan_mode,json=vxlanMode,proto3" json:"vxlan_mode,omitempty"`
+	// Name of the IP pool resource.
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *RouteUpdate) GetNatOutgoing() bool {
+func (m *IPAMPool) Reset()                    { *m = IPAMPool{} }
+func (m *IPAMPool) String() string            { return proto1.CompactTextString(m) }
+func (*IPAMPool) ProtoMessage()               {}
+func (*IPAMPool) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{52} }
+
+func (m *IPAMPool) GetCidr() string {
 	if m != nil {
-		return m.NatOutgoing
+		return m.Cidr
 	}
-	return false
+	return ""
 }
 
-func (m *RouteUpdate) GetLocalWorkload() bool {
+func (m *IPAMPool) GetMasquerade() bool {
 	if m != nil {
-		return m.LocalWorkload
+		return m.Masquerade
 	}
 	return false
 }
 
-func (m *RouteUpdate) GetTunnelType() *TunnelType {
+func (m *IPAMPool) GetIpipMode() string {
 	if m != nil {
-		return m.TunnelType
+		return m.IpipMode
 	}
-	return nil
+	return ""
 }
 
-type RouteRemove struct {
-	Dst string `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+func (m *IPAMPool) GetVxlanMode() string {
+	if m != nil {
+		return m.VxlanMode
+	}
+	return ""
 }
 
-func (m *RouteRemove) Reset()                    { *m = RouteRemove{} }
-func (m *RouteRemove) String() string            { return proto1.CompactTextString(m) }
-func (*RouteRemove) ProtoMessage()               {}
-func (*RouteRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{60} }
-
-func (m *RouteRemove) GetDst() string {
+func (m *IPAMPool) GetName() string {
 	if m != nil {
-		return m.Dst
+		return m.Name
 	}
 	return ""
 }
 
-type VXLANTunnelEndpointUpdate struct {
-	Node             string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Mac              string `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`
-	Ipv4Addr         string `protobuf:"bytes,3,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
-	ParentDeviceIp   string `protobuf:"bytes,4,opt,name=parent_device_ip,json=parentDeviceIp,proto3" json:"parent_device_ip,omitempty"`
-	MacV6            string `protobuf:"bytes,5,opt,name=mac_v6,json=macV6,proto3" json:"mac_v6,omitempty"`
-	Ipv6Addr         string `protobuf:"bytes,6,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
-	ParentDeviceIpv6 string `protobuf:"bytes,7,opt,name=parent_device_ipv6,json=parentDeviceIpv6,proto3" json:"parent_device_ipv6,omitempty"`
+type Encapsulation struct {
+	IpipEnabled    bool `protobuf:"varint,1,opt,name=ipip_enabled,json=ipipEnabled,proto3" json:"ipip_enabled,omitempty"`
+	VxlanEnabled   bool `protobuf:"varint,2,opt,name=vxlan_enabled,json=vxlanEnabled,proto3" json:"vxlan_enabled,omitempty"`
+	VxlanEnabledV6 bool `protobuf:"varint,3,opt,name=vxlan_enabled_v6,json=vxlanEnabledV6,proto3" json:"vxlan_enabled_v6,omitempty"`
 }
 
-func (m *VXLANTunnelEndpointUpdate) Reset()         { *m = VXLANTunnelEndpointUpdate{} }
-func (m *VXLANTunnelEndpointUpdate) String() string { return proto1.CompactTextString(m) }
-func (*VXLANTunnelEndpointUpdate) ProtoMessage()    {}
-func (*VXLANTunnelEndpointUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{61}
-}
+func (m *Encapsulation) Reset()                    { *m = Encapsulation{} }
+func (m *Encapsulation) String() string            { return proto1.CompactTextString(m) }
+func (*Encapsulation) ProtoMessage()               {}
+func (*Encapsulation) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{53} }
 
-func (m *VXLANTunnelEndpointUpdate) GetNode() string {
+func (m *Encapsulation) GetIpipEnabled() bool {
 	if m != nil {
-		return m.Node
+		return m.IpipEnabled
 	}
-	return ""
+	return false
 }
 
-func (m *VXLANTunnelEndpointUpdate) GetMac() string {
+func (m *Encapsulation) GetVxlanEnabled() bool {
 	if m != nil {
-		return m.Mac
+		return m.VxlanEnabled
 	}
-	return ""
+	return false
 }
 
-func (m *VXLANTunnelEndpointUpdate) GetIpv4Addr() string {
+func (m *Encapsulation) GetVxlanEnabledV6() bool {
 	if m != nil {
-		return m.Ipv4Addr
+		return m.VxlanEnabledV6
 	}
-	return ""
+	return false
 }
 
-func (m *VXLANTunnelEndpointUpdate) GetParentDeviceIp() string {
-	if m != nil {
-		return m.ParentDeviceIp
-	}
-	return ""
+type ServiceAccountUpdate struct {
+	Id     *ServiceAccountID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *VXLANTunnelEndpointUpdate) GetMacV6() string {
-	if m != nil {
-		return m.MacV6
-	}
-	return ""
+func (m *ServiceAccountUpdate) Reset()         { *m = ServiceAccountUpdate{} }
+func (m *ServiceAccountUpdate) String() string { return proto1.CompactTextString(m) }
+func (*ServiceAccountUpdate) ProtoMessage()    {}
+func (*ServiceAccountUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{54}
 }
 
-func (m *VXLANTunnelEndpointUpdate) GetIpv6Addr() string {
+func (m *ServiceAccountUpdate) GetId() *ServiceAccountID {
 	if m != nil {
-		return m.Ipv6Addr
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *VXLANTunnelEndpointUpdate) GetParentDeviceIpv6() string {
+func (m *ServiceAccountUpdate) GetLabels() map[string]string {
 	if m != nil {
-		return m.ParentDeviceIpv6
+		return m.Labels
 	}
-	return ""
+	return nil
 }
 
-type VXLANTunnelEndpointRemove struct {
-	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+type ServiceAccountRemove struct {
+	Id *ServiceAccountID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *VXLANTunnelEndpointRemove) Reset()         { *m = VXLANTunnelEndpointRemove{} }
-func (m *VXLANTunnelEndpointRemove) String() string { return proto1.CompactTextString(m) }
-func (*VXLANTunnelEndpointRemove) ProtoMessage()    {}
-func (*VXLANTunnelEndpointRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{62}
+func (m *ServiceAccountRemove) Reset()         { *m = ServiceAccountRemove{} }
+func (m *ServiceAccountRemove) String() string { return proto1.CompactTextString(m) }
+func (*ServiceAccountRemove) ProtoMessage()    {}
+func (*ServiceAccountRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{55}
 }
 
-func (m *VXLANTunnelEndpointRemove) GetNode() string {
+func (m *ServiceAccountRemove) GetId() *ServiceAccountID {
 	if m != nil {
-		return m.Node
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-type WireguardEndpointUpdate struct {
-	// The name of the IPv4 wireguard host.
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	// The public key for IPv4 wireguard on this endpoint.
-	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	// The IP address of the IPv4 wireguard interface.
-	InterfaceIpv4Addr string `protobuf:"bytes,3,opt,name=interface_ipv4_addr,json=interfaceIpv4Addr,proto3" json:"interface_ipv4_addr,omitempty"`
+type ServiceAccountID struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *WireguardEndpointUpdate) Reset()         { *m = WireguardEndpointUpdate{} }
-func (m *WireguardEndpointUpdate) String() string { return proto1.CompactTextString(m) }
-func (*WireguardEndpointUpdate) ProtoMessage()    {}
-func (*WireguardEndpointUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{63}
-}
+func (m *ServiceAccountID) Reset()                    { *m = ServiceAccountID{} }
+func (m *ServiceAccountID) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceAccountID) ProtoMessage()               {}
+func (*ServiceAccountID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{56} }
 
-func (m *WireguardEndpointUpdate) GetHostname() string {
+func (m *ServiceAccountID) GetNamespace() string {
 	if m != nil {
-		return m.Hostname
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *WireguardEndpointUpdate) GetPublicKey() string {
+func (m *ServiceAccountID) GetName() string {
 	if m != nil {
-		return m.PublicKey
+		return m.Name
 	}
 	return ""
 }
 
-func (m *WireguardEndpointUpdate) GetInterfaceIpv4Addr() string {
-	if m != nil {
-		return m.InterfaceIpv4Addr
-	}
-	return ""
+type NamespaceUpdate struct {
+	Id     *NamespaceID      `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-type WireguardEndpointRemove struct {
-	// The name of the IPv4 wireguard host.
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-}
+func (m *NamespaceUpdate) Reset()                    { *m = NamespaceUpdate{} }
+func (m *NamespaceUpdate) String() string            { return proto1.CompactTextString(m) }
+func (*NamespaceUpdate) ProtoMessage()               {}
+func (*NamespaceUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{57} }
 
-func (m *WireguardEndpointRemove) Reset()         { *m = WireguardEndpointRemove{} }
-func (m *WireguardEndpointRemove) String() string { return proto1.CompactTextString(m) }
-func (*WireguardEndpointRemove) ProtoMessage()    {}
-func (*WireguardEndpointRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{64}
+func (m *NamespaceUpdate) GetId() *NamespaceID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
 }
 
-func (m *WireguardEndpointRemove) GetHostname() string {
+func (m *NamespaceUpdate) GetLabels() map[string]string {
 	if m != nil {
-		return m.Hostname
+		return m.Labels
 	}
-	return ""
+	return nil
 }
 
-type WireguardEndpointV6Update struct {
-	// The name of the IPv6 wireguard host.
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	// The public key for IPv6 wireguard on this endpoint.
-	PublicKeyV6 string `protobuf:"bytes,2,opt,name=public_key_v6,json=publicKeyV6,proto3" json:"public_key_v6,omitempty"`
-	// The IP address of the IPv6 wireguard interface.
-	InterfaceIpv6Addr string `protobuf:"bytes,3,opt,name=interface_ipv6_addr,json=interfaceIpv6Addr,proto3" json:"interface_ipv6_addr,omitempty"`
+type NamespaceRemove struct {
+	Id *NamespaceID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *WireguardEndpointV6Update) Reset()         { *m = WireguardEndpointV6Update{} }
-func (m *WireguardEndpointV6Update) String() string { return proto1.CompactTextString(m) }
-func (*WireguardEndpointV6Update) ProtoMessage()    {}
-func (*WireguardEndpointV6Update) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{65}
+func (m *NamespaceRemove) Reset()                    { *m = NamespaceRemove{} }
+func (m *NamespaceRemove) String() string            { return proto1.CompactTextString(m) }
+func (*NamespaceRemove) ProtoMessage()               {}
+func (*NamespaceRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{58} }
+
+func (m *NamespaceRemove) GetId() *NamespaceID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
 }
 
-func (m *WireguardEndpointV6Update) GetHostname() string {
+type NamespaceID struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *NamespaceID) Reset()                    { *m = NamespaceID{} }
+func (m *NamespaceID) String() string            { return proto1.CompactTextString(m) }
+func (*NamespaceID) ProtoMessage()               {}
+func (*NamespaceID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{59} }
+
+func (m *NamespaceID) GetName() string {
 	if m != nil {
-		return m.Hostname
+		return m.Name
 	}
 	return ""
 }
 
-func (m *WireguardEndpointV6Update) GetPublicKeyV6() string {
+type TunnelType struct {
+	Ipip      bool `protobuf:"varint,1,opt,name=ipip,proto3" json:"ipip,omitempty"`
+	Vxlan     bool `protobuf:"varint,2,opt,name=vxlan,proto3" json:"vxlan,omitempty"`
+	Wireguard bool `protobuf:"varint,3,opt,name=wireguard,proto3" json:"wireguard,omitempty"`
+}
+
+func (m *TunnelType) Reset()                    { *m = TunnelType{} }
+func (m *TunnelType) String() string            { return proto1.CompactTextString(m) }
+func (*TunnelType) ProtoMessage()               {}
+func (*TunnelType) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{60} }
+
+func (m *TunnelType) GetIpip() bool {
 	if m != nil {
-		return m.PublicKeyV6
+		return m.Ipip
 	}
-	return ""
+	return false
 }
 
-func (m *WireguardEndpointV6Update) GetInterfaceIpv6Addr() string {
+func (m *TunnelType) GetVxlan() bool {
 	if m != nil {
-		return m.InterfaceIpv6Addr
+		return m.Vxlan
 	}
-	return ""
+	return false
 }
 
-type WireguardEndpointV6Remove struct {
-	// The name of the IPv6 wireguard host.
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+func (m *TunnelType) GetWireguard() bool {
+	if m != nil {
+		return m.Wireguard
+	}
+	return false
 }
 
-func (m *WireguardEndpointV6Remove) Reset()         { *m = WireguardEndpointV6Remove{} }
-func (m *WireguardEndpointV6Remove) String() string { return proto1.CompactTextString(m) }
-func (*WireguardEndpointV6Remove) ProtoMessage()    {}
-func (*WireguardEndpointV6Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{66}
+type RouteUpdate struct {
+	Type       RouteType  `protobuf:"varint,1,opt,name=type,proto3,enum=felix.RouteType" json:"type,omitempty"`
+	IpPoolType IPPoolType `protobuf:"varint,2,opt,name=ip_pool_type,json=ipPoolType,proto3,enum=felix.IPPoolType" json:"ip_pool_type,omitempty"`
+	Dst        string     `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	// The name of the node holding this destination, if this route targets a calico node.
+	DstNodeName string `protobuf:"bytes,4,opt,name=dst_node_name,json=dstNodeName,proto3" json:"dst_node_name,omitempty"`
+	// IP of the node holding this destination.
+	DstNodeIp     string      `protobuf:"bytes,5,opt,name=dst_node_ip,json=dstNodeIp,proto3" json:"dst_node_ip,omitempty"`
+	SameSubnet    bool        `protobuf:"varint,7,opt,name=same_subnet,json=sameSubnet,proto3" json:"same_subnet,omitempty"`
+	NatOutgoing   bool        `protobuf:"varint,8,opt,name=nat_outgoing,json=natOutgoing,proto3" json:"nat_outgoing,omitempty"`
+	LocalWorkload bool        `protobuf:"varint,9,opt,name=local_workload,json=localWorkload,proto3" json:"local_workload,omitempty"`
+	TunnelType    *TunnelType `protobuf:"bytes,10,opt,name=tunnel_type,json=tunnelType" json:"tunnel_type,omitempty"`
 }
 
-func (m *WireguardEndpointV6Remove) GetHostname() string {
+func (m *RouteUpdate) Reset()                    { *m = RouteUpdate{} }
+func (m *RouteUpdate) String() string            { return proto1.CompactTextString(m) }
+func (*RouteUpdate) ProtoMessage()               {}
+func (*RouteUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{61} }
+
+func (m *RouteUpdate) GetType() RouteType {
 	if m != nil {
-		return m.Hostname
+		return m.Type
 	}
-	return ""
+	return RouteType_CIDR_INFO
 }
 
-type GlobalBGPConfigUpdate struct {
-	ServiceClusterCidrs      []string `protobuf:"bytes,1,rep,name=service_cluster_cidrs,json=serviceClusterCidrs" json:"service_cluster_cidrs,omitempty"`
-	ServiceExternalCidrs     []string `protobuf:"bytes,2,rep,name=service_external_cidrs,json=serviceExternalCidrs" json:"service_external_cidrs,omitempty"`
-	ServiceLoadbalancerCidrs []string `protobuf:"bytes,3,rep,name=service_loadbalancer_cidrs,json=serviceLoadbalancerCidrs" json:"service_loadbalancer_cidrs,omitempty"`
+func (m *RouteUpdate) GetIpPoolType() IPPoolType {
+	if m != nil {
+		return m.IpPoolType
+	}
+	return IPPoolType_NONE
 }
 
-func (m *GlobalBGPConfigUpdate) Reset()         { *m = GlobalBGPConfigUpdate{} }
-func (m *GlobalBGPConfigUpdate) String() string { return proto1.CompactTextString(m) }
-func (*GlobalBGPConfigUpdate) ProtoMessage()    {}
-func (*GlobalBGPConfigUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptorFelixbackend, []int{67}
+func (m *RouteUpdate) GetDst() string {
+	if m != nil {
+		return m.Dst
+	}
+	return ""
 }
 
-func (m *GlobalBGPConfigUpdate) GetServiceClusterCidrs() []string {
+func (m *RouteUpdate) GetDstNodeName() string {
 	if m != nil {
-		return m.ServiceClusterCidrs
+		return m.DstNodeName
 	}
-	return nil
+	return ""
 }
 
-func (m *GlobalBGPConfigUpdate) GetServiceExternalCidrs() []string {
+func (m *RouteUpdate) GetDstNodeIp() string {
 	if m != nil {
-		return m.ServiceExternalCidrs
+		return m.DstNodeIp
 	}
-	return nil
+	return ""
 }
 
-func (m *GlobalBGPConfigUpdate) GetServiceLoadbalancerCidrs() []string {
+func (m *RouteUpdate) GetSameSubnet() bool {
 	if m != nil {
-		return m.ServiceLoadbalancerCidrs
+		return m.SameSubnet
 	}
-	return nil
+	return false
 }
 
-type ServicePort struct {
-	Protocol string `protobuf:"bytes,1,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	Port     int32  `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
-	NodePort int32  `protobuf:"varint,3,opt,name=NodePort,proto3" json:"NodePort,omitempty"`
+func (m *RouteUpdate) GetNatOutgoing() bool {
+	if m != nil {
+		return m.NatOutgoing
+	}
+	return false
 }
 
-func (m *ServicePort) Reset()                    { *m = ServicePort{} }
-func (m *ServicePort) String() string            { return proto1.CompactTextString(m) }
-func (*ServicePort) ProtoMessage()               {}
-func (*ServicePort) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{68} }
-
-func (m *ServicePort) GetProtocol() string {
+func (m *RouteUpdate) GetLocalWorkload() bool {
 	if m != nil {
-		return m.Protocol
+		return m.LocalWorkload
 	}
-	return ""
+	return false
 }
 
-func (m *ServicePort) GetPort() int32 {
+func (m *RouteUpdate) GetTunnelType() *TunnelType {
 	if m != nil {
-		return m.Port
+		return m.TunnelType
 	}
-	return 0
+	return nil
 }
 
-func (m *ServicePort) GetNodePort() int32 {
+type RouteRemove struct {
+	Dst string `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+}
+
+func (m *RouteRemove) Reset()                    { *m = RouteRemove{} }
+func (m *RouteRemove) String() string            { return proto1.CompactTextString(m) }
+func (*RouteRemove) ProtoMessage()               {}
+func (*RouteRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{62} }
+
+func (m *RouteRemove) GetDst() string {
 	if m != nil {
-		return m.NodePort
+		return m.Dst
 	}
-	return 0
+	return ""
 }
 
-type ServiceUpdate struct {
-	Name           string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace      string         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Type           string         `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	ClusterIp      string         `protobuf:"bytes,4,opt,name=cluster_ip,json=clusterIp,proto3" json:"cluster_ip,omitempty"`
-	LoadbalancerIp string         `protobuf:"bytes,5,opt,name=loadbalancer_ip,json=loadbalancerIp,proto3" json:"loadbalancer_ip,omitempty"`
-	ExternalIps    []string       `protobuf:"bytes,6,rep,name=external_ips,json=externalIps" json:"external_ips,omitempty"`
-	Ports          []*ServicePort `protobuf:"bytes,7,rep,name=ports" json:"ports,omitempty"`
+type VXLANTunnelEndpointUpdate struct {
+	Node             string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Mac              string `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`
+	Ipv4Addr         string `protobuf:"bytes,3,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
+	ParentDeviceIp   string `protobuf:"bytes,4,opt,name=parent_device_ip,json=parentDeviceIp,proto3" json:"parent_device_ip,omitempty"`
+	MacV6            string `protobuf:"bytes,5,opt,name=mac_v6,json=macV6,proto3" json:"mac_v6,omitempty"`
+	Ipv6Addr         string `protobuf:"bytes,6,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+	ParentDeviceIpv6 string `protobuf:"bytes,7,opt,name=parent_device_ipv6,json=parentDeviceIpv6,proto3" json:"parent_device_ipv6,omitempty"`
 }
 
-func (m *ServiceUpdate) Reset()                    { *m = ServiceUpdate{} }
-func (m *ServiceUpdate) String() string            { return proto1.CompactTextString(m) }
-func (*ServiceUpdate) ProtoMessage()               {}
-func (*ServiceUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{69} }
+func (m *VXLANTunnelEndpointUpdate) Reset()         { *m = VXLANTunnelEndpointUpdate{} }
+func (m *VXLANTunnelEndpointUpdate) String() string { return proto1.CompactTextString(m) }
+func (*VXLANTunnelEndpointUpdate) ProtoMessage()    {}
+func (*VXLANTunnelEndpointUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{63}
+}
 
-func (m *ServiceUpdate) GetName() string {
+func (m *VXLANTunnelEndpointUpdate) GetNode() string {
 	if m != nil {
-		return m.Name
+		return m.Node
 	}
 	return ""
 }
 
-func (m *ServiceUpdate) GetNamespace() string {
+func (m *VXLANTunnelEndpointUpdate) GetMac() string {
 	if m != nil {
-		return m.Namespace
+		return m.Mac
 	}
 	return ""
 }
 
-func (m *ServiceUpdate) GetType() string {
+func (m *VXLANTunnelEndpointUpdate) GetIpv4Addr() string {
 	if m != nil {
-		return m.Type
+		return m.Ipv4Addr
 	}
 	return ""
 }
 
-func (m *ServiceUpdate) GetClusterIp() string {
+func (m *VXLANTunnelEndpointUpdate) GetParentDeviceIp() string {
 	if m != nil {
-		return m.ClusterIp
+		return m.ParentDeviceIp
 	}
 	return ""
 }
 
-func (m *ServiceUpdate) GetLoadbalancerIp() string {
+func (m *VXLANTunnelEndpointUpdate) GetMacV6() string {
 	if m != nil {
-		return m.LoadbalancerIp
+		return m.MacV6
 	}
 	return ""
 }
 
-func (m *ServiceUpdate) GetExternalIps() []string {
+func (m *VXLANTunnelEndpointUpdate) GetIpv6Addr() string {
 	if m != nil {
-		return m.ExternalIps
+		return m.Ipv6Addr
 	}
-	return nil
+	return ""
 }
 
-func (m *ServiceUpdate) GetPorts() []*ServicePort {
+func (m *VXLANTunnelEndpointUpdate) GetParentDeviceIpv6() string {
 	if m != nil {
-		return m.Ports
+		return m.ParentDeviceIpv6
 	}
-	return nil
+	return ""
 }
 
-type ServiceRemove struct {
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+type VXLANTunnelEndpointRemove struct {
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func (m *ServiceRemove) Reset()                    { *m = ServiceRemove{} }
-func (m *ServiceRemove) String() string            { return proto1.CompactTextString(m) }
-func (*ServiceRemove) ProtoMessage()               {}
-func (*ServiceRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{70} }
+func (m *VXLANTunnelEndpointRemove) Reset()         { *m = VXLANTunnelEndpointRemove{} }
+func (m *VXLANTunnelEndpointRemove) String() string { return proto1.CompactTextString(m) }
+func (*VXLANTunnelEndpointRemove) ProtoMessage()    {}
+func (*VXLANTunnelEndpointRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{64}
+}
 
-func (m *ServiceRemove) GetName() string {
+func (m *VXLANTunnelEndpointRemove) GetNode() string {
 	if m != nil {
-		return m.Name
+		return m.Node
 	}
 	return ""
 }
 
-func (m *ServiceRemove) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
+type WireguardEndpointUpdate struct {
+	// The name of the IPv4 wireguard host.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The public key for IPv4 wireguard on this endpoint.
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The IP address of the IPv4 wireguard interface.
+	InterfaceIpv4Addr string `protobuf:"bytes,3,opt,name=interface_ipv4_addr,json=interfaceIpv4Addr,proto3" json:"interface_ipv4_addr,omitempty"`
 }
 
-func init() {
-	proto1.RegisterType((*SyncRequest)(nil), "felix.SyncRequest")
-	proto1.RegisterType((*ToDataplane)(nil), "felix.ToDataplane")
-	proto1.RegisterType((*FromDataplane)(nil), "felix.FromDataplane")
-	proto1.RegisterType((*ConfigUpdate)(nil), "felix.ConfigUpdate")
-	proto1.RegisterType((*RawConfig)(nil), "felix.RawConfig")
-	proto1.RegisterType((*InSync)(nil), "felix.InSync")
-	proto1.RegisterType((*IPSetUpdate)(nil), "felix.IPSetUpdate")
-	proto1.RegisterType((*IPSetDeltaUpdate)(nil), "felix.IPSetDeltaUpdate")
-	proto1.RegisterType((*IPSetRemove)(nil), "felix.IPSetRemove")
-	proto1.RegisterType((*ActiveProfileUpdate)(nil), "felix.ActiveProfileUpdate")
-	proto1.RegisterType((*ActiveProfileRemove)(nil), "felix.ActiveProfileRemove")
-	proto1.RegisterType((*ProfileID)(nil), "felix.ProfileID")
-	proto1.RegisterType((*Profile)(nil), "felix.Profile")
-	proto1.RegisterType((*ActivePolicyUpdate)(nil), "felix.ActivePolicyUpdate")
-	proto1.RegisterType((*ActivePolicyRemove)(nil), "felix.ActivePolicyRemove")
-	proto1.RegisterType((*PolicyID)(nil), "felix.PolicyID")
-	proto1.RegisterType((*Policy)(nil), "felix.Policy")
-	proto1.RegisterType((*Rule)(nil), "felix.Rule")
-	proto1.RegisterType((*ServiceAccountMatch)(nil), "felix.ServiceAccountMatch")
-	proto1.RegisterType((*HTTPMatch)(nil), "felix.HTTPMatch")
-	proto1.RegisterType((*HTTPMatch_PathMatch)(nil), "felix.HTTPMatch.PathMatch")
-	proto1.RegisterType((*RuleMetadata)(nil), "felix.RuleMetadata")
-	proto1.RegisterType((*IcmpTypeAndCode)(nil), "felix.IcmpTypeAndCode")
-	proto1.RegisterType((*Protocol)(nil), "felix.Protocol")
-	proto1.RegisterType((*PortRange)(nil), "felix.PortRange")
-	proto1.RegisterType((*WorkloadEndpointID)(nil), "felix.WorkloadEndpointID")
-	proto1.RegisterType((*WorkloadEndpointUpdate)(nil), "felix.WorkloadEndpointUpdate")
-	proto1.RegisterType((*WorkloadEndpoint)(nil), "felix.WorkloadEndpoint")
-	proto1.RegisterType((*WorkloadEndpointRemove)(nil), "felix.WorkloadEndpointRemove")
-	proto1.RegisterType((*HostEndpointID)(nil), "felix.HostEndpointID")
-	proto1.RegisterType((*HostEndpointUpdate)(nil), "felix.HostEndpointUpdate")
-	proto1.RegisterType((*HostEndpoint)(nil), "felix.HostEndpoint")
-	proto1.RegisterType((*HostEndpointRemove)(nil), "felix.HostEndpointRemove")
-	proto1.RegisterType((*TierInfo)(nil), "felix.TierInfo")
-	proto1.RegisterType((*NatInfo)(nil), "felix.NatInfo")
-	proto1.RegisterType((*ProcessStatusUpdate)(nil), "felix.ProcessStatusUpdate")
-	proto1.RegisterType((*HostEndpointStatusUpdate)(nil), "felix.HostEndpointStatusUpdate")
-	proto1.RegisterType((*EndpointStatus)(nil), "felix.EndpointStatus")
-	proto1.RegisterType((*HostEndpointStatusRemove)(nil), "felix.HostEndpointStatusRemove")
-	proto1.RegisterType((*WorkloadEndpointStatusUpdate)(nil), "felix.WorkloadEndpointStatusUpdate")
-	proto1.RegisterType((*WorkloadEndpointStatusRemove)(nil), "felix.WorkloadEndpointStatusRemove")
-	proto1.RegisterType((*WireguardStatusUpdate)(nil), "felix.WireguardStatusUpdate")
-	proto1.RegisterType((*DataplaneInSync)(nil), "felix.DataplaneInSync")
-	proto1.RegisterType((*HostMetadataV4V6Update)(nil), "felix.HostMetadataV4V6Update")
-	proto1.RegisterType((*HostMetadataV4V6Remove)(nil), "felix.HostMetadataV4V6Remove")
-	proto1.RegisterType((*HostMetadataUpdate)(nil), "felix.HostMetadataUpdate")
-	proto1.RegisterType((*HostMetadataRemove)(nil), "felix.HostMetadataRemove")
-	proto1.RegisterType((*HostMetadataV6Update)(nil), "felix.HostMetadataV6Update")
-	proto1.RegisterType((*HostMetadataV6Remove)(nil), "felix.HostMetadataV6Remove")
-	proto1.RegisterType((*IPAMPoolUpdate)(nil), "felix.IPAMPoolUpdate")
-	proto1.RegisterType((*IPAMPoolRemove)(nil), "felix.IPAMPoolRemove")
-	proto1.RegisterType((*IPAMPool)(nil), "felix.IPAMPool")
-	proto1.RegisterType((*Encapsulation)(nil), "felix.Encapsulation")
-	proto1.RegisterType((*ServiceAccountUpdate)(nil), "felix.ServiceAccountUpdate")
-	proto1.RegisterType((*ServiceAccountRemove)(nil), "felix.ServiceAccountRemove")
-	proto1.RegisterType((*ServiceAccountID)(nil), "felix.ServiceAccountID")
-	proto1.RegisterType((*NamespaceUpdate)(nil), "felix.NamespaceUpdate")
-	proto1.RegisterType((*NamespaceRemove)(nil), "felix.NamespaceRemove")
-	proto1.RegisterType((*NamespaceID)(nil), "felix.NamespaceID")
-	proto1.RegisterType((*TunnelType)(nil), "felix.TunnelType")
-	proto1.RegisterType((*RouteUpdate)(nil), "felix.RouteUpdate")
-	proto1.RegisterType((*RouteRemove)(nil), "felix.RouteRemove")
-	proto1.RegisterType((*VXLANTunnelEndpointUpdate)(nil), "felix.VXLANTunnelEndpointUpdate")
-	proto1.RegisterType((*VXLANTunnelEndpointRemove)(nil), "felix.VXLANTunnelEndpointRemove")
-	proto1.RegisterType((*WireguardEndpointUpdate)(nil), "felix.WireguardEndpointUpdate")
-	proto1.RegisterType((*WireguardEndpointRemove)(nil), "felix.WireguardEndpointRemove")
-	proto1.RegisterType((*WireguardEndpointV6Update)(nil), "felix.WireguardEndpointV6Update")
-	proto1.RegisterType((*WireguardEndpointV6Remove)(nil), "felix.WireguardEndpointV6Remove")
-	proto1.RegisterType((*GlobalBGPConfigUpdate)(nil), "felix.GlobalBGPConfigUpdate")
-	proto1.RegisterType((*ServicePort)(nil), "felix.ServicePort")
-	proto1.RegisterType((*ServiceUpdate)(nil), "felix.ServiceUpdate")
-	proto1.RegisterType((*ServiceRemove)(nil), "felix.ServiceRemove")
-	proto1.RegisterEnum("felix.IPVersion", IPVersion_name, IPVersion_value)
-	proto1.RegisterEnum("felix.RouteType", RouteType_name, RouteType_value)
-	proto1.RegisterEnum("felix.IPPoolType", IPPoolType_name, IPPoolType_value)
-	proto1.RegisterEnum("felix.IPSetUpdate_IPSetType", IPSetUpdate_IPSetType_name, IPSetUpdate_IPSetType_value)
+func (m *WireguardEndpointUpdate) Reset()         { *m = WireguardEndpointUpdate{} }
+func (m *WireguardEndpointUpdate) String() string { return proto1.CompactTextString(m) }
+func (*WireguardEndpointUpdate) ProtoMessage()    {}
+func (*WireguardEndpointUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{65}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *WireguardEndpointUpdate) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
 
-// Client API for PolicySync service
+func (m *WireguardEndpointUpdate) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
 
-type PolicySyncClient interface {
-	// On this API, only the following payloads will be sent:
-	//  - InSync
-	//  - IPSetUpdate
-	//  - IPSetDeltaUpdate
-	//  - IPSetRemove
-	//  - ActiveProfileUpdate
-	//  - ActiveProfileRemove
-	//  - ActivePolicyUpdate
-	//  - ActivePolicyRemove
-	//  - WorkloadEndpointUpdate
-	//  - WorkloadEndpointRemove
-	//  - ServiceAccountUpdate
-	//  - ServiceAccountRemove
-	//  - NamespaceUpdate
-	//  - NamespaceRemove
-	//  - RouteUpdate
-	//  - RouteRemove
-	//  - VXLANTunnelEndpointUpdate
-	//  - VXLANTunnelEndpointRemove
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error)
+func (m *WireguardEndpointUpdate) GetInterfaceIpv4Addr() string {
+	if m != nil {
+		return m.InterfaceIpv4Addr
+	}
+	return ""
 }
 
-type policySyncClient struct {
-	cc *grpc.ClientConn
+type WireguardEndpointRemove struct {
+	// The name of the IPv4 wireguard host.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
 }
 
-func NewPolicySyncClient(cc *grpc.ClientConn) PolicySyncClient {
-	return &policySyncClient{cc}
+func (m *WireguardEndpointRemove) Reset()         { *m = WireguardEndpointRemove{} }
+func (m *WireguardEndpointRemove) String() string { return proto1.CompactTextString(m) }
+func (*WireguardEndpointRemove) ProtoMessage()    {}
+func (*WireguardEndpointRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{66}
 }
 
-func (c *policySyncClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PolicySync_serviceDesc.Streams[0], c.cc, "/felix.PolicySync/Sync", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &policySyncSyncClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *WireguardEndpointRemove) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return x, nil
+	return ""
 }
 
-type PolicySync_SyncClient interface {
-	Recv() (*ToDataplane, error)
-	grpc.ClientStream
+type WireguardEndpointV6Update struct {
+	// The name of the IPv6 wireguard host.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The public key for IPv6 wireguard on this endpoint.
+	PublicKeyV6 string `protobuf:"bytes,2,opt,name=public_key_v6,json=publicKeyV6,proto3" json:"public_key_v6,omitempty"`
+	// The IP address of the IPv6 wireguard interface.
+	InterfaceIpv6Addr string `protobuf:"bytes,3,opt,name=interface_ipv6_addr,json=interfaceIpv6Addr,proto3" json:"interface_ipv6_addr,omitempty"`
 }
 
-type policySyncSyncClient struct {
-	grpc.ClientStream
+func (m *WireguardEndpointV6Update) Reset()         { *m = WireguardEndpointV6Update{} }
+func (m *WireguardEndpointV6Update) String() string { return proto1.CompactTextString(m) }
+func (*WireguardEndpointV6Update) ProtoMessage()    {}
+func (*WireguardEndpointV6Update) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{67}
 }
 
-func (x *policySyncSyncClient) Recv() (*ToDataplane, error) {
-	m := new(ToDataplane)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *WireguardEndpointV6Update) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return m, nil
+	return ""
 }
 
-// Server API for PolicySync service
+func (m *WireguardEndpointV6Update) GetPublicKeyV6() string {
+	if m != nil {
+		return m.PublicKeyV6
+	}
+	return ""
+}
 
-type PolicySyncServer interface {
-	// On this API, only the following payloads will be sent:
-	//  - InSync
-	//  - IPSetUpdate
-	//  - IPSetDeltaUpdate
-	//  - IPSetRemove
-	//  - ActiveProfileUpdate
-	//  - ActiveProfileRemove
-	//  - ActivePolicyUpdate
-	//  - ActivePolicyRemove
-	//  - WorkloadEndpointUpdate
-	//  - WorkloadEndpointRemove
-	//  - ServiceAccountUpdate
-	//  - ServiceAccountRemove
-	//  - NamespaceUpdate
-	//  - NamespaceRemove
-	//  - RouteUpdate
-	//  - RouteRemove
-	//  - VXLANTunnelEndpointUpdate
-	//  - VXLANTunnelEndpointRemove
-	Sync(*SyncRequest, PolicySync_SyncServer) error
+func (m *WireguardEndpointV6Update) GetInterfaceIpv6Addr() string {
+	if m != nil {
+		return m.InterfaceIpv6Addr
+	}
+	return ""
 }
 
-func RegisterPolicySyncServer(s *grpc.Server, srv PolicySyncServer) {
-	s.RegisterService(&_PolicySync_serviceDesc, srv)
+type WireguardEndpointV6Remove struct {
+	// The name of the IPv6 wireguard host.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
 }
 
-func _PolicySync_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SyncRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *WireguardEndpointV6Remove) Reset()         { *m = WireguardEndpointV6Remove{} }
+func (m *WireguardEndpointV6Remove) String() string { return proto1.CompactTextString(m) }
+func (*WireguardEndpointV6Remove) ProtoMessage()    {}
+func (*WireguardEndpointV6Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{68}
+}
+
+func (m *WireguardEndpointV6Remove) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return srv.(PolicySyncServer).Sync(m, &policySyncSyncServer{stream})
+	return ""
 }
 
-type PolicySync_SyncServer interface {
-	Send(*ToDataplane) error
-	grpc.ServerStream
+type GlobalBGPConfigUpdate struct {
+	ServiceClusterCidrs      []string `protobuf:"bytes,1,rep,name=service_cluster_cidrs,json=serviceClusterCidrs" json:"service_cluster_cidrs,omitempty"`
+	ServiceExternalCidrs     []string `protobuf:"bytes,2,rep,name=service_external_cidrs,json=serviceExternalCidrs" json:"service_external_cidrs,omitempty"`
+	ServiceLoadbalancerCidrs []string `protobuf:"bytes,3,rep,name=service_loadbalancer_cidrs,json=serviceLoadbalancerCidrs" json:"service_loadbalancer_cidrs,omitempty"`
 }
 
-type policySyncSyncServer struct {
-	grpc.ServerStream
+func (m *GlobalBGPConfigUpdate) Reset()         { *m = GlobalBGPConfigUpdate{} }
+func (m *GlobalBGPConfigUpdate) String() string { return proto1.CompactTextString(m) }
+func (*GlobalBGPConfigUpdate) ProtoMessage()    {}
+func (*GlobalBGPConfigUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{69}
 }
 
-func (x *policySyncSyncServer) Send(m *ToDataplane) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GlobalBGPConfigUpdate) GetServiceClusterCidrs() []string {
+	if m != nil {
+		return m.ServiceClusterCidrs
+	}
+	return nil
 }
 
-var _PolicySync_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "felix.PolicySync",
-	HandlerType: (*PolicySyncServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Sync",
-			Handler:       _PolicySync_Sync_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "felixbackend.proto",
+func (m *GlobalBGPConfigUpdate) GetServiceExternalCidrs() []string {
+	if m != nil {
+		return m.ServiceExternalCidrs
+	}
+	return nil
 }
 
-func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *GlobalBGPConfigUpdate) GetServiceLoadbalancerCidrs() []string {
+	if m != nil {
+		return m.ServiceLoadbalancerCidrs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type ServicePort struct {
+	Protocol string `protobuf:"bytes,1,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Port     int32  `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	NodePort int32  `protobuf:"varint,3,opt,name=NodePort,proto3" json:"NodePort,omitempty"`
+	Name     string `protobuf:"bytes,4,opt,name=Name,proto3" json:"Name,omitempty"`
 }
 
-func (m *ToDataplane) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ServicePort) Reset()                    { *m = ServicePort{} }
+func (m *ServicePort) String() string            { return proto1.CompactTextString(m) }
+func (*ServicePort) ProtoMessage()               {}
+func (*ServicePort) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{70} }
+
+func (m *ServicePort) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ToDataplane) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		nn1, err := m.Payload.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn1
-	}
-	if m.SequenceNumber != 0 {
-		dAtA[i] = 0x78
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
+func (m *ServicePort) GetPort() int32 {
+	if m != nil {
+		return m.Port
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ToDataplane_InSync) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.InSync != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.InSync.Size()))
-		n2, err := m.InSync.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func (m *ServicePort) GetNodePort() int32 {
+	if m != nil {
+		return m.NodePort
 	}
-	return i, nil
+	return 0
 }
-func (m *ToDataplane_IpsetUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpsetUpdate != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetUpdate.Size()))
-		n3, err := m.IpsetUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+
+func (m *ServicePort) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_IpsetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpsetDeltaUpdate != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetDeltaUpdate.Size()))
-		n4, err := m.IpsetDeltaUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	return i, nil
+
+type ServiceUpdate struct {
+	Name           string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace      string         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type           string         `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ClusterIp      string         `protobuf:"bytes,4,opt,name=cluster_ip,json=clusterIp,proto3" json:"cluster_ip,omitempty"`
+	LoadbalancerIp string         `protobuf:"bytes,5,opt,name=loadbalancer_ip,json=loadbalancerIp,proto3" json:"loadbalancer_ip,omitempty"`
+	ExternalIps    []string       `protobuf:"bytes,6,rep,name=external_ips,json=externalIps" json:"external_ips,omitempty"`
+	Ports          []*ServicePort `protobuf:"bytes,7,rep,name=ports" json:"ports,omitempty"`
 }
-func (m *ToDataplane_IpsetRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpsetRemove != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetRemove.Size()))
-		n5, err := m.IpsetRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+
+func (m *ServiceUpdate) Reset()                    { *m = ServiceUpdate{} }
+func (m *ServiceUpdate) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceUpdate) ProtoMessage()               {}
+func (*ServiceUpdate) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{71} }
+
+func (m *ServiceUpdate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActiveProfileUpdate != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileUpdate.Size()))
-		n6, err := m.ActiveProfileUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+
+func (m *ServiceUpdate) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActiveProfileRemove != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileRemove.Size()))
-		n7, err := m.ActiveProfileRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+
+func (m *ServiceUpdate) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActivePolicyUpdate != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyUpdate.Size()))
-		n8, err := m.ActivePolicyUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+
+func (m *ServiceUpdate) GetClusterIp() string {
+	if m != nil {
+		return m.ClusterIp
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActivePolicyRemove != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyRemove.Size()))
-		n9, err := m.ActivePolicyRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+
+func (m *ServiceUpdate) GetLoadbalancerIp() string {
+	if m != nil {
+		return m.LoadbalancerIp
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostEndpointUpdate != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointUpdate.Size()))
-		n10, err := m.HostEndpointUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+
+func (m *ServiceUpdate) GetExternalIps() []string {
+	if m != nil {
+		return m.ExternalIps
 	}
-	return i, nil
+	return nil
 }
-func (m *ToDataplane_HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostEndpointRemove != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointRemove.Size()))
-		n11, err := m.HostEndpointRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+
+func (m *ServiceUpdate) GetPorts() []*ServicePort {
+	if m != nil {
+		return m.Ports
 	}
-	return i, nil
+	return nil
 }
-func (m *ToDataplane_WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.WorkloadEndpointUpdate != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointUpdate.Size()))
-		n12, err := m.WorkloadEndpointUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
-	}
-	return i, nil
+
+type ServiceRemove struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
-func (m *ToDataplane_WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.WorkloadEndpointRemove != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointRemove.Size()))
-		n13, err := m.WorkloadEndpointRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+
+func (m *ServiceRemove) Reset()                    { *m = ServiceRemove{} }
+func (m *ServiceRemove) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceRemove) ProtoMessage()               {}
+func (*ServiceRemove) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{72} }
+
+func (m *ServiceRemove) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ConfigUpdate != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ConfigUpdate.Size()))
-		n14, err := m.ConfigUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
+
+func (m *ServiceRemove) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_HostMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostMetadataUpdate != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataUpdate.Size()))
-		n15, err := m.HostMetadataUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
-	}
-	return i, nil
+
+func init() {
+	proto1.RegisterType((*SyncRequest)(nil), "felix.SyncRequest")
+	proto1.RegisterType((*ToDataplane)(nil), "felix.ToDataplane")
+	proto1.RegisterType((*FromDataplane)(nil), "felix.FromDataplane")
+	proto1.RegisterType((*ConfigUpdate)(nil), "felix.ConfigUpdate")
+	proto1.RegisterType((*RawConfig)(nil), "felix.RawConfig")
+	proto1.RegisterType((*InSync)(nil), "felix.InSync")
+	proto1.RegisterType((*IPSetUpdate)(nil), "felix.IPSetUpdate")
+	proto1.RegisterType((*IPSetDeltaUpdate)(nil), "felix.IPSetDeltaUpdate")
+	proto1.RegisterType((*IPSetRemove)(nil), "felix.IPSetRemove")
+	proto1.RegisterType((*ActiveProfileUpdate)(nil), "felix.ActiveProfileUpdate")
+	proto1.RegisterType((*ActiveProfileRemove)(nil), "felix.ActiveProfileRemove")
+	proto1.RegisterType((*ProfileID)(nil), "felix.ProfileID")
+	proto1.RegisterType((*Profile)(nil), "felix.Profile")
+	proto1.RegisterType((*ActivePolicyUpdate)(nil), "felix.ActivePolicyUpdate")
+	proto1.RegisterType((*ActivePolicyRemove)(nil), "felix.ActivePolicyRemove")
+	proto1.RegisterType((*PolicyID)(nil), "felix.PolicyID")
+	proto1.RegisterType((*Policy)(nil), "felix.Policy")
+	proto1.RegisterType((*Rule)(nil), "felix.Rule")
+	proto1.RegisterType((*ServiceAccountMatch)(nil), "felix.ServiceAccountMatch")
+	proto1.RegisterType((*HTTPMatch)(nil), "felix.HTTPMatch")
+	proto1.RegisterType((*HTTPMatch_PathMatch)(nil), "felix.HTTPMatch.PathMatch")
+	proto1.RegisterType((*HTTPMatch_QueryParamMatch)(nil), "felix.HTTPMatch.QueryParamMatch")
+	proto1.RegisterType((*HTTPMatch_HeaderMatch)(nil), "felix.HTTPMatch.HeaderMatch")
+	proto1.RegisterType((*GrpcMatch)(nil), "felix.GrpcMatch")
+	proto1.RegisterType((*RuleMetadata)(nil), "felix.RuleMetadata")
+	proto1.RegisterType((*IcmpTypeAndCode)(nil), "felix.IcmpTypeAndCode")
+	proto1.RegisterType((*Protocol)(nil), "felix.Protocol")
+	proto1.RegisterType((*PortRange)(nil), "felix.PortRange")
+	proto1.RegisterType((*WorkloadEndpointID)(nil), "felix.WorkloadEndpointID")
+	proto1.RegisterType((*WorkloadEndpointUpdate)(nil), "felix.WorkloadEndpointUpdate")
+	proto1.RegisterType((*WorkloadEndpoint)(nil), "felix.WorkloadEndpoint")
+	proto1.RegisterType((*EndpointPort)(nil), "felix.EndpointPort")
+	proto1.RegisterType((*WorkloadEndpointRemove)(nil), "felix.WorkloadEndpointRemove")
+	proto1.RegisterType((*HostEndpointID)(nil), "felix.HostEndpointID")
+	proto1.RegisterType((*HostEndpointUpdate)(nil), "felix.HostEndpointUpdate")
+	proto1.RegisterType((*HostEndpoint)(nil), "felix.HostEndpoint")
+	proto1.RegisterType((*HostEndpointRemove)(nil), "felix.HostEndpointRemove")
+	proto1.RegisterType((*TierInfo)(nil), "felix.TierInfo")
+	proto1.RegisterType((*NatInfo)(nil), "felix.NatInfo")
+	proto1.RegisterType((*ProcessStatusUpdate)(nil), "felix.ProcessStatusUpdate")
+	proto1.RegisterType((*HostEndpointStatusUpdate)(nil), "felix.HostEndpointStatusUpdate")
+	proto1.RegisterType((*EndpointStatus)(nil), "felix.EndpointStatus")
+	proto1.RegisterType((*HostEndpointStatusRemove)(nil), "felix.HostEndpointStatusRemove")
+	proto1.RegisterType((*WorkloadEndpointStatusUpdate)(nil), "felix.WorkloadEndpointStatusUpdate")
+	proto1.RegisterType((*WorkloadEndpointStatusRemove)(nil), "felix.WorkloadEndpointStatusRemove")
+	proto1.RegisterType((*WireguardStatusUpdate)(nil), "felix.WireguardStatusUpdate")
+	proto1.RegisterType((*DataplaneInSync)(nil), "felix.DataplaneInSync")
+	proto1.RegisterType((*HostMetadataV4V6Update)(nil), "felix.HostMetadataV4V6Update")
+	proto1.RegisterType((*HostMetadataV4V6Remove)(nil), "felix.HostMetadataV4V6Remove")
+	proto1.RegisterType((*HostMetadataUpdate)(nil), "felix.HostMetadataUpdate")
+	proto1.RegisterType((*HostMetadataRemove)(nil), "felix.HostMetadataRemove")
+	proto1.RegisterType((*HostMetadataV6Update)(nil), "felix.HostMetadataV6Update")
+	proto1.RegisterType((*HostMetadataV6Remove)(nil), "felix.HostMetadataV6Remove")
+	proto1.RegisterType((*IPAMPoolUpdate)(nil), "felix.IPAMPoolUpdate")
+	proto1.RegisterType((*IPAMPoolRemove)(nil), "felix.IPAMPoolRemove")
+	proto1.RegisterType((*IPAMPool)(nil), "felix.IPAMPool")
+	proto1.RegisterType((*Encapsulation)(nil), "felix.Encapsulation")
+	proto1.RegisterType((*ServiceAccountUpdate)(nil), "felix.ServiceAccountUpdate")
+	proto1.RegisterType((*ServiceAccountRemove)(nil), "felix.ServiceAccountRemove")
+	proto1.RegisterType((*ServiceAccountID)(nil), "felix.ServiceAccountID")
+	proto1.RegisterType((*NamespaceUpdate)(nil), "felix.NamespaceUpdate")
+	proto1.RegisterType((*NamespaceRemove)(nil), "felix.NamespaceRemove")
+	proto1.RegisterType((*NamespaceID)(nil), "felix.NamespaceID")
+	proto1.RegisterType((*TunnelType)(nil), "felix.TunnelType")
+	proto1.RegisterType((*RouteUpdate)(nil), "felix.RouteUpdate")
+	proto1.RegisterType((*RouteRemove)(nil), "felix.RouteRemove")
+	proto1.RegisterType((*VXLANTunnelEndpointUpdate)(nil), "felix.VXLANTunnelEndpointUpdate")
+	proto1.RegisterType((*VXLANTunnelEndpointRemove)(nil), "felix.VXLANTunnelEndpointRemove")
+	proto1.RegisterType((*WireguardEndpointUpdate)(nil), "felix.WireguardEndpointUpdate")
+	proto1.RegisterType((*WireguardEndpointRemove)(nil), "felix.WireguardEndpointRemove")
+	proto1.RegisterType((*WireguardEndpointV6Update)(nil), "felix.WireguardEndpointV6Update")
+	proto1.RegisterType((*WireguardEndpointV6Remove)(nil), "felix.WireguardEndpointV6Remove")
+	proto1.RegisterType((*GlobalBGPConfigUpdate)(nil), "felix.GlobalBGPConfigUpdate")
+	proto1.RegisterType((*ServicePort)(nil), "felix.ServicePort")
+	proto1.RegisterType((*ServiceUpdate)(nil), "felix.ServiceUpdate")
+	proto1.RegisterType((*ServiceRemove)(nil), "felix.ServiceRemove")
+	proto1.RegisterEnum("felix.IPVersion", IPVersion_name, IPVersion_value)
+	proto1.RegisterEnum("felix.RouteType", RouteType_name, RouteType_value)
+	proto1.RegisterEnum("felix.IPPoolType", IPPoolType_name, IPPoolType_value)
+	proto1.RegisterEnum("felix.IPSetUpdate_IPSetType", IPSetUpdate_IPSetType_name, IPSetUpdate_IPSetType_value)
 }
-func (m *ToDataplane_IpamPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpamPoolUpdate != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolUpdate.Size()))
-		n16, err := m.IpamPoolUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
-	}
-	return i, nil
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PolicySync service
+
+type PolicySyncClient interface {
+	// On this API, only the following payloads will be sent:
+	//  - InSync
+	//  - IPSetUpdate
+	//  - IPSetDeltaUpdate
+	//  - IPSetRemove
+	//  - ActiveProfileUpdate
+	//  - ActiveProfileRemove
+	//  - ActivePolicyUpdate
+	//  - ActivePolicyRemove
+	//  - WorkloadEndpointUpdate
+	//  - WorkloadEndpointRemove
+	//  - ServiceAccountUpdate
+	//  - ServiceAccountRemove
+	//  - NamespaceUpdate
+	//  - NamespaceRemove
+	//  - RouteUpdate
+	//  - RouteRemove
+	//  - VXLANTunnelEndpointUpdate
+	//  - VXLANTunnelEndpointRemove
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error)
 }
-func (m *ToDataplane_IpamPoolRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpamPoolRemove != nil {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolRemove.Size()))
-		n17, err := m.IpamPoolRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
-	}
-	return i, nil
+
+type policySyncClient struct {
+	cc *grpc.ClientConn
 }
-func (m *ToDataplane_HostMetadataRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostMetadataRemove != nil {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataRemove.Size()))
-		n18, err := m.HostMetadataRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
-	}
-	return i, nil
+
+func NewPolicySyncClient(cc *grpc.ClientConn) PolicySyncClient {
+	return &policySyncClient{cc}
 }
-func (m *ToDataplane_ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ServiceAccountUpdate != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountUpdate.Size()))
-		n19, err := m.ServiceAccountUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
+
+func (c *policySyncClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PolicySync_serviceDesc.Streams[0], c.cc, "/felix.PolicySync/Sync", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	x := &policySyncSyncClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (m *ToDataplane_ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ServiceAccountRemove != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountRemove.Size()))
-		n20, err := m.ServiceAccountRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+
+type PolicySync_SyncClient interface {
+	Recv() (*ToDataplane, error)
+	grpc.ClientStream
+}
+
+type policySyncSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *policySyncSyncClient) Recv() (*ToDataplane, error) {
+	m := new(ToDataplane)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
-func (m *ToDataplane_NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.NamespaceUpdate != nil {
-		dAtA[i] = 0xaa
+
+// Server API for PolicySync service
+
+type PolicySyncServer interface {
+	// On this API, only the following payloads will be sent:
+	//  - InSync
+	//  - IPSetUpdate
+	//  - IPSetDeltaUpdate
+	//  - IPSetRemove
+	//  - ActiveProfileUpdate
+	//  - ActiveProfileRemove
+	//  - ActivePolicyUpdate
+	//  - ActivePolicyRemove
+	//  - WorkloadEndpointUpdate
+	//  - WorkloadEndpointRemove
+	//  - ServiceAccountUpdate
+	//  - ServiceAccountRemove
+	//  - NamespaceUpdate
+	//  - NamespaceRemove
+	//  - RouteUpdate
+	//  - RouteRemove
+	//  - VXLANTunnelEndpointUpdate
+	//  - VXLANTunnelEndpointRemove
+	Sync(*SyncRequest, PolicySync_SyncServer) error
+}
+
+func RegisterPolicySyncServer(s *grpc.Server, srv PolicySyncServer) {
+	s.RegisterService(&_PolicySync_serviceDesc, srv)
+}
+
+func _PolicySync_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PolicySyncServer).Sync(m, &policySyncSyncServer{stream})
+}
+
+type PolicySync_SyncServer interface {
+	Send(*ToDataplane) error
+	grpc.ServerStream
+}
+
+type policySyncSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *policySyncSyncServer) Send(m *ToDataplane) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _PolicySync_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "felix.PolicySync",
+	HandlerType: (*PolicySyncServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Sync",
+			Handler:       _PolicySync_Sync_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "felixbackend.proto",
+}
+
+func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ClusterState {
+		dAtA[i] = 0x8
 		i++
-		dAtA[i] = 0x1
+		if m.ClusterState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceUpdate.Size()))
-		n21, err := m.NamespaceUpdate.MarshalTo(dAtA[i:])
+	}
+	return i, nil
+}
+
+func (m *ToDataplane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToDataplane) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
+		nn1, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += nn1
+	}
+	if m.SequenceNumber != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
 	}
 	return i, nil
 }
-func (m *ToDataplane_NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ToDataplane_InSync) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.NamespaceRemove != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
+	if m.InSync != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceRemove.Size()))
-		n22, err := m.NamespaceRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.InSync.Size()))
+		n2, err := m.InSync.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n2
 	}
 	return i, nil
 }
-func (m *ToDataplane_RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_IpsetUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.RouteUpdate != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x1
+	if m.IpsetUpdate != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteUpdate.Size()))
-		n23, err := m.RouteUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetUpdate.Size()))
+		n3, err := m.IpsetUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n3
 	}
 	return i, nil
 }
-func (m *ToDataplane_RouteRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_IpsetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.RouteRemove != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x1
+	if m.IpsetDeltaUpdate != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteRemove.Size()))
-		n24, err := m.RouteRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetDeltaUpdate.Size()))
+		n4, err := m.IpsetDeltaUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n4
 	}
 	return i, nil
 }
-func (m *ToDataplane_VtepUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_IpsetRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.VtepUpdate != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x1
+	if m.IpsetRemove != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepUpdate.Size()))
-		n25, err := m.VtepUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetRemove.Size()))
+		n5, err := m.IpsetRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n5
 	}
 	return i, nil
 }
-func (m *ToDataplane_VtepRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.VtepRemove != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x1
+	if m.ActiveProfileUpdate != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepRemove.Size()))
-		n26, err := m.VtepRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileUpdate.Size()))
+		n6, err := m.ActiveProfileUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n6
 	}
 	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WireguardEndpointUpdate != nil {
-		dAtA[i] = 0xda
-		i++
-		dAtA[i] = 0x1
+	if m.ActiveProfileRemove != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointUpdate.Size()))
-		n27, err := m.WireguardEndpointUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileRemove.Size()))
+		n7, err := m.ActiveProfileRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n7
 	}
 	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WireguardEndpointRemove != nil {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x1
+	if m.ActivePolicyUpdate != nil {
+		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointRemove.Size()))
-		n28, err := m.WireguardEndpointRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyUpdate.Size()))
+		n8, err := m.ActivePolicyUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n8
 	}
 	return i, nil
 }
-func (m *ToDataplane_GlobalBgpConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GlobalBgpConfigUpdate != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x1
+	if m.ActivePolicyRemove != nil {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.GlobalBgpConfigUpdate.Size()))
-		n29, err := m.GlobalBgpConfigUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyRemove.Size()))
+		n9, err := m.ActivePolicyRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n9
 	}
 	return i, nil
 }
-func (m *ToDataplane_Encapsulation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.Encapsulation != nil {
-		dAtA[i] = 0xf2
-		i++
-		dAtA[i] = 0x1
+	if m.HostEndpointUpdate != nil {
+		dAtA[i] = 0x4a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Encapsulation.Size()))
-		n30, err := m.Encapsulation.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointUpdate.Size()))
+		n10, err := m.HostEndpointUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n10
 	}
 	return i, nil
 }
-func (m *ToDataplane_ServiceUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ServiceUpdate != nil {
-		dAtA[i] = 0xfa
-		i++
-		dAtA[i] = 0x1
+	if m.HostEndpointRemove != nil {
+		dAtA[i] = 0x52
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceUpdate.Size()))
-		n31, err := m.ServiceUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointRemove.Size()))
+		n11, err := m.HostEndpointRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n11
 	}
 	return i, nil
 }
-func (m *ToDataplane_ServiceRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ServiceRemove != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x2
+	if m.WorkloadEndpointUpdate != nil {
+		dAtA[i] = 0x5a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceRemove.Size()))
-		n32, err := m.ServiceRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointUpdate.Size()))
+		n12, err := m.WorkloadEndpointUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n12
 	}
 	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointV6Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WireguardEndpointV6Update != nil {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x2
+	if m.WorkloadEndpointRemove != nil {
+		dAtA[i] = 0x62
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointV6Update.Size()))
-		n33, err := m.WireguardEndpointV6Update.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointRemove.Size()))
+		n13, err := m.WorkloadEndpointRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n13
 	}
 	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointV6Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WireguardEndpointV6Remove != nil {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x2
+	if m.ConfigUpdate != nil {
+		dAtA[i] = 0x6a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointV6Remove.Size()))
-		n34, err := m.WireguardEndpointV6Remove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ConfigUpdate.Size()))
+		n14, err := m.ConfigUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n14
 	}
 	return i, nil
 }
-func (m *ToDataplane_HostMetadataV6Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_HostMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostMetadataV6Update != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x2
+	if m.HostMetadataUpdate != nil {
+		dAtA[i] = 0x72
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV6Update.Size()))
-		n35, err := m.HostMetadataV6Update.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataUpdate.Size()))
+		n15, err := m.HostMetadataUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n15
 	}
 	return i, nil
 }
-func (m *ToDataplane_HostMetadataV6Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_IpamPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostMetadataV6Remove != nil {
-		dAtA[i] = 0xa2
+	if m.IpamPoolUpdate != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV6Remove.Size()))
-		n36, err := m.HostMetadataV6Remove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolUpdate.Size()))
+		n16, err := m.IpamPoolUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n16
 	}
 	return i, nil
 }
-func (m *ToDataplane_HostMetadataV4V6Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_IpamPoolRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostMetadataV4V6Update != nil {
-		dAtA[i] = 0xaa
+	if m.IpamPoolRemove != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV4V6Update.Size()))
-		n37, err := m.HostMetadataV4V6Update.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolRemove.Size()))
+		n17, err := m.IpamPoolRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n17
 	}
 	return i, nil
 }
-func (m *ToDataplane_HostMetadataV4V6Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_HostMetadataRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostMetadataV4V6Remove != nil {
-		dAtA[i] = 0xb2
+	if m.HostMetadataRemove != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV4V6Remove.Size()))
-		n38, err := m.HostMetadataV4V6Remove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataRemove.Size()))
+		n18, err := m.HostMetadataRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n18
 	}
 	return i, nil
 }
-func (m *FromDataplane) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FromDataplane) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		nn39, err := m.Payload.MarshalTo(dAtA[i:])
+func (m *ToDataplane_ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ServiceAccountUpdate != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountUpdate.Size()))
+		n19, err := m.ServiceAccountUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn39
-	}
-	if m.SequenceNumber != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
+		i += n19
 	}
 	return i, nil
 }
-
-func (m *FromDataplane_ProcessStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ProcessStatusUpdate != nil {
-		dAtA[i] = 0x1a
+	if m.ServiceAccountRemove != nil {
+		dAtA[i] = 0xa2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ProcessStatusUpdate.Size()))
-		n40, err := m.ProcessStatusUpdate.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountRemove.Size()))
+		n20, err := m.ServiceAccountRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n20
 	}
 	return i, nil
 }
-func (m *FromDataplane_HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostEndpointStatusUpdate != nil {
-		dAtA[i] = 0x22
+	if m.NamespaceUpdate != nil {
+		dAtA[i] = 0xaa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusUpdate.Size()))
-		n41, err := m.HostEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceUpdate.Size()))
+		n21, err := m.NamespaceUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n21
 	}
 	return i, nil
 }
-func (m *FromDataplane_HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostEndpointStatusRemove != nil {
-		dAtA[i] = 0x2a
+	if m.NamespaceRemove != nil {
+		dAtA[i] = 0xb2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusRemove.Size()))
-		n42, err := m.HostEndpointStatusRemove.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceRemove.Size()))
+		n22, err := m.NamespaceRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n22
 	}
 	return i, nil
 }
-func (m *FromDataplane_WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WorkloadEndpointStatusUpdate != nil {
-		dAtA[i] = 0x32
+	if m.RouteUpdate != nil {
+		dAtA[i] = 0xba
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusUpdate.Size()))
-		n43, err := m.WorkloadEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteUpdate.Size()))
+		n23, err := m.RouteUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n23
 	}
 	return i, nil
 }
-func (m *FromDataplane_WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_RouteRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WorkloadEndpointStatusRemove != nil {
-		dAtA[i] = 0x3a
+	if m.RouteRemove != nil {
+		dAtA[i] = 0xc2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusRemove.Size()))
-		n44, err := m.WorkloadEndpointStatusRemove.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteRemove.Size()))
+		n24, err := m.RouteRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n24
 	}
 	return i, nil
 }
-func (m *FromDataplane_WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_VtepUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WireguardStatusUpdate != nil {
-		dAtA[i] = 0x4a
+	if m.VtepUpdate != nil {
+		dAtA[i] = 0xca
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardStatusUpdate.Size()))
-		n45, err := m.WireguardStatusUpdate.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepUpdate.Size()))
+		n25, err := m.VtepUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n25
 	}
 	return i, nil
 }
-func (m *FromDataplane_DataplaneInSync) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_VtepRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DataplaneInSync != nil {
-		dAtA[i] = 0x52
+	if m.VtepRemove != nil {
+		dAtA[i] = 0xd2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.DataplaneInSync.Size()))
-		n46, err := m.DataplaneInSync.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepRemove.Size()))
+		n26, err := m.VtepRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n26
 	}
 	return i, nil
 }
-func (m *ConfigUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Config) > 0 {
-		for k, _ := range m.Config {
-			dAtA[i] = 0xa
-			i++
-			v := m.Config[k]
-			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.SourceToRawConfig) > 0 {
-		for k, _ := range m.SourceToRawConfig {
-			dAtA[i] = 0x12
-			i++
-			v := m.SourceToRawConfig[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovFelixbackend(uint64(msgSize))
-			}
-			mapSize := 1 + sovFelixbackend(uint64(k)) + msgSize
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(k))
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintFelixbackend(dAtA, i, uint64(v.Size()))
-				n47, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n47
-			}
+func (m *ToDataplane_WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointUpdate != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointUpdate.Size()))
+		n27, err := m.WireguardEndpointUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n27
 	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x1a
+	return i, nil
+}
+func (m *ToDataplane_WireguardEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointRemove != nil {
+		dAtA[i] = 0xe2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointRemove.Size()))
+		n28, err := m.WireguardEndpointRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
 	return i, nil
 }
-
-func (m *RawConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_GlobalBgpConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GlobalBgpConfigUpdate != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.GlobalBgpConfigUpdate.Size()))
+		n29, err := m.GlobalBgpConfigUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *RawConfig) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_Encapsulation) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Encapsulation != nil {
+		dAtA[i] = 0xf2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
-	}
-	if len(m.Config) > 0 {
-		for k, _ := range m.Config {
-			dAtA[i] = 0x12
-			i++
-			v := m.Config[k]
-			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Encapsulation.Size()))
+		n30, err := m.Encapsulation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n30
 	}
 	return i, nil
 }
-
-func (m *InSync) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_ServiceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ServiceUpdate != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceUpdate.Size()))
+		n31, err := m.ServiceUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InSync) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
 	return i, nil
 }
-
-func (m *IPSetUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_ServiceRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ServiceRemove != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceRemove.Size()))
+		n32, err := m.ServiceRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *IPSetUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_WireguardEndpointV6Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointV6Update != nil {
+		dAtA[i] = 0x8a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointV6Update.Size()))
+		n33, err := m.WireguardEndpointV6Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n33
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x18
+	return i, nil
+}
+func (m *ToDataplane_WireguardEndpointV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointV6Remove != nil {
+		dAtA[i] = 0x92
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointV6Remove.Size()))
+		n34, err := m.WireguardEndpointV6Remove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
 	return i, nil
 }
-
-func (m *IPSetDeltaUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ToDataplane_HostMetadataV6Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataV6Update != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV6Update.Size()))
+		n35, err := m.HostMetadataV6Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	return i, nil
 }
-
-func (m *IPSetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_HostMetadataV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataV6Remove != nil {
+		dAtA[i] = 0xa2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV6Remove.Size()))
+		n36, err := m.HostMetadataV6Remove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
 	}
-	if len(m.AddedMembers) > 0 {
-		for _, s := range m.AddedMembers {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return i, nil
+}
+func (m *ToDataplane_HostMetadataV4V6Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataV4V6Update != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV4V6Update.Size()))
+		n37, err := m.HostMetadataV4V6Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n37
 	}
-	if len(m.RemovedMembers) > 0 {
-		for _, s := range m.RemovedMembers {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return i, nil
+}
+func (m *ToDataplane_HostMetadataV4V6Remove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataV4V6Remove != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV4V6Remove.Size()))
+		n38, err := m.HostMetadataV4V6Remove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n38
 	}
 	return i, nil
 }
-
-func (m *IPSetRemove) Marshal() (dAtA []byte, err error) {
+func (m *FromDataplane) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5536,111 +6100,125 @@ func (m *IPSetRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IPSetRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *FromDataplane) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+	if m.Payload != nil {
+		nn39, err := m.Payload.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn39
+	}
+	if m.SequenceNumber != 0 {
+		dAtA[i] = 0x40
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
 	}
 	return i, nil
 }
 
-func (m *ActiveProfileUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *FromDataplane_ProcessStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ProcessStatusUpdate != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ProcessStatusUpdate.Size()))
+		n40, err := m.ProcessStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *FromDataplane_HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostEndpointStatusUpdate != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n48, err := m.Id.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusUpdate.Size()))
+		n41, err := m.HostEndpointStatusUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n41
 	}
-	if m.Profile != nil {
-		dAtA[i] = 0x12
+	return i, nil
+}
+func (m *FromDataplane_HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostEndpointStatusRemove != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Profile.Size()))
-		n49, err := m.Profile.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusRemove.Size()))
+		n42, err := m.HostEndpointStatusRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n42
 	}
 	return i, nil
 }
-
-func (m *ActiveProfileRemove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *FromDataplane_WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WorkloadEndpointStatusUpdate != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusUpdate.Size()))
+		n43, err := m.WorkloadEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *FromDataplane_WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WorkloadEndpointStatusRemove != nil {
+		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n50, err := m.Id.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusRemove.Size()))
+		n44, err := m.WorkloadEndpointStatusRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n44
 	}
 	return i, nil
 }
-
-func (m *ProfileID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *FromDataplane_WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardStatusUpdate != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardStatusUpdate.Size()))
+		n45, err := m.WireguardStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ProfileID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+func (m *FromDataplane_DataplaneInSync) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DataplaneInSync != nil {
+		dAtA[i] = 0x52
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.DataplaneInSync.Size()))
+		n46, err := m.DataplaneInSync.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
 	return i, nil
 }
-
-func (m *Profile) Marshal() (dAtA []byte, err error) {
+func (m *ConfigUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5650,39 +6228,65 @@ func (m *Profile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, msg := range m.InboundRules {
+	if len(m.Config) > 0 {
+		for k, _ := range m.Config {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+			v := m.Config[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	if len(m.OutboundRules) > 0 {
-		for _, msg := range m.OutboundRules {
+	if len(m.SourceToRawConfig) > 0 {
+		for k, _ := range m.SourceToRawConfig {
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			v := m.SourceToRawConfig[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovFelixbackend(uint64(msgSize))
+			}
+			mapSize := 1 + sovFelixbackend(uint64(k)) + msgSize
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(k))
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintFelixbackend(dAtA, i, uint64(v.Size()))
+				n47, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n47
 			}
-			i += n
 		}
 	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
 	return i, nil
 }
 
-func (m *ActivePolicyUpdate) Marshal() (dAtA []byte, err error) {
+func (m *RawConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5692,35 +6296,38 @@ func (m *ActivePolicyUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawConfig) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.Source) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n51, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n51
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	if m.Policy != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Policy.Size()))
-		n52, err := m.Policy.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Config) > 0 {
+		for k, _ := range m.Config {
+			dAtA[i] = 0x12
+			i++
+			v := m.Config[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n52
 	}
 	return i, nil
 }
 
-func (m *ActivePolicyRemove) Marshal() (dAtA []byte, err error) {
+func (m *InSync) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5730,25 +6337,21 @@ func (m *ActivePolicyRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *InSync) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.Hostname) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n53, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n53
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
 	return i, nil
 }
 
-func (m *PolicyID) Marshal() (dAtA []byte, err error) {
+func (m *IPSetUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5758,27 +6361,41 @@ func (m *PolicyID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PolicyID) MarshalTo(dAtA []byte) (int, error) {
+func (m *IPSetUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tier) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Tier)))
-		i += copy(dAtA[i:], m.Tier)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
 	}
 	return i, nil
 }
 
-func (m *Policy) Marshal() (dAtA []byte, err error) {
+func (m *IPSetDeltaUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5788,71 +6405,75 @@ func (m *Policy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+func (m *IPSetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, msg := range m.InboundRules {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if len(m.OutboundRules) > 0 {
-		for _, msg := range m.OutboundRules {
+	if len(m.AddedMembers) > 0 {
+		for _, s := range m.AddedMembers {
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
-		}
-	}
-	if m.Untracked {
-		dAtA[i] = 0x18
-		i++
-		if m.Untracked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i++
 	}
-	if m.PreDnat {
-		dAtA[i] = 0x20
-		i++
-		if m.PreDnat {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.RemovedMembers) > 0 {
+		for _, s := range m.RemovedMembers {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i++
 	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+	return i, nil
+}
+
+func (m *IPSetRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OriginalSelector) > 0 {
-		dAtA[i] = 0x32
+	return dAtA[:n], nil
+}
+
+func (m *IPSetRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSelector)))
-		i += copy(dAtA[i:], m.OriginalSelector)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
 	return i, nil
 }
 
-func (m *Rule) Marshal() (dAtA []byte, err error) {
+func (m *ActiveProfileUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5862,50 +6483,104 @@ func (m *Rule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Action) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Action)))
-		i += copy(dAtA[i:], m.Action)
-	}
-	if m.IpVersion != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n48, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
 	}
-	if m.Protocol != nil {
-		dAtA[i] = 0x1a
+	if m.Profile != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Protocol.Size()))
-		n54, err := m.Protocol.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Profile.Size()))
+		n49, err := m.Profile.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n49
 	}
-	if len(m.SrcNet) > 0 {
-		for _, s := range m.SrcNet {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return i, nil
+}
+
+func (m *ActiveProfileRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n50, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n50
 	}
-	if len(m.SrcPorts) > 0 {
-		for _, msg := range m.SrcPorts {
-			dAtA[i] = 0x2a
+	return i, nil
+}
+
+func (m *ProfileID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Profile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundRules) > 0 {
+		for _, msg := range m.InboundRules {
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5915,24 +6590,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.DstNet) > 0 {
-		for _, s := range m.DstNet {
-			dAtA[i] = 0x32
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.DstPorts) > 0 {
-		for _, msg := range m.DstPorts {
-			dAtA[i] = 0x3a
+	if len(m.OutboundRules) > 0 {
+		for _, msg := range m.OutboundRules {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5942,122 +6602,233 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.Icmp != nil {
-		nn55, err := m.Icmp.MarshalTo(dAtA[i:])
+	return i, nil
+}
+
+func (m *ActivePolicyUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n51, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn55
-	}
-	if len(m.SrcIpSetIds) > 0 {
-		for _, s := range m.SrcIpSetIds {
-			dAtA[i] = 0x52
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.DstIpSetIds) > 0 {
-		for _, s := range m.DstIpSetIds {
-			dAtA[i] = 0x5a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.SrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.SrcNamedPortIpSetIds {
-			dAtA[i] = 0x62
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+		i += n51
 	}
-	if len(m.DstNamedPortIpSetIds) > 0 {
-		for _, s := range m.DstNamedPortIpSetIds {
-			dAtA[i] = 0x6a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.Policy != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Policy.Size()))
+		n52, err := m.Policy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n52
 	}
-	if len(m.DstIpPortSetIds) > 0 {
-		for _, s := range m.DstIpPortSetIds {
-			dAtA[i] = 0x7a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return i, nil
+}
+
+func (m *ActivePolicyRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.NotProtocol != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x6
+	return dAtA[:n], nil
+}
+
+func (m *ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotProtocol.Size()))
-		n56, err := m.NotProtocol.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n53, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n53
 	}
-	if len(m.NotSrcNet) > 0 {
-		for _, s := range m.NotSrcNet {
-			dAtA[i] = 0xba
-			i++
-			dAtA[i] = 0x6
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
+	return i, nil
+}
+
+func (m *PolicyID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tier) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Tier)))
+		i += copy(dAtA[i:], m.Tier)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundRules) > 0 {
+		for _, msg := range m.InboundRules {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OutboundRules) > 0 {
+		for _, msg := range m.OutboundRules {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Untracked {
+		dAtA[i] = 0x18
+		i++
+		if m.Untracked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PreDnat {
+		dAtA[i] = 0x20
+		i++
+		if m.PreDnat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.OriginalSelector) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSelector)))
+		i += copy(dAtA[i:], m.OriginalSelector)
+	}
+	return i, nil
+}
+
+func (m *Rule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if m.IpVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
+	}
+	if m.Protocol != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Protocol.Size()))
+		n54, err := m.Protocol.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if len(m.SrcNet) > 0 {
+		for _, s := range m.SrcNet {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
 			dAtA[i] = uint8(l)
 			i++
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotSrcPorts) > 0 {
-		for _, msg := range m.NotSrcPorts {
-			dAtA[i] = 0xc2
-			i++
-			dAtA[i] = 0x6
+	if len(m.SrcPorts) > 0 {
+		for _, msg := range m.SrcPorts {
+			dAtA[i] = 0x2a
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6067,11 +6838,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.NotDstNet) > 0 {
-		for _, s := range m.NotDstNet {
-			dAtA[i] = 0xca
-			i++
-			dAtA[i] = 0x6
+	if len(m.DstNet) > 0 {
+		for _, s := range m.DstNet {
+			dAtA[i] = 0x32
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6084,11 +6853,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotDstPorts) > 0 {
-		for _, msg := range m.NotDstPorts {
-			dAtA[i] = 0xd2
-			i++
-			dAtA[i] = 0x6
+	if len(m.DstPorts) > 0 {
+		for _, msg := range m.DstPorts {
+			dAtA[i] = 0x3a
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6098,18 +6865,16 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.NotIcmp != nil {
-		nn57, err := m.NotIcmp.MarshalTo(dAtA[i:])
+	if m.Icmp != nil {
+		nn55, err := m.Icmp.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn57
+		i += nn55
 	}
-	if len(m.NotSrcIpSetIds) > 0 {
-		for _, s := range m.NotSrcIpSetIds {
-			dAtA[i] = 0xea
-			i++
-			dAtA[i] = 0x6
+	if len(m.SrcIpSetIds) > 0 {
+		for _, s := range m.SrcIpSetIds {
+			dAtA[i] = 0x52
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6122,11 +6887,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotDstIpSetIds) > 0 {
-		for _, s := range m.NotDstIpSetIds {
-			dAtA[i] = 0xf2
-			i++
-			dAtA[i] = 0x6
+	if len(m.DstIpSetIds) > 0 {
+		for _, s := range m.DstIpSetIds {
+			dAtA[i] = 0x5a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6139,11 +6902,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotSrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotSrcNamedPortIpSetIds {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x7
+	if len(m.SrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.SrcNamedPortIpSetIds {
+			dAtA[i] = 0x62
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6156,11 +6917,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotDstNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotDstNamedPortIpSetIds {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x7
+	if len(m.DstNamedPortIpSetIds) > 0 {
+		for _, s := range m.DstNamedPortIpSetIds {
+			dAtA[i] = 0x6a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6173,29 +6932,193 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.OriginalSrcSelector) > 0 {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x7
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSrcSelector)))
-		i += copy(dAtA[i:], m.OriginalSrcSelector)
+	if len(m.DstIpPortSetIds) > 0 {
+		for _, s := range m.DstIpPortSetIds {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if len(m.OriginalDstSelector) > 0 {
-		dAtA[i] = 0x9a
+	if m.NotProtocol != nil {
+		dAtA[i] = 0xb2
 		i++
-		dAtA[i] = 0x7
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalDstSelector)))
-		i += copy(dAtA[i:], m.OriginalDstSelector)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotProtocol.Size()))
+		n56, err := m.NotProtocol.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
 	}
-	if len(m.OriginalSrcNamespaceSelector) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x7
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSrcNamespaceSelector)))
-		i += copy(dAtA[i:], m.OriginalSrcNamespaceSelector)
+	if len(m.NotSrcNet) > 0 {
+		for _, s := range m.NotSrcNet {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotSrcPorts) > 0 {
+		for _, msg := range m.NotSrcPorts {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x6
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NotDstNet) > 0 {
+		for _, s := range m.NotDstNet {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotDstPorts) > 0 {
+		for _, msg := range m.NotDstPorts {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x6
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NotIcmp != nil {
+		nn57, err := m.NotIcmp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn57
+	}
+	if len(m.NotSrcIpSetIds) > 0 {
+		for _, s := range m.NotSrcIpSetIds {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotDstIpSetIds) > 0 {
+		for _, s := range m.NotDstIpSetIds {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotSrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotSrcNamedPortIpSetIds {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x7
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotDstNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotDstNamedPortIpSetIds {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x7
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.OriginalSrcSelector) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSrcSelector)))
+		i += copy(dAtA[i:], m.OriginalSrcSelector)
+	}
+	if len(m.OriginalDstSelector) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalDstSelector)))
+		i += copy(dAtA[i:], m.OriginalDstSelector)
+	}
+	if len(m.OriginalSrcNamespaceSelector) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSrcNamespaceSelector)))
+		i += copy(dAtA[i:], m.OriginalSrcNamespaceSelector)
 	}
 	if len(m.OriginalDstNamespaceSelector) > 0 {
 		dAtA[i] = 0xaa
@@ -6301,87 +7224,30 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSrcServiceNamespace)))
 		i += copy(dAtA[i:], m.OriginalSrcServiceNamespace)
 	}
-	if len(m.RuleId) > 0 {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0xc
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.RuleId)))
-		i += copy(dAtA[i:], m.RuleId)
-	}
-	return i, nil
-}
-
-func (m *Rule_IcmpType) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintFelixbackend(dAtA, i, uint64(m.IcmpType))
-	return i, nil
-}
-func (m *Rule_IcmpTypeCode) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IcmpTypeCode != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IcmpTypeCode.Size()))
-		n62, err := m.IcmpTypeCode.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n62
-	}
-	return i, nil
-}
-func (m *Rule_NotIcmpType) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0xd8
-	i++
-	dAtA[i] = 0x6
-	i++
-	i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotIcmpType))
-	return i, nil
-}
-func (m *Rule_NotIcmpTypeCode) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.NotIcmpTypeCode != nil {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotIcmpTypeCode.Size()))
-		n63, err := m.NotIcmpTypeCode.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.DstAnnotations) > 0 {
+		for k, _ := range m.DstAnnotations {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x8
+			i++
+			v := m.DstAnnotations[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n63
 	}
-	return i, nil
-}
-func (m *ServiceAccountMatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceAccountMatch) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Selector) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Selector)))
-		i += copy(dAtA[i:], m.Selector)
-	}
-	if len(m.Names) > 0 {
-		for _, s := range m.Names {
-			dAtA[i] = 0x12
+	if len(m.AppProtocols) > 0 {
+		for _, s := range m.AppProtocols {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x8
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6394,27 +7260,23 @@ func (m *ServiceAccountMatch) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
-}
-
-func (m *HTTPMatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.SrcIsLocalNode {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x8
+		i++
+		if m.SrcIsLocalNode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HTTPMatch) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Methods) > 0 {
-		for _, s := range m.Methods {
-			dAtA[i] = 0xa
+	if len(m.JwtAudiences) > 0 {
+		for _, s := range m.JwtAudiences {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x8
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6427,82 +7289,168 @@ func (m *HTTPMatch) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Paths) > 0 {
-		for _, msg := range m.Paths {
-			dAtA[i] = 0x12
+	if len(m.RouteNames) > 0 {
+		for _, s := range m.RouteNames {
+			dAtA[i] = 0xda
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			dAtA[i] = 0x8
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
-}
-
-func (m *HTTPMatch_PathMatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.SrcIpPools) > 0 {
+		for _, s := range m.SrcIpPools {
+			dAtA[i] = 0xe2
+			i++
+			dAtA[i] = 0x8
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HTTPMatch_PathMatch) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PathMatch != nil {
-		nn64, err := m.PathMatch.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.DstServicePorts) > 0 {
+		for _, s := range m.DstServicePorts {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x8
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += nn64
 	}
-	return i, nil
-}
-
-func (m *HTTPMatch_PathMatch_Exact) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Exact)))
-	i += copy(dAtA[i:], m.Exact)
-	return i, nil
-}
-func (m *HTTPMatch_PathMatch_Prefix) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Prefix)))
-	i += copy(dAtA[i:], m.Prefix)
-	return i, nil
-}
-func (m *RuleMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.DirectRemoteNet) > 0 {
+		for _, s := range m.DirectRemoteNet {
+			dAtA[i] = 0xfa
+			i++
+			dAtA[i] = 0x8
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RuleMetadata) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Annotations) > 0 {
-		for k, _ := range m.Annotations {
-			dAtA[i] = 0xa
+	if len(m.DstEncapsulations) > 0 {
+		for _, s := range m.DstEncapsulations {
+			dAtA[i] = 0x82
 			i++
-			v := m.Annotations[k]
+			dAtA[i] = 0x9
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.TlsTerminated {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x9
+		i++
+		if m.TlsTerminated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DstReady {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x9
+		i++
+		if m.DstReady {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.GrpcCallTypes) > 0 {
+		for _, s := range m.GrpcCallTypes {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x9
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.SrcIpSetAddedWithinSecs != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x9
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SrcIpSetAddedWithinSecs))
+	}
+	if m.DstIpSetAddedWithinSecs != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x9
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.DstIpSetAddedWithinSecs))
+	}
+	if m.SrcIpSetCardinalityAbove != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x9
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SrcIpSetCardinalityAbove))
+	}
+	if len(m.RequestLabelSelector) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x9
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.RequestLabelSelector)))
+		i += copy(dAtA[i:], m.RequestLabelSelector)
+	}
+	if len(m.SrcNamespaceLabels) > 0 {
+		for k, _ := range m.SrcNamespaceLabels {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x9
+			i++
+			v := m.SrcNamespaceLabels[k]
 			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
 			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
 			dAtA[i] = 0xa
@@ -6515,215 +7463,93 @@ func (m *RuleMetadata) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], v)
 		}
 	}
-	return i, nil
-}
-
-func (m *IcmpTypeAndCode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *IcmpTypeAndCode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
+	if m.DstInLocalIpamBlock {
+		dAtA[i] = 0xc8
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
-	}
-	if m.Code != 0 {
-		dAtA[i] = 0x10
+		dAtA[i] = 0x9
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Code))
-	}
-	return i, nil
-}
-
-func (m *Protocol) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Protocol) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NumberOrName != nil {
-		nn65, err := m.NumberOrName.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.DstInLocalIpamBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += nn65
+		i++
 	}
-	return i, nil
-}
-
-func (m *Protocol_Number) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintFelixbackend(dAtA, i, uint64(m.Number))
-	return i, nil
-}
-func (m *Protocol_Name) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	return i, nil
-}
-func (m *PortRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PortRange) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.First != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.First))
-	}
-	if m.Last != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Last))
-	}
-	return i, nil
-}
-
-func (m *WorkloadEndpointID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *WorkloadEndpointID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OrchestratorId)))
-		i += copy(dAtA[i:], m.OrchestratorId)
+	if len(m.TlsFingerprints) > 0 {
+		for _, s := range m.TlsFingerprints {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x9
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if len(m.WorkloadId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.WorkloadId)))
-		i += copy(dAtA[i:], m.WorkloadId)
+	if len(m.NotTlsFingerprints) > 0 {
+		for _, s := range m.NotTlsFingerprints {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x9
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if len(m.EndpointId) > 0 {
-		dAtA[i] = 0x22
+	if m.GrpcMatch != nil {
+		dAtA[i] = 0xe2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.EndpointId)))
-		i += copy(dAtA[i:], m.EndpointId)
-	}
-	return i, nil
-}
-
-func (m *WorkloadEndpointUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n66, err := m.Id.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.GrpcMatch.Size()))
+		n62, err := m.GrpcMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n62
 	}
-	if m.Endpoint != nil {
-		dAtA[i] = 0x2a
+	if m.AllowHairpin {
+		dAtA[i] = 0xe8
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Endpoint.Size()))
-		n67, err := m.Endpoint.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		dAtA[i] = 0x9
+		i++
+		if m.AllowHairpin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n67
-	}
-	return i, nil
-}
-
-func (m *WorkloadEndpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.State) > 0 {
-		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
+	if m.DstListening {
+		dAtA[i] = 0xf8
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Mac) > 0 {
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x9
+		i++
+		if m.DstListening {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Mac)))
-		i += copy(dAtA[i:], m.Mac)
 	}
-	if len(m.ProfileIds) > 0 {
-		for _, s := range m.ProfileIds {
-			dAtA[i] = 0x22
+	if len(m.SrcPrincipalPrefixes) > 0 {
+		for _, s := range m.SrcPrincipalPrefixes {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6736,9 +7562,11 @@ func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Ipv4Nets) > 0 {
-		for _, s := range m.Ipv4Nets {
-			dAtA[i] = 0x2a
+	if len(m.SrcPrincipalSuffixes) > 0 {
+		for _, s := range m.SrcPrincipalSuffixes {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6751,9 +7579,11 @@ func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Ipv6Nets) > 0 {
-		for _, s := range m.Ipv6Nets {
-			dAtA[i] = 0x32
+	if len(m.DstPrincipalPrefixes) > 0 {
+		for _, s := range m.DstPrincipalPrefixes {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6766,45 +7596,45 @@ func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Tiers) > 0 {
-		for _, msg := range m.Tiers {
-			dAtA[i] = 0x3a
+	if len(m.DstPrincipalSuffixes) > 0 {
+		for _, s := range m.DstPrincipalSuffixes {
+			dAtA[i] = 0x9a
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Ipv4Nat) > 0 {
-		for _, msg := range m.Ipv4Nat {
-			dAtA[i] = 0x42
+			dAtA[i] = 0xa
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Ipv6Nat) > 0 {
-		for _, msg := range m.Ipv6Nat {
-			dAtA[i] = 0x4a
+	if len(m.SrcZones) > 0 {
+		for _, s := range m.SrcZones {
+			dAtA[i] = 0xa2
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.AllowSpoofedSourcePrefixes) > 0 {
-		for _, s := range m.AllowSpoofedSourcePrefixes {
-			dAtA[i] = 0x52
+	if len(m.SrcRegions) > 0 {
+		for _, s := range m.SrcRegions {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6817,79 +7647,163 @@ func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Annotations) > 0 {
-		for k, _ := range m.Annotations {
-			dAtA[i] = 0x5a
+	if len(m.DstReverseDnsNames) > 0 {
+		for _, s := range m.DstReverseDnsNames {
+			dAtA[i] = 0xb2
 			i++
-			v := m.Annotations[k]
-			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
-}
-
-func (m *WorkloadEndpointRemove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.NotDstIpPortSetIds) > 0 {
+		for _, s := range m.NotDstIpPortSetIds {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return dAtA[:n], nil
+	if len(m.SrcIpPortSetIds) > 0 {
+		for _, s := range m.SrcIpPortSetIds {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotSrcIpPortSetIds) > 0 {
+		for _, s := range m.NotSrcIpPortSetIds {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ConnectionReused {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0xa
+		i++
+		if m.ConnectionReused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.SrcAsNumbers) > 0 {
+		dAtA64 := make([]byte, len(m.SrcAsNumbers)*10)
+		var j63 int
+		for _, num := range m.SrcAsNumbers {
+			for num >= 1<<7 {
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j63++
+			}
+			dAtA64[j63] = uint8(num)
+			j63++
+		}
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(j63))
+		i += copy(dAtA[i:], dAtA64[:j63])
+	}
+	if len(m.RuleId) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0xc
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.RuleId)))
+		i += copy(dAtA[i:], m.RuleId)
+	}
+	return i, nil
 }
 
-func (m *WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *Rule_IcmpType) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(m.IcmpType))
+	return i, nil
+}
+func (m *Rule_IcmpTypeCode) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.IcmpTypeCode != nil {
+		dAtA[i] = 0x4a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n68, err := m.Id.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IcmpTypeCode.Size()))
+		n65, err := m.IcmpTypeCode.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n65
 	}
 	return i, nil
 }
-
-func (m *HostEndpointID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Rule_NotIcmpType) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0xd8
+	i++
+	dAtA[i] = 0x6
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotIcmpType))
+	return i, nil
 }
-
-func (m *HostEndpointID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndpointId) > 0 {
-		dAtA[i] = 0x12
+func (m *Rule_NotIcmpTypeCode) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.NotIcmpTypeCode != nil {
+		dAtA[i] = 0xe2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.EndpointId)))
-		i += copy(dAtA[i:], m.EndpointId)
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotIcmpTypeCode.Size()))
+		n66, err := m.NotIcmpTypeCode.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
 	}
 	return i, nil
 }
-
-func (m *HostEndpointUpdate) Marshal() (dAtA []byte, err error) {
+func (m *ServiceAccountMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6899,35 +7813,61 @@ func (m *HostEndpointUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceAccountMatch) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.Selector) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n69, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Selector)))
+		i += copy(dAtA[i:], m.Selector)
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n69
 	}
-	if m.Endpoint != nil {
-		dAtA[i] = 0x1a
+	if len(m.TrustDomains) > 0 {
+		for _, s := range m.TrustDomains {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.GlobNames {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Endpoint.Size()))
-		n70, err := m.Endpoint.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.GlobNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n70
+		i++
 	}
 	return i, nil
 }
 
-func (m *HostEndpoint) Marshal() (dAtA []byte, err error) {
+func (m *HTTPMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6937,20 +7877,14 @@ func (m *HostEndpoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPMatch) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.ProfileIds) > 0 {
-		for _, s := range m.ProfileIds {
-			dAtA[i] = 0x12
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6963,9 +7897,9 @@ func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Tiers) > 0 {
-		for _, msg := range m.Tiers {
-			dAtA[i] = 0x1a
+	if len(m.Paths) > 0 {
+		for _, msg := range m.Paths {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6975,9 +7909,9 @@ func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.ExpectedIpv4Addrs) > 0 {
-		for _, s := range m.ExpectedIpv4Addrs {
-			dAtA[i] = 0x22
+	if len(m.ContentTypes) > 0 {
+		for _, s := range m.ContentTypes {
+			dAtA[i] = 0x1a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -6990,24 +7924,19 @@ func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.ExpectedIpv6Addrs) > 0 {
-		for _, s := range m.ExpectedIpv6Addrs {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.IgnoreTrailingSlash {
+		dAtA[i] = 0x20
+		i++
+		if m.IgnoreTrailingSlash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.UntrackedTiers) > 0 {
-		for _, msg := range m.UntrackedTiers {
-			dAtA[i] = 0x32
+	if len(m.QueryParams) > 0 {
+		for _, msg := range m.QueryParams {
+			dAtA[i] = 0x2a
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -7017,8 +7946,18 @@ func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.PreDnatTiers) > 0 {
-		for _, msg := range m.PreDnatTiers {
+	if m.CaseInsensitive {
+		dAtA[i] = 0x30
+		i++
+		if m.CaseInsensitive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
 			dAtA[i] = 0x3a
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
@@ -7029,22 +7968,84 @@ func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.ForwardTiers) > 0 {
-		for _, msg := range m.ForwardTiers {
+	if len(m.Upgrades) > 0 {
+		for _, s := range m.Upgrades {
 			dAtA[i] = 0x42
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	if len(m.NotMethods) > 0 {
+		for _, s := range m.NotMethods {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotPaths) > 0 {
+		for _, msg := range m.NotPaths {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxBodyBytes != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.MaxBodyBytes))
+	}
+	if len(m.ResponseCodes) > 0 {
+		dAtA68 := make([]byte, len(m.ResponseCodes)*10)
+		var j67 int
+		for _, num := range m.ResponseCodes {
+			for num >= 1<<7 {
+				dAtA68[j67] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j67++
+			}
+			dAtA68[j67] = uint8(num)
+			j67++
+		}
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(j67))
+		i += copy(dAtA[i:], dAtA68[:j67])
+	}
+	if m.MinDurationMs != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.MinDurationMs))
+	}
+	if m.MaxDurationMs != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.MaxDurationMs))
+	}
+	return i, nil
 }
 
-func (m *HostEndpointRemove) Marshal() (dAtA []byte, err error) {
+func (m *HTTPMatch_PathMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7054,25 +8055,97 @@ func (m *HostEndpointRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPMatch_PathMatch) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if m.PathMatch != nil {
+		nn69, err := m.PathMatch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn69
+	}
+	return i, nil
+}
+
+func (m *HTTPMatch_PathMatch_Exact) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Exact)))
+	i += copy(dAtA[i:], m.Exact)
+	return i, nil
+}
+func (m *HTTPMatch_PathMatch_Prefix) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Prefix)))
+	i += copy(dAtA[i:], m.Prefix)
+	return i, nil
+}
+func (m *HTTPMatch_PathMatch_Regex) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Regex)))
+	i += copy(dAtA[i:], m.Regex)
+	return i, nil
+}
+func (m *HTTPMatch_QueryParamMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPMatch_QueryParamMatch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n71, err := m.Id.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.ValueMatch != nil {
+		nn70, err := m.ValueMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += nn70
 	}
 	return i, nil
 }
 
-func (m *TierInfo) Marshal() (dAtA []byte, err error) {
+func (m *HTTPMatch_QueryParamMatch_Exact) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Exact)))
+	i += copy(dAtA[i:], m.Exact)
+	return i, nil
+}
+func (m *HTTPMatch_QueryParamMatch_Present) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	if m.Present {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *HTTPMatch_HeaderMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7082,7 +8155,7 @@ func (m *TierInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TierInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPMatch_HeaderMatch) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7093,9 +8166,70 @@ func (m *TierInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.IngressPolicies) > 0 {
-		for _, s := range m.IngressPolicies {
-			dAtA[i] = 0x12
+	if m.ValueMatch != nil {
+		nn71, err := m.ValueMatch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn71
+	}
+	return i, nil
+}
+
+func (m *HTTPMatch_HeaderMatch_Exact) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Exact)))
+	i += copy(dAtA[i:], m.Exact)
+	return i, nil
+}
+func (m *HTTPMatch_HeaderMatch_Prefix) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Prefix)))
+	i += copy(dAtA[i:], m.Prefix)
+	return i, nil
+}
+func (m *HTTPMatch_HeaderMatch_Regex) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Regex)))
+	i += copy(dAtA[i:], m.Regex)
+	return i, nil
+}
+func (m *HTTPMatch_HeaderMatch_Present) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x28
+	i++
+	if m.Present {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *GrpcMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrpcMatch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -7108,9 +8242,9 @@ func (m *TierInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.EgressPolicies) > 0 {
-		for _, s := range m.EgressPolicies {
-			dAtA[i] = 0x1a
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			dAtA[i] = 0x12
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -7126,7 +8260,7 @@ func (m *TierInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *NatInfo) Marshal() (dAtA []byte, err error) {
+func (m *RuleMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7136,27 +8270,32 @@ func (m *NatInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NatInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RuleMetadata) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExtIp) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ExtIp)))
-		i += copy(dAtA[i:], m.ExtIp)
-	}
-	if len(m.IntIp) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.IntIp)))
-		i += copy(dAtA[i:], m.IntIp)
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0xa
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
 	return i, nil
 }
 
-func (m *ProcessStatusUpdate) Marshal() (dAtA []byte, err error) {
+func (m *IcmpTypeAndCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7166,27 +8305,25 @@ func (m *ProcessStatusUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProcessStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *IcmpTypeAndCode) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.IsoTimestamp) > 0 {
-		dAtA[i] = 0xa
+	if m.Type != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.IsoTimestamp)))
-		i += copy(dAtA[i:], m.IsoTimestamp)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
 	}
-	if m.Uptime != 0 {
-		dAtA[i] = 0x11
+	if m.Code != 0 {
+		dAtA[i] = 0x10
 		i++
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Uptime))))
-		i += 8
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Code))
 	}
 	return i, nil
 }
 
-func (m *HostEndpointStatusUpdate) Marshal() (dAtA []byte, err error) {
+func (m *Protocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7196,35 +8333,37 @@ func (m *HostEndpointStatusUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Protocol) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n72, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n72
-	}
-	if m.Status != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Status.Size()))
-		n73, err := m.Status.MarshalTo(dAtA[i:])
+	if m.NumberOrName != nil {
+		nn72, err := m.NumberOrName.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += nn72
 	}
 	return i, nil
 }
 
-func (m *EndpointStatus) Marshal() (dAtA []byte, err error) {
+func (m *Protocol_Number) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(m.Number))
+	return i, nil
+}
+func (m *Protocol_Name) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	return i, nil
+}
+func (m *PortRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7234,21 +8373,25 @@ func (m *EndpointStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndpointStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *PortRange) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Status) > 0 {
-		dAtA[i] = 0xa
+	if m.First != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Status)))
-		i += copy(dAtA[i:], m.Status)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.First))
+	}
+	if m.Last != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Last))
 	}
 	return i, nil
 }
 
-func (m *HostEndpointStatusRemove) Marshal() (dAtA []byte, err error) {
+func (m *WorkloadEndpointID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7258,25 +8401,33 @@ func (m *HostEndpointStatusRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkloadEndpointID) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+	if len(m.OrchestratorId) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n74, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n74
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OrchestratorId)))
+		i += copy(dAtA[i:], m.OrchestratorId)
+	}
+	if len(m.WorkloadId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.WorkloadId)))
+		i += copy(dAtA[i:], m.WorkloadId)
+	}
+	if len(m.EndpointId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.EndpointId)))
+		i += copy(dAtA[i:], m.EndpointId)
 	}
 	return i, nil
 }
 
-func (m *WorkloadEndpointStatusUpdate) Marshal() (dAtA []byte, err error) {
+func (m *WorkloadEndpointUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7286,7 +8437,7 @@ func (m *WorkloadEndpointStatusUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7295,26 +8446,26 @@ func (m *WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n75, err := m.Id.MarshalTo(dAtA[i:])
+		n73, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n73
 	}
-	if m.Status != nil {
-		dAtA[i] = 0x12
+	if m.Endpoint != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Status.Size()))
-		n76, err := m.Status.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Endpoint.Size()))
+		n74, err := m.Endpoint.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n74
 	}
 	return i, nil
 }
 
-func (m *WorkloadEndpointStatusRemove) Marshal() (dAtA []byte, err error) {
+func (m *WorkloadEndpoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7324,25 +8475,158 @@ func (m *WorkloadEndpointStatusRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.State) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n77, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Mac) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Mac)))
+		i += copy(dAtA[i:], m.Mac)
+	}
+	if len(m.ProfileIds) > 0 {
+		for _, s := range m.ProfileIds {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Ipv4Nets) > 0 {
+		for _, s := range m.Ipv4Nets {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Ipv6Nets) > 0 {
+		for _, s := range m.Ipv6Nets {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, msg := range m.Tiers {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Ipv4Nat) > 0 {
+		for _, msg := range m.Ipv4Nat {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Ipv6Nat) > 0 {
+		for _, msg := range m.Ipv6Nat {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.AllowSpoofedSourcePrefixes) > 0 {
+		for _, s := range m.AllowSpoofedSourcePrefixes {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0x5a
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n77
 	}
 	return i, nil
 }
 
-func (m *WireguardStatusUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EndpointPort) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7352,26 +8636,32 @@ func (m *WireguardStatusUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndpointPort) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKey) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.IpVersion != 0 {
-		dAtA[i] = 0x10
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Port))
 	}
 	return i, nil
 }
 
-func (m *DataplaneInSync) Marshal() (dAtA []byte, err error) {
+func (m *WorkloadEndpointRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7381,15 +8671,25 @@ func (m *DataplaneInSync) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataplaneInSync) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n75, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
 	return i, nil
 }
 
-func (m *HostMetadataV4V6Update) Marshal() (dAtA []byte, err error) {
+func (m *HostEndpointID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7399,56 +8699,59 @@ func (m *HostMetadataV4V6Update) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostMetadataV4V6Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostEndpointID) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
-	}
-	if len(m.Ipv4Addr) > 0 {
+	if len(m.EndpointId) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
-		i += copy(dAtA[i:], m.Ipv4Addr)
-	}
-	if len(m.Ipv6Addr) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
-		i += copy(dAtA[i:], m.Ipv6Addr)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.EndpointId)))
+		i += copy(dAtA[i:], m.EndpointId)
 	}
-	if len(m.Asnumber) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Asnumber)))
-		i += copy(dAtA[i:], m.Asnumber)
+	return i, nil
+}
+
+func (m *HostEndpointUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Labels) > 0 {
-		for k, _ := range m.Labels {
-			dAtA[i] = 0x2a
-			i++
-			v := m.Labels[k]
-			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+	return dAtA[:n], nil
+}
+
+func (m *HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n76, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	if m.Endpoint != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Endpoint.Size()))
+		n77, err := m.Endpoint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n77
 	}
 	return i, nil
 }
 
-func (m *HostMetadataV4V6Remove) Marshal() (dAtA []byte, err error) {
+func (m *HostEndpoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7458,27 +8761,114 @@ func (m *HostMetadataV4V6Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostMetadataV4V6Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostEndpoint) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Ipv4Addr) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
-		i += copy(dAtA[i:], m.Ipv4Addr)
+	if len(m.ProfileIds) > 0 {
+		for _, s := range m.ProfileIds {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, msg := range m.Tiers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ExpectedIpv4Addrs) > 0 {
+		for _, s := range m.ExpectedIpv4Addrs {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExpectedIpv6Addrs) > 0 {
+		for _, s := range m.ExpectedIpv6Addrs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.UntrackedTiers) > 0 {
+		for _, msg := range m.UntrackedTiers {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PreDnatTiers) > 0 {
+		for _, msg := range m.PreDnatTiers {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ForwardTiers) > 0 {
+		for _, msg := range m.ForwardTiers {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *HostMetadataUpdate) Marshal() (dAtA []byte, err error) {
+func (m *HostEndpointRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7488,27 +8878,25 @@ func (m *HostMetadataUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
-	}
-	if len(m.Ipv4Addr) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
-		i += copy(dAtA[i:], m.Ipv4Addr)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n78, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
 	}
 	return i, nil
 }
 
-func (m *HostMetadataRemove) Marshal() (dAtA []byte, err error) {
+func (m *TierInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7518,27 +8906,51 @@ func (m *HostMetadataRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostMetadataRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *TierInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Ipv4Addr) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
-		i += copy(dAtA[i:], m.Ipv4Addr)
+	if len(m.IngressPolicies) > 0 {
+		for _, s := range m.IngressPolicies {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.EgressPolicies) > 0 {
+		for _, s := range m.EgressPolicies {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
 
-func (m *HostMetadataV6Update) Marshal() (dAtA []byte, err error) {
+func (m *NatInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7548,27 +8960,27 @@ func (m *HostMetadataV6Update) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostMetadataV6Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *NatInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if len(m.ExtIp) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ExtIp)))
+		i += copy(dAtA[i:], m.ExtIp)
 	}
-	if len(m.Ipv6Addr) > 0 {
+	if len(m.IntIp) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
-		i += copy(dAtA[i:], m.Ipv6Addr)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.IntIp)))
+		i += copy(dAtA[i:], m.IntIp)
 	}
 	return i, nil
 }
 
-func (m *HostMetadataV6Remove) Marshal() (dAtA []byte, err error) {
+func (m *ProcessStatusUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7578,27 +8990,27 @@ func (m *HostMetadataV6Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostMetadataV6Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProcessStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if len(m.IsoTimestamp) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.IsoTimestamp)))
+		i += copy(dAtA[i:], m.IsoTimestamp)
 	}
-	if len(m.Ipv6Addr) > 0 {
-		dAtA[i] = 0x12
+	if m.Uptime != 0 {
+		dAtA[i] = 0x11
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
-		i += copy(dAtA[i:], m.Ipv6Addr)
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Uptime))))
+		i += 8
 	}
 	return i, nil
 }
 
-func (m *IPAMPoolUpdate) Marshal() (dAtA []byte, err error) {
+func (m *HostEndpointStatusUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7608,31 +9020,35 @@ func (m *IPAMPoolUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IPAMPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.Pool != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Pool.Size()))
-		n78, err := m.Pool.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n79, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n79
+	}
+	if m.Status != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Status.Size()))
+		n80, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
 	}
 	return i, nil
 }
 
-func (m *IPAMPoolRemove) Marshal() (dAtA []byte, err error) {
+func (m *EndpointStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7642,21 +9058,21 @@ func (m *IPAMPoolRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IPAMPoolRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndpointStatus) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
+	if len(m.Status) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
 	}
 	return i, nil
 }
 
-func (m *IPAMPool) Marshal() (dAtA []byte, err error) {
+func (m *HostEndpointStatusRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7666,43 +9082,25 @@ func (m *IPAMPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IPAMPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cidr) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Cidr)))
-		i += copy(dAtA[i:], m.Cidr)
-	}
-	if m.Masquerade {
-		dAtA[i] = 0x10
-		i++
-		if m.Masquerade {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n81, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
-	}
-	if len(m.IpipMode) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.IpipMode)))
-		i += copy(dAtA[i:], m.IpipMode)
-	}
-	if len(m.VxlanMode) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.VxlanMode)))
-		i += copy(dAtA[i:], m.VxlanMode)
+		i += n81
 	}
 	return i, nil
 }
 
-func (m *Encapsulation) Marshal() (dAtA []byte, err error) {
+func (m *WorkloadEndpointStatusUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7712,45 +9110,35 @@ func (m *Encapsulation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Encapsulation) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.IpipEnabled {
-		dAtA[i] = 0x8
-		i++
-		if m.IpipEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.VxlanEnabled {
-		dAtA[i] = 0x10
+	if m.Id != nil {
+		dAtA[i] = 0xa
 		i++
-		if m.VxlanEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n82, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
+		i += n82
 	}
-	if m.VxlanEnabledV6 {
-		dAtA[i] = 0x18
+	if m.Status != nil {
+		dAtA[i] = 0x12
 		i++
-		if m.VxlanEnabledV6 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Status.Size()))
+		n83, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
+		i += n83
 	}
 	return i, nil
 }
 
-func (m *ServiceAccountUpdate) Marshal() (dAtA []byte, err error) {
+func (m *WorkloadEndpointStatusRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7760,7 +9148,7 @@ func (m *ServiceAccountUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7769,33 +9157,16 @@ func (m *ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n79, err := m.Id.MarshalTo(dAtA[i:])
+		n84, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
-	}
-	if len(m.Labels) > 0 {
-		for k, _ := range m.Labels {
-			dAtA[i] = 0x12
-			i++
-			v := m.Labels[k]
-			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+		i += n84
 	}
 	return i, nil
 }
 
-func (m *ServiceAccountRemove) Marshal() (dAtA []byte, err error) {
+func (m *WireguardStatusUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7805,25 +9176,26 @@ func (m *ServiceAccountRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.PublicKey) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n80, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n80
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.IpVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
 	}
 	return i, nil
 }
 
-func (m *ServiceAccountID) Marshal() (dAtA []byte, err error) {
+func (m *DataplaneInSync) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7833,27 +9205,15 @@ func (m *ServiceAccountID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServiceAccountID) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataplaneInSync) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
 	return i, nil
 }
 
-func (m *NamespaceUpdate) Marshal() (dAtA []byte, err error) {
+func (m *HostMetadataV4V6Update) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7863,24 +9223,38 @@ func (m *NamespaceUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostMetadataV4V6Update) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.Hostname) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n81, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n81
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Ipv4Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
+		i += copy(dAtA[i:], m.Ipv4Addr)
+	}
+	if len(m.Ipv6Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
+	}
+	if len(m.Asnumber) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Asnumber)))
+		i += copy(dAtA[i:], m.Asnumber)
 	}
 	if len(m.Labels) > 0 {
 		for k, _ := range m.Labels {
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 			i++
 			v := m.Labels[k]
 			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
@@ -7898,7 +9272,7 @@ func (m *NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *NamespaceRemove) Marshal() (dAtA []byte, err error) {
+func (m *HostMetadataV4V6Remove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7908,25 +9282,27 @@ func (m *NamespaceRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostMetadataV4V6Remove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
+	if len(m.Hostname) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n82, err := m.Id.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n82
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Ipv4Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
+		i += copy(dAtA[i:], m.Ipv4Addr)
 	}
 	return i, nil
 }
 
-func (m *NamespaceID) Marshal() (dAtA []byte, err error) {
+func (m *HostMetadataUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7936,21 +9312,27 @@ func (m *NamespaceID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceID) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if len(m.Hostname) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Ipv4Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
+		i += copy(dAtA[i:], m.Ipv4Addr)
 	}
 	return i, nil
 }
 
-func (m *TunnelType) Marshal() (dAtA []byte, err error) {
+func (m *HostMetadataRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7960,45 +9342,27 @@ func (m *TunnelType) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TunnelType) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostMetadataRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Ipip {
-		dAtA[i] = 0x8
-		i++
-		if m.Ipip {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
 		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	if m.Vxlan {
-		dAtA[i] = 0x10
-		i++
-		if m.Vxlan {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Wireguard {
-		dAtA[i] = 0x18
-		i++
-		if m.Wireguard {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Ipv4Addr) > 0 {
+		dAtA[i] = 0x12
 		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
+		i += copy(dAtA[i:], m.Ipv4Addr)
 	}
 	return i, nil
 }
 
-func (m *RouteUpdate) Marshal() (dAtA []byte, err error) {
+func (m *HostMetadataV6Update) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8008,83 +9372,27 @@ func (m *RouteUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostMetadataV6Update) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
-	}
-	if m.IpPoolType != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpPoolType))
-	}
-	if len(m.Dst) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Dst)))
-		i += copy(dAtA[i:], m.Dst)
-	}
-	if len(m.DstNodeName) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.DstNodeName)))
-		i += copy(dAtA[i:], m.DstNodeName)
-	}
-	if len(m.DstNodeIp) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.DstNodeIp)))
-		i += copy(dAtA[i:], m.DstNodeIp)
-	}
-	if m.SameSubnet {
-		dAtA[i] = 0x38
-		i++
-		if m.SameSubnet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.NatOutgoing {
-		dAtA[i] = 0x40
-		i++
-		if m.NatOutgoing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.LocalWorkload {
-		dAtA[i] = 0x48
-		i++
-		if m.LocalWorkload {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
 		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	if m.TunnelType != nil {
-		dAtA[i] = 0x52
+	if len(m.Ipv6Addr) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.TunnelType.Size()))
-		n83, err := m.TunnelType.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n83
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
 	}
 	return i, nil
 }
 
-func (m *RouteRemove) Marshal() (dAtA []byte, err error) {
+func (m *HostMetadataV6Remove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8094,21 +9402,27 @@ func (m *RouteRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RouteRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostMetadataV6Remove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Dst) > 0 {
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Ipv6Addr) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Dst)))
-		i += copy(dAtA[i:], m.Dst)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
 	}
 	return i, nil
 }
 
-func (m *VXLANTunnelEndpointUpdate) Marshal() (dAtA []byte, err error) {
+func (m *IPAMPoolUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8118,57 +9432,31 @@ func (m *VXLANTunnelEndpointUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VXLANTunnelEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *IPAMPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Node) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Node)))
-		i += copy(dAtA[i:], m.Node)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if len(m.Mac) > 0 {
+	if m.Pool != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Mac)))
-		i += copy(dAtA[i:], m.Mac)
-	}
-	if len(m.Ipv4Addr) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
-		i += copy(dAtA[i:], m.Ipv4Addr)
-	}
-	if len(m.ParentDeviceIp) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ParentDeviceIp)))
-		i += copy(dAtA[i:], m.ParentDeviceIp)
-	}
-	if len(m.MacV6) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.MacV6)))
-		i += copy(dAtA[i:], m.MacV6)
-	}
-	if len(m.Ipv6Addr) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
-		i += copy(dAtA[i:], m.Ipv6Addr)
-	}
-	if len(m.ParentDeviceIpv6) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ParentDeviceIpv6)))
-		i += copy(dAtA[i:], m.ParentDeviceIpv6)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Pool.Size()))
+		n85, err := m.Pool.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
 	}
 	return i, nil
 }
 
-func (m *VXLANTunnelEndpointRemove) Marshal() (dAtA []byte, err error) {
+func (m *IPAMPoolRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8178,21 +9466,21 @@ func (m *VXLANTunnelEndpointRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VXLANTunnelEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *IPAMPoolRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Node) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Node)))
-		i += copy(dAtA[i:], m.Node)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
 	return i, nil
 }
 
-func (m *WireguardEndpointUpdate) Marshal() (dAtA []byte, err error) {
+func (m *IPAMPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8202,57 +9490,49 @@ func (m *WireguardEndpointUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *IPAMPool) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if len(m.Cidr) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Cidr)))
+		i += copy(dAtA[i:], m.Cidr)
 	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x12
+	if m.Masquerade {
+		dAtA[i] = 0x10
+		i++
+		if m.Masquerade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
 	}
-	if len(m.InterfaceIpv4Addr) > 0 {
+	if len(m.IpipMode) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceIpv4Addr)))
-		i += copy(dAtA[i:], m.InterfaceIpv4Addr)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.IpipMode)))
+		i += copy(dAtA[i:], m.IpipMode)
 	}
-	return i, nil
-}
-
-func (m *WireguardEndpointRemove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.VxlanMode) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.VxlanMode)))
+		i += copy(dAtA[i:], m.VxlanMode)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WireguardEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hostname) > 0 {
-		dAtA[i] = 0xa
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *WireguardEndpointV6Update) Marshal() (dAtA []byte, err error) {
+func (m *Encapsulation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8262,33 +9542,45 @@ func (m *WireguardEndpointV6Update) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WireguardEndpointV6Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *Encapsulation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
-		dAtA[i] = 0xa
+	if m.IpipEnabled {
+		dAtA[i] = 0x8
+		i++
+		if m.IpipEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
 	}
-	if len(m.PublicKeyV6) > 0 {
-		dAtA[i] = 0x12
+	if m.VxlanEnabled {
+		dAtA[i] = 0x10
+		i++
+		if m.VxlanEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKeyV6)))
-		i += copy(dAtA[i:], m.PublicKeyV6)
 	}
-	if len(m.InterfaceIpv6Addr) > 0 {
-		dAtA[i] = 0x1a
+	if m.VxlanEnabledV6 {
+		dAtA[i] = 0x18
+		i++
+		if m.VxlanEnabledV6 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceIpv6Addr)))
-		i += copy(dAtA[i:], m.InterfaceIpv6Addr)
 	}
 	return i, nil
 }
 
-func (m *WireguardEndpointV6Remove) Marshal() (dAtA []byte, err error) {
+func (m *ServiceAccountUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8298,21 +9590,42 @@ func (m *WireguardEndpointV6Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WireguardEndpointV6Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hostname) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n86, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x12
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
 	return i, nil
 }
 
-func (m *GlobalBGPConfigUpdate) Marshal() (dAtA []byte, err error) {
+func (m *ServiceAccountRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8322,60 +9635,25 @@ func (m *GlobalBGPConfigUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobalBGPConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ServiceClusterCidrs) > 0 {
-		for _, s := range m.ServiceClusterCidrs {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.ServiceExternalCidrs) > 0 {
-		for _, s := range m.ServiceExternalCidrs {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.ServiceLoadbalancerCidrs) > 0 {
-		for _, s := range m.ServiceLoadbalancerCidrs {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n87, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n87
 	}
 	return i, nil
 }
 
-func (m *ServicePort) Marshal() (dAtA []byte, err error) {
+func (m *ServiceAccountID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8385,31 +9663,27 @@ func (m *ServicePort) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServicePort) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceAccountID) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Protocol) > 0 {
+	if len(m.Namespace) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Protocol)))
-		i += copy(dAtA[i:], m.Protocol)
-	}
-	if m.Port != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Port))
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
 	}
-	if m.NodePort != 0 {
-		dAtA[i] = 0x18
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NodePort))
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *ServiceUpdate) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8419,72 +9693,42 @@ func (m *ServiceUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServiceUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
-	}
-	if len(m.ClusterIp) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ClusterIp)))
-		i += copy(dAtA[i:], m.ClusterIp)
-	}
-	if len(m.LoadbalancerIp) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.LoadbalancerIp)))
-		i += copy(dAtA[i:], m.LoadbalancerIp)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n88, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n88
 	}
-	if len(m.ExternalIps) > 0 {
-		for _, s := range m.ExternalIps {
-			dAtA[i] = 0x32
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x12
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
 			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Ports) > 0 {
-		for _, msg := range m.Ports {
-			dAtA[i] = 0x3a
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
 	return i, nil
 }
 
-func (m *ServiceRemove) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8494,387 +9738,637 @@ func (m *ServiceRemove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServiceRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if m.Id != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n89, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
 	}
 	return i, nil
 }
 
-func encodeVarintFelixbackend(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NamespaceID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *SyncRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ToDataplane) Size() (n int) {
+func (m *NamespaceID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Payload != nil {
-		n += m.Payload.Size()
-	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sovFelixbackend(uint64(m.SequenceNumber))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ToDataplane_InSync) Size() (n int) {
-	var l int
-	_ = l
-	if m.InSync != nil {
-		l = m.InSync.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+func (m *TunnelType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_IpsetUpdate) Size() (n int) {
+
+func (m *TunnelType) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.IpsetUpdate != nil {
-		l = m.IpsetUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Ipip {
+		dAtA[i] = 0x8
+		i++
+		if m.Ipip {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-func (m *ToDataplane_IpsetDeltaUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpsetDeltaUpdate != nil {
-		l = m.IpsetDeltaUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Vxlan {
+		dAtA[i] = 0x10
+		i++
+		if m.Vxlan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-func (m *ToDataplane_IpsetRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpsetRemove != nil {
-		l = m.IpsetRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Wireguard {
+		dAtA[i] = 0x18
+		i++
+		if m.Wireguard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_ActiveProfileUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ActiveProfileUpdate != nil {
-		l = m.ActiveProfileUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+
+func (m *RouteUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_ActiveProfileRemove) Size() (n int) {
+
+func (m *RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActiveProfileRemove != nil {
-		l = m.ActiveProfileRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
 	}
-	return n
-}
-func (m *ToDataplane_ActivePolicyUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ActivePolicyUpdate != nil {
-		l = m.ActivePolicyUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.IpPoolType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpPoolType))
 	}
-	return n
-}
-func (m *ToDataplane_ActivePolicyRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.ActivePolicyRemove != nil {
-		l = m.ActivePolicyRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.Dst) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Dst)))
+		i += copy(dAtA[i:], m.Dst)
 	}
-	return n
-}
-func (m *ToDataplane_HostEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostEndpointUpdate != nil {
-		l = m.HostEndpointUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstNodeName) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.DstNodeName)))
+		i += copy(dAtA[i:], m.DstNodeName)
 	}
-	return n
-}
-func (m *ToDataplane_HostEndpointRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostEndpointRemove != nil {
-		l = m.HostEndpointRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstNodeIp) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.DstNodeIp)))
+		i += copy(dAtA[i:], m.DstNodeIp)
 	}
-	return n
-}
-func (m *ToDataplane_WorkloadEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.WorkloadEndpointUpdate != nil {
-		l = m.WorkloadEndpointUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.SameSubnet {
+		dAtA[i] = 0x38
+		i++
+		if m.SameSubnet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-func (m *ToDataplane_WorkloadEndpointRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.WorkloadEndpointRemove != nil {
-		l = m.WorkloadEndpointRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NatOutgoing {
+		dAtA[i] = 0x40
+		i++
+		if m.NatOutgoing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-func (m *ToDataplane_ConfigUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ConfigUpdate != nil {
-		l = m.ConfigUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.LocalWorkload {
+		dAtA[i] = 0x48
+		i++
+		if m.LocalWorkload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-func (m *ToDataplane_HostMetadataUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostMetadataUpdate != nil {
-		l = m.HostMetadataUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.TunnelType != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.TunnelType.Size()))
+		n90, err := m.TunnelType.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_IpamPoolUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpamPoolUpdate != nil {
-		l = m.IpamPoolUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *RouteRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_IpamPoolRemove) Size() (n int) {
+
+func (m *RouteRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.IpamPoolRemove != nil {
-		l = m.IpamPoolRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Dst) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Dst)))
+		i += copy(dAtA[i:], m.Dst)
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_HostMetadataRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostMetadataRemove != nil {
-		l = m.HostMetadataRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *VXLANTunnelEndpointUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_ServiceAccountUpdate) Size() (n int) {
+
+func (m *VXLANTunnelEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ServiceAccountUpdate != nil {
-		l = m.ServiceAccountUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Node) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Node)))
+		i += copy(dAtA[i:], m.Node)
 	}
-	return n
-}
-func (m *ToDataplane_ServiceAccountRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.ServiceAccountRemove != nil {
-		l = m.ServiceAccountRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Mac) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Mac)))
+		i += copy(dAtA[i:], m.Mac)
 	}
-	return n
-}
-func (m *ToDataplane_NamespaceUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.NamespaceUpdate != nil {
-		l = m.NamespaceUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Ipv4Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv4Addr)))
+		i += copy(dAtA[i:], m.Ipv4Addr)
 	}
-	return n
-}
-func (m *ToDataplane_NamespaceRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.NamespaceRemove != nil {
-		l = m.NamespaceRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.ParentDeviceIp) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ParentDeviceIp)))
+		i += copy(dAtA[i:], m.ParentDeviceIp)
 	}
-	return n
-}
-func (m *ToDataplane_RouteUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.RouteUpdate != nil {
-		l = m.RouteUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.MacV6) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.MacV6)))
+		i += copy(dAtA[i:], m.MacV6)
 	}
-	return n
-}
-func (m *ToDataplane_RouteRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.RouteRemove != nil {
-		l = m.RouteRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Ipv6Addr) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
 	}
-	return n
+	if len(m.ParentDeviceIpv6) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ParentDeviceIpv6)))
+		i += copy(dAtA[i:], m.ParentDeviceIpv6)
+	}
+	return i, nil
 }
-func (m *ToDataplane_VtepUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.VtepUpdate != nil {
-		l = m.VtepUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *VXLANTunnelEndpointRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_VtepRemove) Size() (n int) {
+
+func (m *VXLANTunnelEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.VtepRemove != nil {
-		l = m.VtepRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Node) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Node)))
+		i += copy(dAtA[i:], m.Node)
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.WireguardEndpointUpdate != nil {
-		l = m.WireguardEndpointUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *WireguardEndpointUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_WireguardEndpointRemove) Size() (n int) {
+
+func (m *WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.WireguardEndpointRemove != nil {
-		l = m.WireguardEndpointRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if len(m.InterfaceIpv4Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceIpv4Addr)))
+		i += copy(dAtA[i:], m.InterfaceIpv4Addr)
+	}
+	return i, nil
 }
-func (m *ToDataplane_GlobalBgpConfigUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.GlobalBgpConfigUpdate != nil {
-		l = m.GlobalBgpConfigUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *WireguardEndpointRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_Encapsulation) Size() (n int) {
+
+func (m *WireguardEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Encapsulation != nil {
-		l = m.Encapsulation.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_ServiceUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ServiceUpdate != nil {
-		l = m.ServiceUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *WireguardEndpointV6Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_ServiceRemove) Size() (n int) {
+
+func (m *WireguardEndpointV6Update) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ServiceRemove != nil {
-		l = m.ServiceRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	if len(m.PublicKeyV6) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKeyV6)))
+		i += copy(dAtA[i:], m.PublicKeyV6)
+	}
+	if len(m.InterfaceIpv6Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceIpv6Addr)))
+		i += copy(dAtA[i:], m.InterfaceIpv6Addr)
+	}
+	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointV6Update) Size() (n int) {
-	var l int
-	_ = l
-	if m.WireguardEndpointV6Update != nil {
-		l = m.WireguardEndpointV6Update.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *WireguardEndpointV6Remove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_WireguardEndpointV6Remove) Size() (n int) {
+
+func (m *WireguardEndpointV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.WireguardEndpointV6Remove != nil {
-		l = m.WireguardEndpointV6Remove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_HostMetadataV6Update) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostMetadataV6Update != nil {
-		l = m.HostMetadataV6Update.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *GlobalBGPConfigUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_HostMetadataV6Remove) Size() (n int) {
+
+func (m *GlobalBGPConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.HostMetadataV6Remove != nil {
-		l = m.HostMetadataV6Remove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	return n
+	if len(m.ServiceClusterCidrs) > 0 {
+		for _, s := range m.ServiceClusterCidrs {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ServiceExternalCidrs) > 0 {
+		for _, s := range m.ServiceExternalCidrs {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ServiceLoadbalancerCidrs) > 0 {
+		for _, s := range m.ServiceLoadbalancerCidrs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
 }
-func (m *ToDataplane_HostMetadataV4V6Update) Size() (n int) {
+
+func (m *ServicePort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServicePort) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.HostMetadataV4V6Update != nil {
-		l = m.HostMetadataV4V6Update.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
 	}
-	return n
+	if m.Port != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Port))
+	}
+	if m.NodePort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NodePort))
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
 }
-func (m *ToDataplane_HostMetadataV4V6Remove) Size() (n int) {
+
+func (m *ServiceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.HostMetadataV4V6Remove != nil {
-		l = m.HostMetadataV4V6Remove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.ClusterIp) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ClusterIp)))
+		i += copy(dAtA[i:], m.ClusterIp)
+	}
+	if len(m.LoadbalancerIp) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.LoadbalancerIp)))
+		i += copy(dAtA[i:], m.LoadbalancerIp)
+	}
+	if len(m.ExternalIps) > 0 {
+		for _, s := range m.ExternalIps {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ServiceRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	return i, nil
+}
+
+func encodeVarintFelixbackend(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *SyncRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterState {
+		n += 2
 	}
 	return n
 }
-func (m *FromDataplane) Size() (n int) {
+
+func (m *ToDataplane) Size() (n int) {
 	var l int
 	_ = l
 	if m.Payload != nil {
@@ -8886,1491 +10380,3483 @@ func (m *FromDataplane) Size() (n int) {
 	return n
 }
 
-func (m *FromDataplane_ProcessStatusUpdate) Size() (n int) {
+func (m *ToDataplane_InSync) Size() (n int) {
 	var l int
 	_ = l
-	if m.ProcessStatusUpdate != nil {
-		l = m.ProcessStatusUpdate.Size()
+	if m.InSync != nil {
+		l = m.InSync.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_HostEndpointStatusUpdate) Size() (n int) {
+func (m *ToDataplane_IpsetUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.HostEndpointStatusUpdate != nil {
-		l = m.HostEndpointStatusUpdate.Size()
+	if m.IpsetUpdate != nil {
+		l = m.IpsetUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_HostEndpointStatusRemove) Size() (n int) {
+func (m *ToDataplane_IpsetDeltaUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.HostEndpointStatusRemove != nil {
-		l = m.HostEndpointStatusRemove.Size()
+	if m.IpsetDeltaUpdate != nil {
+		l = m.IpsetDeltaUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_WorkloadEndpointStatusUpdate) Size() (n int) {
+func (m *ToDataplane_IpsetRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.WorkloadEndpointStatusUpdate != nil {
-		l = m.WorkloadEndpointStatusUpdate.Size()
+	if m.IpsetRemove != nil {
+		l = m.IpsetRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_WorkloadEndpointStatusRemove) Size() (n int) {
+func (m *ToDataplane_ActiveProfileUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.WorkloadEndpointStatusRemove != nil {
-		l = m.WorkloadEndpointStatusRemove.Size()
+	if m.ActiveProfileUpdate != nil {
+		l = m.ActiveProfileUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_WireguardStatusUpdate) Size() (n int) {
+func (m *ToDataplane_ActiveProfileRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.WireguardStatusUpdate != nil {
-		l = m.WireguardStatusUpdate.Size()
+	if m.ActiveProfileRemove != nil {
+		l = m.ActiveProfileRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_DataplaneInSync) Size() (n int) {
+func (m *ToDataplane_ActivePolicyUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.DataplaneInSync != nil {
-		l = m.DataplaneInSync.Size()
+	if m.ActivePolicyUpdate != nil {
+		l = m.ActivePolicyUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *ConfigUpdate) Size() (n int) {
+func (m *ToDataplane_ActivePolicyRemove) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Config) > 0 {
-		for k, v := range m.Config {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
-	}
-	if len(m.SourceToRawConfig) > 0 {
-		for k, v := range m.SourceToRawConfig {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovFelixbackend(uint64(l))
-			}
-			mapEntrySize := 1 + sovFelixbackend(uint64(k)) + l
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.Message)
-	if l > 0 {
+	if m.ActivePolicyRemove != nil {
+		l = m.ActivePolicyRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *RawConfig) Size() (n int) {
+func (m *ToDataplane_HostEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
+	if m.HostEndpointUpdate != nil {
+		l = m.HostEndpointUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.Config) > 0 {
-		for k, v := range m.Config {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
-	}
 	return n
 }
-
-func (m *InSync) Size() (n int) {
+func (m *ToDataplane_HostEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
+	if m.HostEndpointRemove != nil {
+		l = m.HostEndpointRemove.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-
-func (m *IPSetUpdate) Size() (n int) {
+func (m *ToDataplane_WorkloadEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.WorkloadEndpointUpdate != nil {
+		l = m.WorkloadEndpointUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.Type != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Type))
-	}
 	return n
 }
-
-func (m *IPSetDeltaUpdate) Size() (n int) {
+func (m *ToDataplane_WorkloadEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.WorkloadEndpointRemove != nil {
+		l = m.WorkloadEndpointRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.AddedMembers) > 0 {
-		for _, s := range m.AddedMembers {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.RemovedMembers) > 0 {
-		for _, s := range m.RemovedMembers {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
 	return n
 }
-
-func (m *IPSetRemove) Size() (n int) {
+func (m *ToDataplane_ConfigUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.ConfigUpdate != nil {
+		l = m.ConfigUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActiveProfileUpdate) Size() (n int) {
+func (m *ToDataplane_HostMetadataUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if m.Profile != nil {
-		l = m.Profile.Size()
+	if m.HostMetadataUpdate != nil {
+		l = m.HostMetadataUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActiveProfileRemove) Size() (n int) {
+func (m *ToDataplane_IpamPoolUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.IpamPoolUpdate != nil {
+		l = m.IpamPoolUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ProfileID) Size() (n int) {
+func (m *ToDataplane_IpamPoolRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.IpamPoolRemove != nil {
+		l = m.IpamPoolRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Profile) Size() (n int) {
+func (m *ToDataplane_HostMetadataRemove) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, e := range m.InboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.OutboundRules) > 0 {
-		for _, e := range m.OutboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.HostMetadataRemove != nil {
+		l = m.HostMetadataRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActivePolicyUpdate) Size() (n int) {
+func (m *ToDataplane_ServiceAccountUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.ServiceAccountUpdate != nil {
+		l = m.ServiceAccountUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActivePolicyRemove) Size() (n int) {
+func (m *ToDataplane_ServiceAccountRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.ServiceAccountRemove != nil {
+		l = m.ServiceAccountRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *PolicyID) Size() (n int) {
+func (m *ToDataplane_NamespaceUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Tier)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NamespaceUpdate != nil {
+		l = m.NamespaceUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Policy) Size() (n int) {
+func (m *ToDataplane_NamespaceRemove) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, e := range m.InboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.NamespaceRemove != nil {
+		l = m.NamespaceRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.OutboundRules) > 0 {
-		for _, e := range m.OutboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	return n
+}
+func (m *ToDataplane_RouteUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.RouteUpdate != nil {
+		l = m.RouteUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Untracked {
-		n += 2
+	return n
+}
+func (m *ToDataplane_RouteRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.RouteRemove != nil {
+		l = m.RouteRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.PreDnat {
-		n += 2
+	return n
+}
+func (m *ToDataplane_VtepUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.VtepUpdate != nil {
+		l = m.VtepUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *ToDataplane_VtepRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.VtepRemove != nil {
+		l = m.VtepRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalSelector)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *ToDataplane_WireguardEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.WireguardEndpointUpdate != nil {
+		l = m.WireguardEndpointUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Rule) Size() (n int) {
+func (m *ToDataplane_WireguardEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.WireguardEndpointRemove != nil {
+		l = m.WireguardEndpointRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.IpVersion != 0 {
-		n += 1 + sovFelixbackend(uint64(m.IpVersion))
+	return n
+}
+func (m *ToDataplane_GlobalBgpConfigUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.GlobalBgpConfigUpdate != nil {
+		l = m.GlobalBgpConfigUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Protocol != nil {
-		l = m.Protocol.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.SrcNet) > 0 {
-		for _, s := range m.SrcNet {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.SrcPorts) > 0 {
-		for _, e := range m.SrcPorts {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstNet) > 0 {
-		for _, s := range m.DstNet {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstPorts) > 0 {
-		for _, e := range m.DstPorts {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.Icmp != nil {
-		n += m.Icmp.Size()
-	}
-	if len(m.SrcIpSetIds) > 0 {
-		for _, s := range m.SrcIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstIpSetIds) > 0 {
-		for _, s := range m.DstIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.SrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.SrcNamedPortIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstNamedPortIpSetIds) > 0 {
-		for _, s := range m.DstNamedPortIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstIpPortSetIds) > 0 {
-		for _, s := range m.DstIpPortSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.NotProtocol != nil {
-		l = m.NotProtocol.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.NotSrcNet) > 0 {
-		for _, s := range m.NotSrcNet {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotSrcPorts) > 0 {
-		for _, e := range m.NotSrcPorts {
-			l = e.Size()
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstNet) > 0 {
-		for _, s := range m.NotDstNet {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstPorts) > 0 {
-		for _, e := range m.NotDstPorts {
-			l = e.Size()
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.NotIcmp != nil {
-		n += m.NotIcmp.Size()
-	}
-	if len(m.NotSrcIpSetIds) > 0 {
-		for _, s := range m.NotSrcIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstIpSetIds) > 0 {
-		for _, s := range m.NotDstIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotSrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotSrcNamedPortIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotDstNamedPortIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	l = len(m.OriginalSrcSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalDstSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalSrcNamespaceSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalDstNamespaceSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalNotSrcSelector)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_Encapsulation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Encapsulation != nil {
+		l = m.Encapsulation.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalNotDstSelector)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_ServiceUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.ServiceUpdate != nil {
+		l = m.ServiceUpdate.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.SrcServiceAccountMatch != nil {
-		l = m.SrcServiceAccountMatch.Size()
+	return n
+}
+func (m *ToDataplane_ServiceRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.ServiceRemove != nil {
+		l = m.ServiceRemove.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.DstServiceAccountMatch != nil {
-		l = m.DstServiceAccountMatch.Size()
+	return n
+}
+func (m *ToDataplane_WireguardEndpointV6Update) Size() (n int) {
+	var l int
+	_ = l
+	if m.WireguardEndpointV6Update != nil {
+		l = m.WireguardEndpointV6Update.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.HttpMatch != nil {
-		l = m.HttpMatch.Size()
+	return n
+}
+func (m *ToDataplane_WireguardEndpointV6Remove) Size() (n int) {
+	var l int
+	_ = l
+	if m.WireguardEndpointV6Remove != nil {
+		l = m.WireguardEndpointV6Remove.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	return n
+}
+func (m *ToDataplane_HostMetadataV6Update) Size() (n int) {
+	var l int
+	_ = l
+	if m.HostMetadataV6Update != nil {
+		l = m.HostMetadataV6Update.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalDstService)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_HostMetadataV6Remove) Size() (n int) {
+	var l int
+	_ = l
+	if m.HostMetadataV6Remove != nil {
+		l = m.HostMetadataV6Remove.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalDstServiceNamespace)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_HostMetadataV4V6Update) Size() (n int) {
+	var l int
+	_ = l
+	if m.HostMetadataV4V6Update != nil {
+		l = m.HostMetadataV4V6Update.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalSrcService)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_HostMetadataV4V6Remove) Size() (n int) {
+	var l int
+	_ = l
+	if m.HostMetadataV4V6Remove != nil {
+		l = m.HostMetadataV4V6Remove.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalSrcServiceNamespace)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *FromDataplane) Size() (n int) {
+	var l int
+	_ = l
+	if m.Payload != nil {
+		n += m.Payload.Size()
 	}
-	l = len(m.RuleId)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if m.SequenceNumber != 0 {
+		n += 1 + sovFelixbackend(uint64(m.SequenceNumber))
 	}
 	return n
 }
 
-func (m *Rule_IcmpType) Size() (n int) {
+func (m *FromDataplane_ProcessStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	n += 1 + sovFelixbackend(uint64(m.IcmpType))
+	if m.ProcessStatusUpdate != nil {
+		l = m.ProcessStatusUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *Rule_IcmpTypeCode) Size() (n int) {
+func (m *FromDataplane_HostEndpointStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.IcmpTypeCode != nil {
-		l = m.IcmpTypeCode.Size()
+	if m.HostEndpointStatusUpdate != nil {
+		l = m.HostEndpointStatusUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *Rule_NotIcmpType) Size() (n int) {
+func (m *FromDataplane_HostEndpointStatusRemove) Size() (n int) {
 	var l int
 	_ = l
-	n += 2 + sovFelixbackend(uint64(m.NotIcmpType))
+	if m.HostEndpointStatusRemove != nil {
+		l = m.HostEndpointStatusRemove.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *Rule_NotIcmpTypeCode) Size() (n int) {
+func (m *FromDataplane_WorkloadEndpointStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.NotIcmpTypeCode != nil {
-		l = m.NotIcmpTypeCode.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if m.WorkloadEndpointStatusUpdate != nil {
+		l = m.WorkloadEndpointStatusUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *ServiceAccountMatch) Size() (n int) {
+func (m *FromDataplane_WorkloadEndpointStatusRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Selector)
-	if l > 0 {
+	if m.WorkloadEndpointStatusRemove != nil {
+		l = m.WorkloadEndpointStatusRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.Names) > 0 {
-		for _, s := range m.Names {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
 	return n
 }
-
-func (m *HTTPMatch) Size() (n int) {
+func (m *FromDataplane_WireguardStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Methods) > 0 {
-		for _, s := range m.Methods {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Paths) > 0 {
-		for _, e := range m.Paths {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.WireguardStatusUpdate != nil {
+		l = m.WireguardStatusUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *HTTPMatch_PathMatch) Size() (n int) {
+func (m *FromDataplane_DataplaneInSync) Size() (n int) {
 	var l int
 	_ = l
-	if m.PathMatch != nil {
-		n += m.PathMatch.Size()
+	if m.DataplaneInSync != nil {
+		l = m.DataplaneInSync.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *HTTPMatch_PathMatch_Exact) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Exact)
-	n += 1 + l + sovFelixbackend(uint64(l))
-	return n
-}
-func (m *HTTPMatch_PathMatch_Prefix) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Prefix)
-	n += 1 + l + sovFelixbackend(uint64(l))
-	return n
-}
-func (m *RuleMetadata) Size() (n int) {
+func (m *ConfigUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
+	if len(m.Config) > 0 {
+		for k, v := range m.Config {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
 			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
 		}
 	}
+	if len(m.SourceToRawConfig) > 0 {
+		for k, v := range m.SourceToRawConfig {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFelixbackend(uint64(l))
+			}
+			mapEntrySize := 1 + sovFelixbackend(uint64(k)) + l
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
 
-func (m *IcmpTypeAndCode) Size() (n int) {
+func (m *RawConfig) Size() (n int) {
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Type))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Code != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Code))
+	if len(m.Config) > 0 {
+		for k, v := range m.Config {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *Protocol) Size() (n int) {
+func (m *InSync) Size() (n int) {
 	var l int
 	_ = l
-	if m.NumberOrName != nil {
-		n += m.NumberOrName.Size()
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *Protocol_Number) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovFelixbackend(uint64(m.Number))
-	return n
-}
-func (m *Protocol_Name) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovFelixbackend(uint64(l))
-	return n
-}
-func (m *PortRange) Size() (n int) {
+func (m *IPSetUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.First != 0 {
-		n += 1 + sovFelixbackend(uint64(m.First))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Last != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Last))
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.Type != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Type))
 	}
 	return n
 }
 
-func (m *WorkloadEndpointID) Size() (n int) {
+func (m *IPSetDeltaUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.OrchestratorId)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.WorkloadId)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.AddedMembers) > 0 {
+		for _, s := range m.AddedMembers {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	l = len(m.EndpointId)
+	if len(m.RemovedMembers) > 0 {
+		for _, s := range m.RemovedMembers {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IPSetRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *WorkloadEndpointUpdate) Size() (n int) {
+func (m *ActiveProfileUpdate) Size() (n int) {
 	var l int
 	_ = l
 	if m.Id != nil {
 		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Endpoint != nil {
-		l = m.Endpoint.Size()
+	if m.Profile != nil {
+		l = m.Profile.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *WorkloadEndpoint) Size() (n int) {
+func (m *ActiveProfileRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.State)
-	if l > 0 {
+	if m.Id != nil {
+		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
+	return n
+}
+
+func (m *ProfileID) Size() (n int) {
+	var l int
+	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Mac)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.ProfileIds) > 0 {
-		for _, s := range m.ProfileIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Ipv4Nets) > 0 {
-		for _, s := range m.Ipv4Nets {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Ipv6Nets) > 0 {
-		for _, s := range m.Ipv6Nets {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Tiers) > 0 {
-		for _, e := range m.Tiers {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Ipv4Nat) > 0 {
-		for _, e := range m.Ipv4Nat {
+	return n
+}
+
+func (m *Profile) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.InboundRules) > 0 {
+		for _, e := range m.InboundRules {
 			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.Ipv6Nat) > 0 {
-		for _, e := range m.Ipv6Nat {
+	if len(m.OutboundRules) > 0 {
+		for _, e := range m.OutboundRules {
 			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.AllowSpoofedSourcePrefixes) > 0 {
-		for _, s := range m.AllowSpoofedSourcePrefixes {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
-	}
 	return n
 }
 
-func (m *WorkloadEndpointRemove) Size() (n int) {
+func (m *ActivePolicyUpdate) Size() (n int) {
 	var l int
 	_ = l
 	if m.Id != nil {
 		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
 
-func (m *HostEndpointID) Size() (n int) {
+func (m *ActivePolicyRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.EndpointId)
-	if l > 0 {
+	if m.Id != nil {
+		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostEndpointUpdate) Size() (n int) {
+func (m *PolicyID) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
+	l = len(m.Tier)
+	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Endpoint != nil {
-		l = m.Endpoint.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostEndpoint) Size() (n int) {
+func (m *Policy) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.ProfileIds) > 0 {
-		for _, s := range m.ProfileIds {
-			l = len(s)
+	if len(m.InboundRules) > 0 {
+		for _, e := range m.InboundRules {
+			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.Tiers) > 0 {
-		for _, e := range m.Tiers {
+	if len(m.OutboundRules) > 0 {
+		for _, e := range m.OutboundRules {
 			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ExpectedIpv4Addrs) > 0 {
-		for _, s := range m.ExpectedIpv4Addrs {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.Untracked {
+		n += 2
 	}
-	if len(m.ExpectedIpv6Addrs) > 0 {
-		for _, s := range m.ExpectedIpv6Addrs {
+	if m.PreDnat {
+		n += 2
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.OriginalSelector)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *Rule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.IpVersion != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IpVersion))
+	}
+	if m.Protocol != nil {
+		l = m.Protocol.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.SrcNet) > 0 {
+		for _, s := range m.SrcNet {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.UntrackedTiers) > 0 {
-		for _, e := range m.UntrackedTiers {
+	if len(m.SrcPorts) > 0 {
+		for _, e := range m.SrcPorts {
 			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.PreDnatTiers) > 0 {
-		for _, e := range m.PreDnatTiers {
-			l = e.Size()
+	if len(m.DstNet) > 0 {
+		for _, s := range m.DstNet {
+			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ForwardTiers) > 0 {
-		for _, e := range m.ForwardTiers {
+	if len(m.DstPorts) > 0 {
+		for _, e := range m.DstPorts {
 			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *HostEndpointRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	return n
-}
-
-func (m *TierInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Icmp != nil {
+		n += m.Icmp.Size()
 	}
-	if len(m.IngressPolicies) > 0 {
-		for _, s := range m.IngressPolicies {
+	if len(m.SrcIpSetIds) > 0 {
+		for _, s := range m.SrcIpSetIds {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.EgressPolicies) > 0 {
-		for _, s := range m.EgressPolicies {
+	if len(m.DstIpSetIds) > 0 {
+		for _, s := range m.DstIpSetIds {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *NatInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ExtIp)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.SrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.SrcNamedPortIpSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	l = len(m.IntIp)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstNamedPortIpSetIds) > 0 {
+		for _, s := range m.DstNamedPortIpSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *ProcessStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.IsoTimestamp)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstIpPortSetIds) > 0 {
+		for _, s := range m.DstIpPortSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Uptime != 0 {
-		n += 9
+	if m.NotProtocol != nil {
+		l = m.NotProtocol.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *HostEndpointStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotSrcNet) > 0 {
+		for _, s := range m.NotSrcNet {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotSrcPorts) > 0 {
+		for _, e := range m.NotSrcPorts {
+			l = e.Size()
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *EndpointStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotDstNet) > 0 {
+		for _, s := range m.NotDstNet {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *HostEndpointStatusRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotDstPorts) > 0 {
+		for _, e := range m.NotDstPorts {
+			l = e.Size()
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *WorkloadEndpointStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NotIcmp != nil {
+		n += m.NotIcmp.Size()
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotSrcIpSetIds) > 0 {
+		for _, s := range m.NotSrcIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *WorkloadEndpointStatusRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotDstIpSetIds) > 0 {
+		for _, s := range m.NotDstIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *WireguardStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotSrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotSrcNamedPortIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.IpVersion != 0 {
-		n += 1 + sovFelixbackend(uint64(m.IpVersion))
+	if len(m.NotDstNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotDstNamedPortIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *DataplaneInSync) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *HostMetadataV4V6Update) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
+	l = len(m.OriginalSrcSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv4Addr)
+	l = len(m.OriginalDstSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv6Addr)
+	l = len(m.OriginalSrcNamespaceSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Asnumber)
+	l = len(m.OriginalDstNamespaceSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
+	l = len(m.OriginalNotSrcSelector)
+	if l > 0 {
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *HostMetadataV4V6Remove) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
+	l = len(m.OriginalNotDstSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv4Addr)
+	if m.SrcServiceAccountMatch != nil {
+		l = m.SrcServiceAccountMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
+	if m.DstServiceAccountMatch != nil {
+		l = m.DstServiceAccountMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
+	if m.HttpMatch != nil {
+		l = m.HttpMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.OriginalDstService)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *HostMetadataUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
+	l = len(m.OriginalDstServiceNamespace)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv4Addr)
+	l = len(m.OriginalSrcService)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *HostMetadataRemove) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
+	l = len(m.OriginalSrcServiceNamespace)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv4Addr)
+	if len(m.DstAnnotations) > 0 {
+		for k, v := range m.DstAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 2 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AppProtocols) > 0 {
+		for _, s := range m.AppProtocols {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.SrcIsLocalNode {
+		n += 3
+	}
+	if len(m.JwtAudiences) > 0 {
+		for _, s := range m.JwtAudiences {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.RouteNames) > 0 {
+		for _, s := range m.RouteNames {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.SrcIpPools) > 0 {
+		for _, s := range m.SrcIpPools {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.DstServicePorts) > 0 {
+		for _, s := range m.DstServicePorts {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.DirectRemoteNet) > 0 {
+		for _, s := range m.DirectRemoteNet {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.DstEncapsulations) > 0 {
+		for _, s := range m.DstEncapsulations {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.TlsTerminated {
+		n += 3
+	}
+	if m.DstReady {
+		n += 3
+	}
+	if len(m.GrpcCallTypes) > 0 {
+		for _, s := range m.GrpcCallTypes {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.SrcIpSetAddedWithinSecs != 0 {
+		n += 2 + sovFelixbackend(uint64(m.SrcIpSetAddedWithinSecs))
+	}
+	if m.DstIpSetAddedWithinSecs != 0 {
+		n += 2 + sovFelixbackend(uint64(m.DstIpSetAddedWithinSecs))
+	}
+	if m.SrcIpSetCardinalityAbove != 0 {
+		n += 2 + sovFelixbackend(uint64(m.SrcIpSetCardinalityAbove))
+	}
+	l = len(m.RequestLabelSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.SrcNamespaceLabels) > 0 {
+		for k, v := range m.SrcNamespaceLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 2 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	if m.DstInLocalIpamBlock {
+		n += 3
+	}
+	if len(m.TlsFingerprints) > 0 {
+		for _, s := range m.TlsFingerprints {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.NotTlsFingerprints) > 0 {
+		for _, s := range m.NotTlsFingerprints {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.GrpcMatch != nil {
+		l = m.GrpcMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
+	if m.AllowHairpin {
+		n += 3
+	}
+	if m.DstListening {
+		n += 3
+	}
+	if len(m.SrcPrincipalPrefixes) > 0 {
+		for _, s := range m.SrcPrincipalPrefixes {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.SrcPrincipalSuffixes) > 0 {
+		for _, s := range m.SrcPrincipalSuffixes {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.DstPrincipalPrefixes) > 0 {
+		for _, s := range m.DstPrincipalPrefixes {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.DstPrincipalSuffixes) > 0 {
+		for _, s := range m.DstPrincipalSuffixes {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.SrcZones) > 0 {
+		for _, s := range m.SrcZones {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.SrcRegions) > 0 {
+		for _, s := range m.SrcRegions {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.DstReverseDnsNames) > 0 {
+		for _, s := range m.DstReverseDnsNames {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.NotDstIpPortSetIds) > 0 {
+		for _, s := range m.NotDstIpPortSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.SrcIpPortSetIds) > 0 {
+		for _, s := range m.SrcIpPortSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.NotSrcIpPortSetIds) > 0 {
+		for _, s := range m.NotSrcIpPortSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.ConnectionReused {
+		n += 3
+	}
+	if len(m.SrcAsNumbers) > 0 {
+		l = 0
+		for _, e := range m.SrcAsNumbers {
+			l += sovFelixbackend(uint64(e))
+		}
+		n += 2 + sovFelixbackend(uint64(l)) + l
+	}
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostMetadataV6Update) Size() (n int) {
+func (m *Rule_IcmpType) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Ipv6Addr)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
+	n += 1 + sovFelixbackend(uint64(m.IcmpType))
 	return n
 }
-
-func (m *HostMetadataV6Remove) Size() (n int) {
+func (m *Rule_IcmpTypeCode) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Ipv6Addr)
-	if l > 0 {
+	if m.IcmpTypeCode != nil {
+		l = m.IcmpTypeCode.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *IPAMPoolUpdate) Size() (n int) {
+func (m *Rule_NotIcmpType) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
+	n += 2 + sovFelixbackend(uint64(m.NotIcmpType))
 	return n
 }
-
-func (m *IPAMPoolRemove) Size() (n int) {
+func (m *Rule_NotIcmpTypeCode) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NotIcmpTypeCode != nil {
+		l = m.NotIcmpTypeCode.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *IPAMPool) Size() (n int) {
+func (m *ServiceAccountMatch) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Cidr)
+	l = len(m.Selector)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Masquerade {
-		n += 2
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	l = len(m.IpipMode)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.TrustDomains) > 0 {
+		for _, s := range m.TrustDomains {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	l = len(m.VxlanMode)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.GlobNames {
+		n += 2
 	}
 	return n
 }
 
-func (m *Encapsulation) Size() (n int) {
+func (m *HTTPMatch) Size() (n int) {
 	var l int
 	_ = l
-	if m.IpipEnabled {
-		n += 2
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.VxlanEnabled {
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ContentTypes) > 0 {
+		for _, s := range m.ContentTypes {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.IgnoreTrailingSlash {
 		n += 2
 	}
-	if m.VxlanEnabledV6 {
+	if len(m.QueryParams) > 0 {
+		for _, e := range m.QueryParams {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.CaseInsensitive {
 		n += 2
 	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Upgrades) > 0 {
+		for _, s := range m.Upgrades {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.NotMethods) > 0 {
+		for _, s := range m.NotMethods {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.NotPaths) > 0 {
+		for _, e := range m.NotPaths {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.MaxBodyBytes != 0 {
+		n += 1 + sovFelixbackend(uint64(m.MaxBodyBytes))
+	}
+	if len(m.ResponseCodes) > 0 {
+		l = 0
+		for _, e := range m.ResponseCodes {
+			l += sovFelixbackend(uint64(e))
+		}
+		n += 1 + sovFelixbackend(uint64(l)) + l
+	}
+	if m.MinDurationMs != 0 {
+		n += 1 + sovFelixbackend(uint64(m.MinDurationMs))
+	}
+	if m.MaxDurationMs != 0 {
+		n += 1 + sovFelixbackend(uint64(m.MaxDurationMs))
+	}
 	return n
 }
 
-func (m *ServiceAccountUpdate) Size() (n int) {
+func (m *HTTPMatch_PathMatch) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
+	if m.PathMatch != nil {
+		n += m.PathMatch.Size()
 	}
 	return n
 }
 
-func (m *ServiceAccountRemove) Size() (n int) {
+func (m *HTTPMatch_PathMatch_Exact) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
+	l = len(m.Exact)
+	n += 1 + l + sovFelixbackend(uint64(l))
 	return n
 }
-
-func (m *ServiceAccountID) Size() (n int) {
+func (m *HTTPMatch_PathMatch_Prefix) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *HTTPMatch_PathMatch_Regex) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Regex)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *HTTPMatch_QueryParamMatch) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
+	if m.ValueMatch != nil {
+		n += m.ValueMatch.Size()
+	}
 	return n
 }
 
-func (m *NamespaceUpdate) Size() (n int) {
+func (m *HTTPMatch_QueryParamMatch_Exact) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
-	}
+	l = len(m.Exact)
+	n += 1 + l + sovFelixbackend(uint64(l))
 	return n
 }
-
-func (m *NamespaceRemove) Size() (n int) {
+func (m *HTTPMatch_QueryParamMatch_Present) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
+	n += 2
 	return n
 }
-
-func (m *NamespaceID) Size() (n int) {
+func (m *HTTPMatch_HeaderMatch) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
+	if m.ValueMatch != nil {
+		n += m.ValueMatch.Size()
+	}
 	return n
 }
 
-func (m *TunnelType) Size() (n int) {
+func (m *HTTPMatch_HeaderMatch_Exact) Size() (n int) {
 	var l int
 	_ = l
-	if m.Ipip {
-		n += 2
+	l = len(m.Exact)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *HTTPMatch_HeaderMatch_Prefix) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *HTTPMatch_HeaderMatch_Regex) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Regex)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *HTTPMatch_HeaderMatch_Present) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *GrpcMatch) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Vxlan {
-		n += 2
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Wireguard {
-		n += 2
+	return n
+}
+
+func (m *RuleMetadata) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *RouteUpdate) Size() (n int) {
+func (m *IcmpTypeAndCode) Size() (n int) {
 	var l int
 	_ = l
 	if m.Type != 0 {
 		n += 1 + sovFelixbackend(uint64(m.Type))
 	}
-	if m.IpPoolType != 0 {
-		n += 1 + sovFelixbackend(uint64(m.IpPoolType))
-	}
-	l = len(m.Dst)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.DstNodeName)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.DstNodeIp)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if m.SameSubnet {
-		n += 2
-	}
-	if m.NatOutgoing {
-		n += 2
-	}
-	if m.LocalWorkload {
-		n += 2
-	}
-	if m.TunnelType != nil {
-		l = m.TunnelType.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Code != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Code))
 	}
 	return n
 }
 
-func (m *RouteRemove) Size() (n int) {
+func (m *Protocol) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Dst)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NumberOrName != nil {
+		n += m.NumberOrName.Size()
 	}
 	return n
 }
 
-func (m *VXLANTunnelEndpointUpdate) Size() (n int) {
+func (m *Protocol_Number) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Mac)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Ipv4Addr)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	n += 1 + sovFelixbackend(uint64(m.Number))
+	return n
+}
+func (m *Protocol_Name) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *PortRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.First != 0 {
+		n += 1 + sovFelixbackend(uint64(m.First))
 	}
-	l = len(m.ParentDeviceIp)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Last != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Last))
 	}
-	l = len(m.MacV6)
+	return n
+}
+
+func (m *WorkloadEndpointID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.OrchestratorId)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv6Addr)
+	l = len(m.WorkloadId)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.ParentDeviceIpv6)
+	l = len(m.EndpointId)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *VXLANTunnelEndpointRemove) Size() (n int) {
+func (m *WorkloadEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Node)
-	if l > 0 {
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Endpoint != nil {
+		l = m.Endpoint.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *WireguardEndpointUpdate) Size() (n int) {
+func (m *WorkloadEndpoint) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
+	l = len(m.State)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.PublicKey)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.InterfaceIpv4Addr)
+	l = len(m.Mac)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *WireguardEndpointRemove) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.ProfileIds) > 0 {
+		for _, s := range m.ProfileIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv4Nets) > 0 {
+		for _, s := range m.Ipv4Nets {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv6Nets) > 0 {
+		for _, s := range m.Ipv6Nets {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv4Nat) > 0 {
+		for _, e := range m.Ipv4Nat {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv6Nat) > 0 {
+		for _, e := range m.Ipv6Nat {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.AllowSpoofedSourcePrefixes) > 0 {
+		for _, s := range m.AllowSpoofedSourcePrefixes {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *WireguardEndpointV6Update) Size() (n int) {
+func (m *EndpointPort) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.PublicKeyV6)
+	l = len(m.Protocol)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.InterfaceIpv6Addr)
-	if l > 0 {
+	if m.Port != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *WorkloadEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *WireguardEndpointV6Remove) Size() (n int) {
+func (m *HostEndpointID) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
+	l = len(m.EndpointId)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *GlobalBGPConfigUpdate) Size() (n int) {
+func (m *HostEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.ServiceClusterCidrs) > 0 {
-		for _, s := range m.ServiceClusterCidrs {
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Endpoint != nil {
+		l = m.Endpoint.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostEndpoint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.ProfileIds) > 0 {
+		for _, s := range m.ProfileIds {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ServiceExternalCidrs) > 0 {
-		for _, s := range m.ServiceExternalCidrs {
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ExpectedIpv4Addrs) > 0 {
+		for _, s := range m.ExpectedIpv4Addrs {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ServiceLoadbalancerCidrs) > 0 {
-		for _, s := range m.ServiceLoadbalancerCidrs {
+	if len(m.ExpectedIpv6Addrs) > 0 {
+		for _, s := range m.ExpectedIpv6Addrs {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
+	if len(m.UntrackedTiers) > 0 {
+		for _, e := range m.UntrackedTiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.PreDnatTiers) > 0 {
+		for _, e := range m.PreDnatTiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ForwardTiers) > 0 {
+		for _, e := range m.ForwardTiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ServicePort) Size() (n int) {
+func (m *HostEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Protocol)
-	if l > 0 {
+	if m.Id != nil {
+		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Port != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Port))
-	}
-	if m.NodePort != 0 {
-		n += 1 + sovFelixbackend(uint64(m.NodePort))
-	}
 	return n
 }
 
-func (m *ServiceUpdate) Size() (n int) {
+func (m *TierInfo) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.IngressPolicies) > 0 {
+		for _, s := range m.IngressPolicies {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	l = len(m.Type)
+	if len(m.EgressPolicies) > 0 {
+		for _, s := range m.EgressPolicies {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NatInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ExtIp)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.ClusterIp)
+	l = len(m.IntIp)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.LoadbalancerIp)
+	return n
+}
+
+func (m *ProcessStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.IsoTimestamp)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.ExternalIps) > 0 {
-		for _, s := range m.ExternalIps {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.Uptime != 0 {
+		n += 9
 	}
-	if len(m.Ports) > 0 {
-		for _, e := range m.Ports {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	return n
+}
+
+func (m *HostEndpointStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *ServiceRemove) Size() (n int) {
+func (m *EndpointStatus) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Status)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Namespace)
-	if l > 0 {
+	return n
+}
+
+func (m *HostEndpointStatusRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func sovFelixbackend(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *WorkloadEndpointStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func sozFelixbackend(x uint64) (n int) {
-	return sovFelixbackend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *WorkloadEndpointStatusRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
 }
-func (m *SyncRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *WireguardStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.IpVersion != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IpVersion))
+	}
+	return n
+}
+
+func (m *DataplaneInSync) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HostMetadataV4V6Update) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Asnumber)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *HostMetadataV4V6Remove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataV6Update) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataV6Remove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *IPAMPoolUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *IPAMPoolRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *IPAMPool) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cidr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Masquerade {
+		n += 2
+	}
+	l = len(m.IpipMode)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.VxlanMode)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *Encapsulation) Size() (n int) {
+	var l int
+	_ = l
+	if m.IpipEnabled {
+		n += 2
+	}
+	if m.VxlanEnabled {
+		n += 2
+	}
+	if m.VxlanEnabledV6 {
+		n += 2
+	}
+	return n
+}
+
+func (m *ServiceAccountUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ServiceAccountRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceAccountID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *TunnelType) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ipip {
+		n += 2
+	}
+	if m.Vxlan {
+		n += 2
+	}
+	if m.Wireguard {
+		n += 2
+	}
+	return n
+}
+
+func (m *RouteUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Type))
+	}
+	if m.IpPoolType != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IpPoolType))
+	}
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.DstNodeName)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.DstNodeIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.SameSubnet {
+		n += 2
+	}
+	if m.NatOutgoing {
+		n += 2
+	}
+	if m.LocalWorkload {
+		n += 2
+	}
+	if m.TunnelType != nil {
+		l = m.TunnelType.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *RouteRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *VXLANTunnelEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Mac)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.ParentDeviceIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.MacV6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.ParentDeviceIpv6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *VXLANTunnelEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WireguardEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.InterfaceIpv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WireguardEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WireguardEndpointV6Update) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.PublicKeyV6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.InterfaceIpv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WireguardEndpointV6Remove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *GlobalBGPConfigUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ServiceClusterCidrs) > 0 {
+		for _, s := range m.ServiceClusterCidrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ServiceExternalCidrs) > 0 {
+		for _, s := range m.ServiceExternalCidrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ServiceLoadbalancerCidrs) > 0 {
+		for _, s := range m.ServiceLoadbalancerCidrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServicePort) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Port))
+	}
+	if m.NodePort != 0 {
+		n += 1 + sovFelixbackend(uint64(m.NodePort))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.ClusterIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.LoadbalancerIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.ExternalIps) > 0 {
+		for _, s := range m.ExternalIps {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func sovFelixbackend(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozFelixbackend(x uint64) (n int) {
+	return sovFelixbackend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ToDataplane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowFelixbackend
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToDataplane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InSync{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_InSync{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpsetUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPSetUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpsetUpdate{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpsetDeltaUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPSetDeltaUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpsetDeltaUpdate{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpsetRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPSetRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpsetRemove{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActiveProfileUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActiveProfileUpdate{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActiveProfileRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActiveProfileRemove{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivePolicyUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActivePolicyUpdate{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivePolicyRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActivePolicyRemove{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostEndpointUpdate{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostEndpointRemove{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkloadEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WorkloadEndpointUpdate{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkloadEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WorkloadEndpointRemove{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConfigUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ConfigUpdate{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataUpdate{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPAMPoolUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpamPoolUpdate{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPAMPoolRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpamPoolRemove{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataRemove{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ServiceAccountUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ServiceAccountUpdate{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ServiceAccountRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ServiceAccountRemove{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NamespaceUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_NamespaceUpdate{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NamespaceRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_NamespaceRemove{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RouteUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_RouteUpdate{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RouteRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_RouteRemove{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VtepUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VXLANTunnelEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_VtepUpdate{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VtepRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VXLANTunnelEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_VtepRemove{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WireguardEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WireguardEndpointUpdate{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WireguardEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WireguardEndpointRemove{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalBgpConfigUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GlobalBGPConfigUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_GlobalBgpConfigUpdate{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encapsulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Encapsulation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_Encapsulation{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ServiceUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ServiceUpdate{v}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ServiceRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ServiceRemove{v}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointV6Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WireguardEndpointV6Update{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WireguardEndpointV6Update{v}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointV6Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WireguardEndpointV6Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WireguardEndpointV6Remove{v}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV6Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &HostMetadataV6Update{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Payload = &ToDataplane_HostMetadataV6Update{v}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV6Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataV6Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataV6Remove{v}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV4V6Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataV4V6Update{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataV4V6Update{v}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV4V6Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataV4V6Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataV4V6Remove{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -10392,7 +13878,7 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ToDataplane) Unmarshal(dAtA []byte) error {
+func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10415,15 +13901,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ToDataplane: wiretype end group for non-group")
+			return fmt.Errorf("proto: FromDataplane: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FromDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10447,15 +13933,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InSync{}
+			v := &ProcessStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_InSync{v}
+			m.Payload = &FromDataplane_ProcessStatusUpdate{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpsetUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10479,15 +13965,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPSetUpdate{}
+			v := &HostEndpointStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_IpsetUpdate{v}
+			m.Payload = &FromDataplane_HostEndpointStatusUpdate{v}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpsetDeltaUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10511,15 +13997,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPSetDeltaUpdate{}
+			v := &HostEndpointStatusRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_IpsetDeltaUpdate{v}
+			m.Payload = &FromDataplane_HostEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpsetRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10543,15 +14029,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPSetRemove{}
+			v := &WorkloadEndpointStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_IpsetRemove{v}
+			m.Payload = &FromDataplane_WorkloadEndpointStatusUpdate{v}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10575,17 +14061,17 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActiveProfileUpdate{}
+			v := &WorkloadEndpointStatusRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActiveProfileUpdate{v}
+			m.Payload = &FromDataplane_WorkloadEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileRemove", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
 			}
-			var msglen int
+			m.SequenceNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10595,27 +14081,14 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ActiveProfileRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_ActiveProfileRemove{v}
-			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10639,15 +14112,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActivePolicyUpdate{}
+			v := &WireguardStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActivePolicyUpdate{v}
+			m.Payload = &FromDataplane_WireguardStatusUpdate{v}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataplaneInSync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10671,15 +14144,65 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActivePolicyRemove{}
+			v := &DataplaneInSync{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActivePolicyRemove{v}
+			m.Payload = &FromDataplane_DataplaneInSync{v}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10703,15 +14226,101 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostEndpointUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Config == nil {
+				m.Config = make(map[string]string)
 			}
-			m.Payload = &ToDataplane_HostEndpointUpdate{v}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Config[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceToRawConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10735,17 +14344,97 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.SourceToRawConfig == nil {
+				m.SourceToRawConfig = make(map[uint32]*RawConfig)
 			}
-			m.Payload = &ToDataplane_HostEndpointRemove{v}
+			var mapkey uint32
+			var mapvalue *RawConfig
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RawConfig{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SourceToRawConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10755,29 +14444,76 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WorkloadEndpointUpdate{v}
-			iNdEx = postIndex
-		case 12:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10787,27 +14523,24 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_WorkloadEndpointRemove{v}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10831,17 +14564,153 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ConfigUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Config == nil {
+				m.Config = make(map[string]string)
 			}
-			m.Payload = &ToDataplane_ConfigUpdate{v}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Config[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InSync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InSync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InSync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10851,29 +14720,76 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostMetadataUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_HostMetadataUpdate{v}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			m.SequenceNumber = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPSetUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10883,16 +14799,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10902,29 +14828,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPAMPoolUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_IpamPoolUpdate{v}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolRemove", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10934,29 +14857,66 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Type |= (IPSetUpdate_IPSetType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPAMPoolRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			m.Payload = &ToDataplane_IpamPoolRemove{v}
-			iNdEx = postIndex
-		case 18:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPSetDeltaUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPSetDeltaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10966,29 +14926,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostMetadataRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_HostMetadataRemove{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedMembers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10998,29 +14955,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ServiceAccountUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_ServiceAccountUpdate{v}
+			m.AddedMembers = append(m.AddedMembers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 20:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedMembers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11030,29 +14984,76 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ServiceAccountRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemovedMembers = append(m.RemovedMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ServiceAccountRemove{v}
-			iNdEx = postIndex
-		case 21:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPSetRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPSetRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPSetRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11062,27 +15063,74 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NamespaceUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_NamespaceUpdate{v}
-			iNdEx = postIndex
-		case 22:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveProfileUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveProfileUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11106,15 +15154,16 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NamespaceRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &ProfileID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_NamespaceRemove{v}
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouteUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11138,15 +15187,66 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RouteUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Profile == nil {
+				m.Profile = &Profile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_RouteUpdate{v}
 			iNdEx = postIndex
-		case 24:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveProfileRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveProfileRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveProfileRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouteRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11170,17 +15270,68 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RouteRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &ProfileID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_RouteRemove{v}
 			iNdEx = postIndex
-		case 25:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VtepUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11190,27 +15341,74 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &VXLANTunnelEndpointUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_VtepUpdate{v}
-			iNdEx = postIndex
-		case 26:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Profile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VtepRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11234,15 +15432,14 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &VXLANTunnelEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InboundRules = append(m.InboundRules, &Rule{})
+			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_VtepRemove{v}
 			iNdEx = postIndex
-		case 27:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11266,15 +15463,64 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardEndpointUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutboundRules = append(m.OutboundRules, &Rule{})
+			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WireguardEndpointUpdate{v}
-			iNdEx = postIndex
-		case 28:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivePolicyUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivePolicyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11298,15 +15544,16 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &PolicyID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WireguardEndpointRemove{v}
 			iNdEx = postIndex
-		case 29:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalBgpConfigUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11330,15 +15577,66 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GlobalBGPConfigUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_GlobalBgpConfigUpdate{v}
 			iNdEx = postIndex
-		case 30:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivePolicyRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivePolicyRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivePolicyRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encapsulation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11362,17 +15660,68 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Encapsulation{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &PolicyID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_Encapsulation{v}
 			iNdEx = postIndex
-		case 31:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11382,29 +15731,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ServiceUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_ServiceUpdate{v}
+			m.Tier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 32:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11414,27 +15760,74 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ServiceRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ServiceRemove{v}
-			iNdEx = postIndex
-		case 33:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointV6Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11458,15 +15851,14 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardEndpointV6Update{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InboundRules = append(m.InboundRules, &Rule{})
+			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WireguardEndpointV6Update{v}
 			iNdEx = postIndex
-		case 34:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointV6Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11490,17 +15882,16 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardEndpointV6Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutboundRules = append(m.OutboundRules, &Rule{})
+			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WireguardEndpointV6Remove{v}
 			iNdEx = postIndex
-		case 35:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV6Update", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Untracked", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11510,29 +15901,17 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &HostMetadataV6Update{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_HostMetadataV6Update{v}
-			iNdEx = postIndex
-		case 36:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV6Remove", wireType)
+			m.Untracked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDnat", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11542,29 +15921,17 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &HostMetadataV6Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_HostMetadataV6Remove{v}
-			iNdEx = postIndex
-		case 37:
+			m.PreDnat = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV4V6Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11574,29 +15941,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostMetadataV4V6Update{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_HostMetadataV4V6Update{v}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 38:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV4V6Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11606,23 +15970,20 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostMetadataV4V6Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_HostMetadataV4V6Remove{v}
+			m.OriginalSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11645,7 +16006,7 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FromDataplane) Unmarshal(dAtA []byte) error {
+func (m *Rule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11668,17 +16029,17 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FromDataplane: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FromDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessStatusUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11688,29 +16049,26 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProcessStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &FromDataplane_ProcessStatusUpdate{v}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusUpdate", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpVersion", wireType)
 			}
-			var msglen int
+			m.IpVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11720,27 +16078,14 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.IpVersion |= (IPVersion(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &HostEndpointStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &FromDataplane_HostEndpointStatusUpdate{v}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11764,17 +16109,18 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostEndpointStatusRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Protocol == nil {
+				m.Protocol = &Protocol{}
+			}
+			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &FromDataplane_HostEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcNet", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11784,27 +16130,24 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &FromDataplane_WorkloadEndpointStatusUpdate{v}
+			m.SrcNet = append(m.SrcNet, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPorts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11828,36 +16171,16 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointStatusRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SrcPorts = append(m.SrcPorts, &PortRange{})
+			if err := m.SrcPorts[len(m.SrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &FromDataplane_WorkloadEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
-			}
-			m.SequenceNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardStatusUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstNet", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11867,27 +16190,24 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &FromDataplane_WireguardStatusUpdate{v}
+			m.DstNet = append(m.DstNet, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataplaneInSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPorts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11911,65 +16231,34 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DataplaneInSync{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DstPorts = append(m.DstPorts, &PortRange{})
+			if err := m.DstPorts[len(m.DstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &FromDataplane_DataplaneInSync{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcmpType", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Icmp = &Rule_IcmpType{v}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IcmpTypeCode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11993,103 +16282,46 @@ func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = make(map[string]string)
+			v := &IcmpTypeAndCode{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.Icmp = &Rule_IcmpTypeCode{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpSetIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Config[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcIpSetIds = append(m.SrcIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceToRawConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIpSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12099,107 +16331,53 @@ func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceToRawConfig == nil {
-				m.SourceToRawConfig = make(map[uint32]*RawConfig)
+			m.DstIpSetIds = append(m.DstIpSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcNamedPortIpSetIds", wireType)
 			}
-			var mapkey uint32
-			var mapvalue *RawConfig
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &RawConfig{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SourceToRawConfig[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcNamedPortIpSetIds = append(m.SrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstNamedPortIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12224,61 +16402,73 @@ func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.DstNamedPortIpSetIds = append(m.DstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIpPortSetIds", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RawConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			m.DstIpPortSetIds = append(m.DstIpPortSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotProtocol", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.NotProtocol == nil {
+				m.NotProtocol = &Protocol{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RawConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.NotProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNet", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12303,11 +16493,11 @@ func (m *RawConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.NotSrcNet = append(m.NotSrcNet, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcPorts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12331,201 +16521,155 @@ func (m *RawConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = make(map[string]string)
+			m.NotSrcPorts = append(m.NotSrcPorts, &PortRange{})
+			if err := m.NotSrcPorts[len(m.NotSrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Config[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotDstNet = append(m.NotDstNet, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstPorts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InSync) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			m.NotDstPorts = append(m.NotDstPorts, &PortRange{})
+			if err := m.NotDstPorts[len(m.NotDstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 107:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpType", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InSync: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InSync: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.NotIcmp = &Rule_NotIcmpType{v}
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpTypeCode", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			v := &IcmpTypeAndCode{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.NotIcmp = &Rule_NotIcmpTypeCode{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcIpSetIds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPSetUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotSrcIpSetIds = append(m.NotSrcIpSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12550,11 +16694,11 @@ func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.NotDstIpSetIds = append(m.NotDstIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNamedPortIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12579,13 +16723,13 @@ func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.NotSrcNamedPortIpSetIds = append(m.NotSrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNamedPortIpSetIds", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12595,64 +16739,53 @@ func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (IPSetUpdate_IPSetType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			m.NotDstNamedPortIpSetIds = append(m.NotDstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcSelector", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPSetDeltaUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPSetDeltaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSrcSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12677,11 +16810,11 @@ func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OriginalDstSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcNamespaceSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12706,11 +16839,11 @@ func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedMembers = append(m.AddedMembers, string(dAtA[iNdEx:postIndex]))
+			m.OriginalSrcNamespaceSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstNamespaceSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12735,61 +16868,40 @@ func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedMembers = append(m.RemovedMembers, string(dAtA[iNdEx:postIndex]))
+			m.OriginalDstNamespaceSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 118:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotSrcSelector", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPSetRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPSetRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPSetRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OriginalNotSrcSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 119:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotDstSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12814,61 +16926,44 @@ func (m *IPSetRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OriginalNotDstSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 120:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcServiceAccountMatch", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SrcServiceAccountMatch == nil {
+				m.SrcServiceAccountMatch = &ServiceAccountMatch{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SrcServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveProfileUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveProfileUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 121:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstServiceAccountMatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12892,16 +16987,16 @@ func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ProfileID{}
+			if m.DstServiceAccountMatch == nil {
+				m.DstServiceAccountMatch = &ServiceAccountMatch{}
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DstServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 122:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpMatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12925,68 +17020,51 @@ func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profile == nil {
-				m.Profile = &Profile{}
+			if m.HttpMatch == nil {
+				m.HttpMatch = &HTTPMatch{}
 			}
-			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HttpMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 123:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActiveProfileRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Metadata == nil {
+				m.Metadata = &RuleMetadata{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveProfileRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveProfileRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 130:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12996,78 +17074,24 @@ func (m *ActiveProfileRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ProfileID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalDstService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProfileID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProfileID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProfileID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 131:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstServiceNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13092,63 +17116,42 @@ func (m *ProfileID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.OriginalDstServiceNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 132:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcService", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Profile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OriginalSrcService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 133:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcServiceNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13158,26 +17161,24 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InboundRules = append(m.InboundRules, &Rule{})
-			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalSrcServiceNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 135:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13201,66 +17202,103 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundRules = append(m.OutboundRules, &Rule{})
-			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.DstAnnotations == nil {
+				m.DstAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.DstAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActivePolicyUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivePolicyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 136:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppProtocols", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13270,30 +17308,26 @@ func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &PolicyID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppProtocols = append(m.AppProtocols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+		case 137:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIsLocalNode", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13303,80 +17337,17 @@ func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Policy == nil {
-				m.Policy = &Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActivePolicyRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActivePolicyRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivePolicyRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SrcIsLocalNode = bool(v != 0)
+		case 138:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JwtAudiences", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13386,78 +17357,24 @@ func (m *ActivePolicyRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &PolicyID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JwtAudiences = append(m.JwtAudiences, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PolicyID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 139:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13482,11 +17399,11 @@ func (m *PolicyID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tier = string(dAtA[iNdEx:postIndex])
+			m.RouteNames = append(m.RouteNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 140:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpPools", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13511,63 +17428,42 @@ func (m *PolicyID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SrcIpPools = append(m.SrcIpPools, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 141:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstServicePorts", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Policy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DstServicePorts = append(m.DstServicePorts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 143:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectRemoteNet", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13577,28 +17473,26 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InboundRules = append(m.InboundRules, &Rule{})
-			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DirectRemoteNet = append(m.DirectRemoteNet, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 144:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstEncapsulations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13608,26 +17502,24 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundRules = append(m.OutboundRules, &Rule{})
-			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DstEncapsulations = append(m.DstEncapsulations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 145:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Untracked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsTerminated", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13644,10 +17536,10 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Untracked = bool(v != 0)
-		case 4:
+			m.TlsTerminated = bool(v != 0)
+		case 146:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreDnat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReady", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13664,10 +17556,10 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.PreDnat = bool(v != 0)
-		case 5:
+			m.DstReady = bool(v != 0)
+		case 147:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcCallTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13692,11 +17584,68 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.GrpcCallTypes = append(m.GrpcCallTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 148:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpSetAddedWithinSecs", wireType)
+			}
+			m.SrcIpSetAddedWithinSecs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcIpSetAddedWithinSecs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 149:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIpSetAddedWithinSecs", wireType)
+			}
+			m.DstIpSetAddedWithinSecs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstIpSetAddedWithinSecs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 150:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpSetCardinalityAbove", wireType)
+			}
+			m.SrcIpSetCardinalityAbove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcIpSetCardinalityAbove |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 151:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestLabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13721,63 +17670,13 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSelector = string(dAtA[iNdEx:postIndex])
+			m.RequestLabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Rule) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 152:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcNamespaceLabels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13787,45 +17686,115 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			if m.SrcNamespaceLabels == nil {
+				m.SrcNamespaceLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SrcNamespaceLabels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 153:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpVersion", wireType)
-			}
-			m.IpVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IpVersion |= (IPVersion(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstInLocalIpamBlock", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13835,28 +17804,15 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Protocol == nil {
-				m.Protocol = &Protocol{}
-			}
-			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.DstInLocalIpamBlock = bool(v != 0)
+		case 154:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsFingerprints", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13881,42 +17837,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcNet = append(m.SrcNet, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPorts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SrcPorts = append(m.SrcPorts, &PortRange{})
-			if err := m.SrcPorts[len(m.SrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TlsFingerprints = append(m.TlsFingerprints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 155:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotTlsFingerprints", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13941,11 +17866,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstNet = append(m.DstNet, string(dAtA[iNdEx:postIndex]))
+			m.NotTlsFingerprints = append(m.NotTlsFingerprints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 156:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcMatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13969,16 +17894,18 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstPorts = append(m.DstPorts, &PortRange{})
-			if err := m.DstPorts[len(m.DstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GrpcMatch == nil {
+				m.GrpcMatch = &GrpcMatch{}
+			}
+			if err := m.GrpcMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 157:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IcmpType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowHairpin", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13988,17 +17915,17 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Icmp = &Rule_IcmpType{v}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IcmpTypeCode", wireType)
+			m.AllowHairpin = bool(v != 0)
+		case 159:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstListening", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14008,27 +17935,15 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &IcmpTypeAndCode{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Icmp = &Rule_IcmpTypeCode{v}
-			iNdEx = postIndex
-		case 10:
+			m.DstListening = bool(v != 0)
+		case 160:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPrincipalPrefixes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14053,11 +17968,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIpSetIds = append(m.SrcIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.SrcPrincipalPrefixes = append(m.SrcPrincipalPrefixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 161:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPrincipalSuffixes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14082,11 +17997,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIpSetIds = append(m.DstIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.SrcPrincipalSuffixes = append(m.SrcPrincipalSuffixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 162:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrincipalPrefixes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14111,11 +18026,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcNamedPortIpSetIds = append(m.SrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.DstPrincipalPrefixes = append(m.DstPrincipalPrefixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
+		case 163:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrincipalSuffixes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14140,11 +18055,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstNamedPortIpSetIds = append(m.DstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.DstPrincipalSuffixes = append(m.DstPrincipalSuffixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
+		case 164:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIpPortSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcZones", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14169,13 +18084,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIpPortSetIds = append(m.DstIpPortSetIds, string(dAtA[iNdEx:postIndex]))
+			m.SrcZones = append(m.SrcZones, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 102:
+		case 165:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotProtocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcRegions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14185,28 +18100,24 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NotProtocol == nil {
-				m.NotProtocol = &Protocol{}
-			}
-			if err := m.NotProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcRegions = append(m.SrcRegions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 103:
+		case 166:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReverseDnsNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14231,13 +18142,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcNet = append(m.NotSrcNet, string(dAtA[iNdEx:postIndex]))
+			m.DstReverseDnsNames = append(m.DstReverseDnsNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 104:
+		case 169:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstIpPortSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14247,26 +18158,24 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcPorts = append(m.NotSrcPorts, &PortRange{})
-			if err := m.NotSrcPorts[len(m.NotSrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotDstIpPortSetIds = append(m.NotDstIpPortSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 105:
+		case 170:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpPortSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14291,13 +18200,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstNet = append(m.NotDstNet, string(dAtA[iNdEx:postIndex]))
+			m.SrcIpPortSetIds = append(m.SrcIpPortSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 106:
+		case 171:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcIpPortSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14307,28 +18216,26 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstPorts = append(m.NotDstPorts, &PortRange{})
-			if err := m.NotDstPorts[len(m.NotDstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotSrcIpPortSetIds = append(m.NotSrcIpPortSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 107:
+		case 172:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionReused", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14338,47 +18245,77 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NotIcmp = &Rule_NotIcmpType{v}
-		case 108:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpTypeCode", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
+			m.ConnectionReused = bool(v != 0)
+		case 173:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.SrcAsNumbers = append(m.SrcAsNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFelixbackend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SrcAsNumbers = append(m.SrcAsNumbers, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcAsNumbers", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &IcmpTypeAndCode{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.NotIcmp = &Rule_NotIcmpTypeCode{v}
-			iNdEx = postIndex
-		case 109:
+		case 201:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14403,11 +18340,61 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcIpSetIds = append(m.NotSrcIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.RuleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 110:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceAccountMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceAccountMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14432,11 +18419,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstIpSetIds = append(m.NotDstIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 112:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14461,11 +18448,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcNamedPortIpSetIds = append(m.NotSrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 113:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDomains", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14490,13 +18477,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstNamedPortIpSetIds = append(m.NotDstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.TrustDomains = append(m.TrustDomains, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 114:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcSelector", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobNames", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14506,24 +18493,65 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.GlobNames = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcSelector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 115:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14548,13 +18576,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstSelector = string(dAtA[iNdEx:postIndex])
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 116:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcNamespaceSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14564,24 +18592,26 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcNamespaceSelector = string(dAtA[iNdEx:postIndex])
+			m.Paths = append(m.Paths, &HTTPMatch_PathMatch{})
+			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 117:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstNamespaceSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14606,13 +18636,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstNamespaceSelector = string(dAtA[iNdEx:postIndex])
+			m.ContentTypes = append(m.ContentTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 118:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotSrcSelector", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTrailingSlash", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14622,26 +18652,17 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalNotSrcSelector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 119:
+			m.IgnoreTrailingSlash = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotDstSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14651,24 +18672,46 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalNotDstSelector = string(dAtA[iNdEx:postIndex])
+			m.QueryParams = append(m.QueryParams, &HTTPMatch_QueryParamMatch{})
+			if err := m.QueryParams[len(m.QueryParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 120:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseInsensitive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CaseInsensitive = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcServiceAccountMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14692,18 +18735,16 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcServiceAccountMatch == nil {
-				m.SrcServiceAccountMatch = &ServiceAccountMatch{}
-			}
-			if err := m.SrcServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &HTTPMatch_HeaderMatch{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 121:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstServiceAccountMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14713,28 +18754,53 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DstServiceAccountMatch == nil {
-				m.DstServiceAccountMatch = &ServiceAccountMatch{}
+			m.Upgrades = append(m.Upgrades, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotMethods", wireType)
 			}
-			if err := m.DstServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotMethods = append(m.NotMethods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 122:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotPaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14758,18 +18824,16 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HttpMatch == nil {
-				m.HttpMatch = &HTTPMatch{}
-			}
-			if err := m.HttpMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotPaths = append(m.NotPaths, &HTTPMatch_PathMatch{})
+			if err := m.NotPaths[len(m.NotPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 123:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBodyBytes", wireType)
 			}
-			var msglen int
+			m.MaxBodyBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14779,30 +18843,78 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxBodyBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &RuleMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 12:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ResponseCodes = append(m.ResponseCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFelixbackend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ResponseCodes = append(m.ResponseCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCodes", wireType)
 			}
-			iNdEx = postIndex
-		case 130:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstService", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDurationMs", wireType)
 			}
-			var stringLen uint64
+			m.MinDurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14812,26 +18924,16 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MinDurationMs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalDstService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 131:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstServiceNamespace", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDurationMs", wireType)
 			}
-			var stringLen uint64
+			m.MaxDurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14841,24 +18943,64 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxDurationMs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstServiceNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 132:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14883,11 +19025,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcService = string(dAtA[iNdEx:postIndex])
+			m.PathMatch = &HTTPMatch_PathMatch_Exact{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 133:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcServiceNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14912,11 +19054,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcServiceNamespace = string(dAtA[iNdEx:postIndex])
+			m.PathMatch = &HTTPMatch_PathMatch_Prefix{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 201:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14941,7 +19083,7 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuleId = string(dAtA[iNdEx:postIndex])
+			m.PathMatch = &HTTPMatch_PathMatch_Regex{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14964,7 +19106,7 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
+func (m *HTTPMatch_QueryParamMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14987,15 +19129,15 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15020,11 +19162,11 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15049,8 +19191,29 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			m.ValueMatch = &HTTPMatch_QueryParamMatch_Exact{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Present", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ValueMatch = &HTTPMatch_QueryParamMatch_Present{b}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -15072,7 +19235,7 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
+func (m *HTTPMatch_HeaderMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15095,15 +19258,73 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeaderMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeaderMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatch = &HTTPMatch_HeaderMatch_Exact{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15128,13 +19349,13 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			m.ValueMatch = &HTTPMatch_HeaderMatch_Prefix{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15144,23 +19365,42 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Paths = append(m.Paths, &HTTPMatch_PathMatch{})
-			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValueMatch = &HTTPMatch_HeaderMatch_Regex{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Present", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ValueMatch = &HTTPMatch_HeaderMatch_Present{b}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -15182,7 +19422,7 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
+func (m *GrpcMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15205,15 +19445,15 @@ func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PathMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrpcMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PathMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrpcMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15238,11 +19478,11 @@ func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathMatch = &HTTPMatch_PathMatch_Exact{string(dAtA[iNdEx:postIndex])}
+			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15267,7 +19507,7 @@ func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathMatch = &HTTPMatch_PathMatch_Prefix{string(dAtA[iNdEx:postIndex])}
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16429,6 +20669,164 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, &EndpointPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndpointPort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndpointPort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndpointPort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -19212,6 +23610,35 @@ func (m *IPAMPool) Unmarshal(dAtA []byte) error {
 			}
 			m.VxlanMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -21552,6 +25979,35 @@ func (m *ServicePort) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -22044,269 +26500,341 @@ var (
 func init() { proto1.RegisterFile("felixbackend.proto", fileDescriptorFelixbackend) }
 
 var fileDescriptorFelixbackend = []byte{
-	// 4220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xcd, 0x73, 0x1c, 0x49,
-	0x56, 0x57, 0xb7, 0xa4, 0x56, 0xf7, 0xeb, 0xaf, 0x72, 0xea, 0xab, 0x25, 0xdb, 0xb2, 0xa7, 0x3c,
-	0xde, 0xd1, 0x78, 0x77, 0x3c, 0x46, 0x23, 0xb7, 0xd7, 0xc3, 0x32, 0x1b, 0x6d, 0xb5, 0x66, 0xd4,
-	0x33, 0xb6, 0x24, 0x4a, 0x1a, 0x0d, 0xb3, 0x6c, 0x44, 0x51, 0xaa, 0x4a, 0x49, 0x85, 0xab, 0xab,
-	0x6a, 0xaa, 0xb2, 0xf5, 0xb1, 0x9c, 0x80, 0x25, 0x02, 0x82, 0x03, 0x1c, 0x08, 0x82, 0x3f, 0x80,
-	0x13, 0xc1, 0x7f, 0xc0, 0x81, 0xeb, 0x6e, 0x70, 0x81, 0xe0, 0x4c, 0x04, 0x31, 0xdc, 0xb8, 0x41,
-	0x04, 0x77, 0x22, 0x3f, 0xeb, 0xa3, 0xab, 0x65, 0x99, 0x1d, 0xf6, 0xa4, 0xce, 0x97, 0xef, 0xfd,
-	0xf2, 0x97, 0xaf, 0x5e, 0xbe, 0xfc, 0x14, 0xa0, 0x13, 0xec, 0xb9, 0x97, 0xc7, 0x96, 0xfd, 0x1a,
-	0xfb, 0xce, 0xe3, 0x30, 0x0a, 0x48, 0x80, 0x66, 0x99, 0x4c, 0x6f, 0x42, 0xfd, 0xe0, 0xca, 0xb7,
-	0x0d, 0xfc, 0xcd, 0x08, 0xc7, 0x44, 0xff, 0xa7, 0x25, 0xa8, 0x1f, 0x06, 0x7d, 0x8b, 0x58, 0xa1,
-	0x67, 0xf9, 0x18, 0xad, 0xc3, 0x9c, 0xeb, 0x9b, 0xf1, 0x95, 0x6f, 0x77, 0x4a, 0xf7, 0x4b, 0xeb,
-	0xf5, 0x8d, 0xe6, 0x63, 0x66, 0xf7, 0x78, 0xe0, 0x53, 0xb3, 0x9d, 0x29, 0xa3, 0xe2, 0xb2, 0x5f,
-	0xe8, 0x19, 0x34, 0xdc, 0x30, 0xc6, 0xc4, 0x1c, 0x85, 0x8e, 0x45, 0x70, 0xa7, 0xcc, 0xd4, 0x91,
-	0x54, 0xdf, 0x3f, 0xc0, 0xe4, 0x4b, 0x56, 0xb3, 0x33, 0x65, 0xd4, 0x99, 0x26, 0x2f, 0xa2, 0xcf,
-	0x00, 0x71, 0x43, 0x07, 0x7b, 0xc4, 0x92, 0xe6, 0xd3, 0xcc, 0x7c, 0x39, 0x6d, 0xde, 0xa7, 0xf5,
-	0x0a, 0x43, 0x63, 0x46, 0x29, 0x59, 0xc2, 0x20, 0xc2, 0xc3, 0xe0, 0x1c, 0x77, 0x66, 0xc6, 0x19,
-	0x18, 0xac, 0x46, 0x31, 0xe0, 0x45, 0xb4, 0x0f, 0x8b, 0x96, 0x4d, 0xdc, 0x73, 0x6c, 0x86, 0x51,
-	0x70, 0xe2, 0x7a, 0x58, 0x92, 0x98, 0x65, 0x08, 0xab, 0x02, 0xa1, 0xc7, 0x74, 0xf6, 0xb9, 0x8a,
-	0xe2, 0x31, 0x6f, 0x8d, 0x8b, 0x0b, 0x10, 0x05, 0xa7, 0xca, 0x64, 0x44, 0xc5, 0x2d, 0x8b, 0x28,
-	0x38, 0xbe, 0x82, 0x05, 0x89, 0x18, 0x78, 0xae, 0x7d, 0x25, 0x29, 0xce, 0x31, 0xc0, 0x95, 0x2c,
-	0x20, 0xd3, 0x50, 0x0c, 0x91, 0x35, 0x26, 0x1d, 0x87, 0x13, 0xfc, 0xaa, 0x13, 0xe1, 0x14, 0xbd,
-	0x0c, 0x5c, 0xc2, 0xee, 0x2c, 0x88, 0x89, 0x89, 0x7d, 0x27, 0x0c, 0x5c, 0x5f, 0x05, 0x41, 0x2d,
-	0x03, 0xb7, 0x13, 0xc4, 0x64, 0x5b, 0x68, 0x24, 0xec, 0xce, 0xc6, 0xa4, 0xe3, 0x70, 0x82, 0x1d,
-	0x4c, 0x84, 0x4b, 0xd8, 0x9d, 0x8d, 0x49, 0xd1, 0xd7, 0xd0, 0xb9, 0x08, 0xa2, 0xd7, 0x5e, 0x60,
-	0x39, 0x63, 0x0c, 0xeb, 0x0c, 0xf2, 0xae, 0x80, 0xfc, 0x4a, 0xa8, 0x8d, 0xb1, 0x5c, 0xba, 0x28,
-	0xac, 0x29, 0x86, 0x16, 0x6c, 0x1b, 0xd7, 0x42, 0x2b, 0xc6, 0x63, 0xd0, 0x82, 0xf5, 0xc7, 0xd0,
-	0xb4, 0x03, 0xff, 0xc4, 0x3d, 0x95, 0x54, 0x9b, 0x0c, 0x6f, 0x5e, 0xe0, 0x6d, 0xb1, 0x3a, 0x45,
-	0xb0, 0x61, 0xa7, 0xca, 0xca, 0x81, 0x43, 0x4c, 0x2c, 0xc7, 0x4a, 0x46, 0x55, 0x6b, 0xcc, 0x81,
-	0xaf, 0x84, 0x46, 0xf6, 0x7b, 0x64, 0xa5, 0xe8, 0x3d, 0x68, 0xc7, 0x34, 0x41, 0xf8, 0x36, 0x36,
-	0xfd, 0xd1, 0xf0, 0x18, 0x47, 0x9d, 0xf6, 0xfd, 0xd2, 0xfa, 0x8c, 0xd1, 0x92, 0xe2, 0x5d, 0x26,
-	0x45, 0x3d, 0xd0, 0xdc, 0xd0, 0x1a, 0x9a, 0x61, 0x10, 0x78, 0xb2, 0x4d, 0x8d, 0xb5, 0xb9, 0xa8,
-	0x86, 0x61, 0xef, 0xd5, 0x7e, 0x10, 0x78, 0xaa, 0xbd, 0x16, 0x35, 0x48, 0x24, 0x59, 0x08, 0xe1,
-	0xc9, 0x5b, 0x85, 0x10, 0xca, 0x83, 0x0a, 0x22, 0x17, 0x8d, 0xaa, 0xf7, 0x02, 0x06, 0x4d, 0xec,
-	0x7d, 0x36, 0x7c, 0xb2, 0x52, 0x74, 0x00, 0x4b, 0x31, 0x8e, 0xce, 0x5d, 0x1b, 0x9b, 0x96, 0x6d,
-	0x07, 0xa3, 0x24, 0x78, 0xe6, 0x19, 0xe0, 0x6d, 0x01, 0x78, 0xc0, 0x95, 0x7a, 0x5c, 0x47, 0x75,
-	0x70, 0x21, 0x2e, 0x90, 0x17, 0x81, 0x0a, 0x96, 0x0b, 0xd7, 0x80, 0x2a, 0x9e, 0x39, 0x50, 0xc1,
-	0x74, 0x0b, 0x34, 0xdf, 0x1a, 0xe2, 0x38, 0xb4, 0x6c, 0x95, 0xc3, 0x16, 0x19, 0xdc, 0x92, 0x80,
-	0xdb, 0x95, 0xd5, 0x8a, 0x5e, 0xdb, 0xcf, 0x8a, 0xb2, 0x20, 0x82, 0xd3, 0x52, 0x31, 0x88, 0xa2,
-	0x93, 0x80, 0x08, 0x26, 0xcf, 0xa0, 0x11, 0x05, 0x23, 0xa2, 0x58, 0x2c, 0x67, 0x72, 0xb1, 0x41,
-	0xab, 0x92, 0xd9, 0x20, 0x4a, 0x8a, 0x89, 0xa1, 0x68, 0xb9, 0x33, 0x6e, 0x98, 0x24, 0xf1, 0x28,
-	0x29, 0xa2, 0x2d, 0xa8, 0x9f, 0x13, 0x1c, 0xca, 0x06, 0x57, 0x98, 0xdd, 0x7d, 0x61, 0x77, 0xf4,
-	0x3b, 0x2f, 0x7b, 0xbb, 0x87, 0x23, 0xdf, 0xc7, 0xde, 0xd8, 0xd0, 0x06, 0x6a, 0xa6, 0xfa, 0xce,
-	0x41, 0x44, 0xe3, 0xab, 0x6f, 0x02, 0x51, 0x54, 0x18, 0x88, 0x60, 0xf2, 0x53, 0x58, 0xb9, 0x70,
-	0x23, 0x7c, 0x3a, 0xb2, 0xa2, 0xf1, 0x7c, 0x73, 0x9b, 0x41, 0xae, 0xc9, 0xa4, 0x20, 0xf5, 0xc6,
-	0x58, 0x2d, 0x5f, 0x14, 0x57, 0x4d, 0x40, 0x17, 0x84, 0xef, 0x5c, 0x8f, 0xae, 0xe8, 0x8e, 0xa3,
-	0x0b, 0xee, 0x5f, 0x41, 0xe7, 0xd4, 0x0b, 0x8e, 0x2d, 0xcf, 0x3c, 0x3e, 0x0d, 0xcd, 0x6c, 0xfe,
-	0xb9, 0xcb, 0xc0, 0xef, 0x08, 0xf0, 0xcf, 0x98, 0xda, 0x8b, 0xcf, 0xf6, 0x73, 0x89, 0x68, 0x91,
-	0xdb, 0xbf, 0x38, 0x0d, 0xd3, 0x15, 0xe8, 0x47, 0xd0, 0xc4, 0xbe, 0x6d, 0x85, 0xf1, 0xc8, 0xb3,
-	0x88, 0x1b, 0xf8, 0x9d, 0x35, 0x86, 0xb6, 0x20, 0xd0, 0xb6, 0xd3, 0x75, 0x3b, 0x53, 0x46, 0x56,
-	0x19, 0xfd, 0x16, 0xb4, 0xe4, 0x68, 0x11, 0x64, 0xee, 0x65, 0xcc, 0xc5, 0x28, 0x51, 0x24, 0x9a,
-	0x71, 0x5a, 0x90, 0x36, 0x17, 0x8e, 0xba, 0x5f, 0x64, 0xae, 0xdc, 0x23, 0xcd, 0x85, 0x53, 0x6c,
-	0xb8, 0x53, 0xe0, 0xf2, 0xf3, 0xae, 0xe4, 0xf2, 0x4e, 0x26, 0x4c, 0xc6, 0xbc, 0x7e, 0xd4, 0x55,
-	0xbc, 0x56, 0x2e, 0x26, 0x55, 0x4e, 0x6e, 0x44, 0x30, 0xd6, 0xdf, 0xd4, 0x88, 0x62, 0x5f, 0xd4,
-	0x88, 0xe8, 0xc9, 0x21, 0x2c, 0x67, 0x33, 0x63, 0xd2, 0x89, 0x07, 0x99, 0xb4, 0x93, 0x4e, 0x8e,
-	0x29, 0xfe, 0x0b, 0x67, 0x05, 0xf2, 0x42, 0x54, 0xc1, 0xfa, 0xdd, 0x6b, 0x50, 0x93, 0x64, 0x76,
-	0x56, 0x20, 0x47, 0x3f, 0x81, 0x95, 0x1c, 0xea, 0x66, 0xc2, 0xf6, 0x61, 0x66, 0x6e, 0xcd, 0xe0,
-	0x6e, 0xa6, 0xf8, 0x2e, 0x65, 0x90, 0x37, 0xcf, 0x25, 0xe3, 0x62, 0x6c, 0xc1, 0xf9, 0x7b, 0xd7,
-	0x62, 0x27, 0xf3, 0x76, 0x1e, 0x9b, 0xd7, 0xbc, 0xa8, 0xc1, 0x5c, 0x68, 0x5d, 0xd1, 0x09, 0x5d,
-	0xff, 0xd7, 0x59, 0x68, 0x7e, 0x1a, 0x05, 0xc3, 0x64, 0x3d, 0xbd, 0x0f, 0x8b, 0x61, 0x14, 0xd8,
-	0x38, 0x8e, 0xcd, 0x98, 0x58, 0x64, 0x14, 0x67, 0xd7, 0xbb, 0x72, 0x61, 0xb8, 0xcf, 0x75, 0x0e,
-	0x98, 0x4a, 0xb2, 0xd4, 0x0c, 0xc7, 0xc5, 0xe8, 0xf7, 0xe0, 0x76, 0x76, 0xad, 0x94, 0xc5, 0xe5,
-	0x8b, 0xe0, 0x7b, 0x05, 0x4b, 0xa6, 0x1c, 0x78, 0xe7, 0x6c, 0x42, 0xdd, 0xc4, 0x16, 0x84, 0xbb,
-	0x66, 0xdf, 0xd0, 0x82, 0x72, 0x58, 0x41, 0x0b, 0xe2, 0x53, 0x7b, 0x70, 0x6f, 0x7c, 0x15, 0x95,
-	0xed, 0x07, 0x5f, 0x38, 0x3f, 0x98, 0xb0, 0x98, 0xca, 0xf5, 0xe5, 0xce, 0xc5, 0x35, 0xf5, 0xd7,
-	0xb6, 0x26, 0xfa, 0x34, 0x77, 0x83, 0xd6, 0x54, 0xbf, 0x26, 0xb4, 0x26, 0xfa, 0x56, 0xb0, 0x76,
-	0xaa, 0x16, 0xae, 0x9d, 0x8e, 0x20, 0xc9, 0xca, 0xb9, 0xce, 0xd7, 0x32, 0x99, 0x57, 0x8d, 0xfd,
-	0x5c, 0xaf, 0x17, 0x2f, 0x8a, 0x2a, 0x50, 0x1f, 0x6e, 0x39, 0x32, 0xfe, 0x4c, 0xb9, 0x99, 0x83,
-	0xcc, 0x84, 0xae, 0xe2, 0x53, 0xed, 0xea, 0xda, 0x4e, 0x56, 0x94, 0x8e, 0xea, 0x7f, 0x29, 0x43,
-	0x23, 0x93, 0xdb, 0x9f, 0x41, 0x85, 0xcf, 0x14, 0x9d, 0xd2, 0xfd, 0xe9, 0x54, 0x2c, 0xa4, 0x95,
-	0x44, 0x61, 0xdb, 0x27, 0xd1, 0x95, 0x21, 0xd4, 0xd1, 0xef, 0xc2, 0x42, 0x1c, 0x8c, 0x22, 0x1b,
-	0x9b, 0x24, 0x30, 0x23, 0xeb, 0x42, 0x4c, 0x38, 0x9d, 0x32, 0x83, 0x79, 0x54, 0x04, 0x73, 0xc0,
-	0xf4, 0x0f, 0x03, 0xc3, 0xba, 0x48, 0x23, 0xde, 0x8a, 0xf3, 0x72, 0xd4, 0x81, 0xb9, 0x21, 0x8e,
-	0x63, 0xeb, 0x94, 0x0f, 0xae, 0x9a, 0x21, 0x8b, 0xab, 0xcf, 0xa1, 0x9e, 0xb2, 0x45, 0x1a, 0x4c,
-	0xbf, 0xc6, 0x57, 0x6c, 0x7f, 0x5b, 0x33, 0xe8, 0x4f, 0xb4, 0x00, 0xb3, 0xe7, 0x96, 0x37, 0xe2,
-	0x9b, 0xd8, 0x9a, 0xc1, 0x0b, 0x1f, 0x97, 0x7f, 0x58, 0x5a, 0x3d, 0x82, 0xa5, 0x62, 0x06, 0x69,
-	0x94, 0x26, 0x47, 0xf9, 0x5e, 0x1a, 0xa5, 0xbe, 0xa1, 0xc9, 0x35, 0x8c, 0xb4, 0x4b, 0xe1, 0xea,
-	0x7f, 0x55, 0x82, 0x5a, 0x42, 0x7d, 0x09, 0x2a, 0xbc, 0x3f, 0x82, 0x94, 0x28, 0xa1, 0x4d, 0xe5,
-	0x68, 0xee, 0xa1, 0x3b, 0x79, 0xc8, 0x22, 0x2f, 0xff, 0x0a, 0xdd, 0xd5, 0xab, 0x50, 0xe1, 0xdf,
-	0x5f, 0xff, 0x9b, 0x12, 0xd4, 0x53, 0x9b, 0x78, 0xd4, 0x82, 0xb2, 0xeb, 0x08, 0x90, 0xb2, 0xeb,
-	0x70, 0x6f, 0xd3, 0x38, 0x8e, 0x19, 0x37, 0xe6, 0x6d, 0x56, 0x44, 0x4f, 0x60, 0x86, 0x5c, 0x85,
-	0xfc, 0x23, 0xb4, 0x14, 0xe5, 0x14, 0x16, 0xff, 0x7d, 0x78, 0x15, 0x62, 0x83, 0x69, 0xea, 0x1f,
-	0x40, 0x4d, 0x89, 0x50, 0x05, 0xca, 0x83, 0x7d, 0x6d, 0x0a, 0xb5, 0x69, 0xfb, 0x66, 0x6f, 0xb7,
-	0x6f, 0xee, 0xef, 0x19, 0x87, 0x5a, 0x09, 0xcd, 0xc1, 0xf4, 0xee, 0xf6, 0xa1, 0x56, 0xd6, 0x43,
-	0xd0, 0xf2, 0xe7, 0x03, 0x63, 0xf4, 0x1e, 0x40, 0xd3, 0x72, 0x1c, 0xec, 0x98, 0x59, 0x92, 0x0d,
-	0x26, 0x7c, 0x25, 0x98, 0xbe, 0x07, 0x6d, 0x3e, 0xfe, 0x13, 0xb5, 0x69, 0xa6, 0xd6, 0x12, 0x62,
-	0xa1, 0xa8, 0xdf, 0x15, 0xbe, 0x10, 0x43, 0x3c, 0xd7, 0x98, 0x6e, 0xc1, 0x7c, 0xc1, 0x59, 0x01,
-	0xba, 0xaf, 0xd4, 0x92, 0x60, 0x10, 0x1a, 0x83, 0x3e, 0x63, 0xb9, 0x0e, 0x73, 0xe2, 0xbc, 0x40,
-	0xc4, 0x4c, 0x2b, 0xab, 0x66, 0xc8, 0x6a, 0xfd, 0x59, 0xae, 0x09, 0xc1, 0xe4, 0x8d, 0x4d, 0xe8,
-	0xf7, 0xa0, 0xa6, 0x04, 0x08, 0xc1, 0x0c, 0x5d, 0xb8, 0x0b, 0xea, 0xec, 0xb7, 0x1e, 0xc0, 0x9c,
-	0x50, 0x40, 0x4f, 0xa0, 0xe9, 0xfa, 0xc7, 0xc1, 0xc8, 0x77, 0xcc, 0x68, 0xe4, 0xe1, 0x58, 0x0c,
-	0xef, 0xba, 0x8c, 0xba, 0x91, 0x87, 0x8d, 0x86, 0xd0, 0xa0, 0x85, 0x18, 0x6d, 0x40, 0x2b, 0x18,
-	0x91, 0xb4, 0x49, 0x79, 0xdc, 0xa4, 0x29, 0x55, 0x98, 0x8d, 0xfe, 0x53, 0x40, 0xe3, 0xc7, 0x16,
-	0xe8, 0x5e, 0xaa, 0x27, 0x6d, 0xd9, 0x13, 0xa6, 0x20, 0x7c, 0xf5, 0x10, 0x2a, 0xfc, 0xe8, 0x42,
-	0xb8, 0xaa, 0x99, 0x51, 0x32, 0x44, 0xa5, 0xfe, 0x34, 0x8b, 0x2e, 0xfc, 0xf4, 0x26, 0x74, 0x7d,
-	0x03, 0xaa, 0xb2, 0x4c, 0xbd, 0x44, 0x5c, 0x1c, 0x49, 0x2f, 0xd1, 0xdf, 0xca, 0x73, 0xe5, 0x94,
-	0xe7, 0xfe, 0xbb, 0x04, 0x15, 0x6e, 0xf4, 0xeb, 0xf1, 0x1c, 0xba, 0x03, 0xb5, 0x91, 0x4f, 0x22,
-	0xcb, 0x7e, 0x8d, 0x1d, 0x36, 0xbc, 0xaa, 0x46, 0x22, 0x40, 0x2b, 0x50, 0x0d, 0x23, 0x6c, 0x3a,
-	0xbe, 0x45, 0xd8, 0x2a, 0xa0, 0x4a, 0xa3, 0x07, 0xf7, 0x7d, 0x8b, 0x50, 0x43, 0xb5, 0x61, 0x63,
-	0xf3, 0x77, 0xcd, 0x48, 0x04, 0xe8, 0xfb, 0x70, 0x2b, 0x88, 0xdc, 0x53, 0xd7, 0xb7, 0x3c, 0x33,
-	0xc6, 0x1e, 0xb6, 0x49, 0x10, 0xb1, 0xf9, 0xb7, 0x66, 0x68, 0xb2, 0xe2, 0x40, 0xc8, 0xf5, 0xbf,
-	0xd3, 0x60, 0x86, 0xb2, 0xa1, 0x39, 0xcb, 0xb2, 0xd9, 0xca, 0x5e, 0xe4, 0x2c, 0x5e, 0x42, 0x1f,
-	0x02, 0xb8, 0xa1, 0x79, 0x8e, 0xa3, 0x98, 0xd6, 0x95, 0x59, 0x12, 0xd0, 0x54, 0x12, 0x38, 0xe2,
-	0x72, 0xa3, 0xe6, 0x86, 0xe2, 0x27, 0xfa, 0x3e, 0xe5, 0x1d, 0x90, 0xc0, 0x0e, 0x3c, 0xb1, 0x2a,
-	0x6a, 0x27, 0x91, 0xcc, 0xc4, 0x86, 0x52, 0x40, 0xcb, 0x30, 0x17, 0x47, 0xb6, 0xe9, 0x63, 0xda,
-	0xc7, 0x69, 0x96, 0x2a, 0x23, 0x7b, 0x17, 0x13, 0xf4, 0x01, 0xd4, 0x68, 0x45, 0x18, 0x44, 0x24,
-	0xee, 0xcc, 0x32, 0x57, 0xaa, 0x01, 0x11, 0x44, 0xc4, 0xb0, 0xfc, 0x53, 0x6c, 0x54, 0xe3, 0xc8,
-	0xa6, 0xa5, 0x98, 0xe2, 0x38, 0x31, 0x61, 0x38, 0x15, 0x8e, 0xe3, 0xc4, 0x44, 0xe0, 0xd0, 0x0a,
-	0x8e, 0x33, 0x37, 0x09, 0xc7, 0x89, 0x09, 0xc7, 0xb9, 0x0b, 0x35, 0xd7, 0x1e, 0x86, 0x26, 0xcb,
-	0x78, 0x74, 0x9e, 0x9f, 0xdd, 0x99, 0x32, 0xaa, 0x54, 0xc4, 0x92, 0xd9, 0x27, 0xd0, 0x52, 0xd5,
-	0xa6, 0x1d, 0x38, 0x72, 0x6a, 0x97, 0x13, 0xf1, 0x40, 0x28, 0xf6, 0x7c, 0x67, 0x2b, 0x70, 0xd8,
-	0xb9, 0x8e, 0xb4, 0xa5, 0x65, 0xf4, 0x00, 0x5a, 0xb4, 0x57, 0x6e, 0x68, 0xc6, 0x98, 0x98, 0xae,
-	0x13, 0x77, 0x80, 0xb1, 0xad, 0xc7, 0x91, 0x3d, 0x08, 0x0f, 0x30, 0x19, 0x38, 0x31, 0x55, 0xa2,
-	0x94, 0x53, 0x4a, 0x75, 0xae, 0xe4, 0xc4, 0x44, 0x29, 0x3d, 0x83, 0x15, 0xe6, 0x38, 0x6b, 0x88,
-	0x1d, 0xd6, 0xbb, 0xb4, 0x7e, 0x83, 0xe9, 0x2f, 0x50, 0x57, 0xd2, 0x7a, 0xda, 0xb5, 0xb4, 0x21,
-	0xf3, 0x54, 0xa1, 0x61, 0x93, 0x1b, 0x52, 0xdf, 0x8d, 0x19, 0xfe, 0x00, 0xe6, 0x05, 0x2d, 0x66,
-	0x25, 0x4d, 0xda, 0xcc, 0xa4, 0xcd, 0xb8, 0x51, 0x7d, 0xa1, 0xbd, 0x01, 0x0d, 0x3f, 0x20, 0xa6,
-	0x8a, 0x84, 0x93, 0xe2, 0x48, 0xa8, 0xfb, 0x01, 0x91, 0x05, 0xb4, 0x06, 0xb4, 0x68, 0xca, 0x80,
-	0x38, 0x65, 0xc8, 0x35, 0x3f, 0x20, 0x07, 0x3c, 0x26, 0x36, 0xa1, 0x29, 0xeb, 0xf9, 0xf7, 0x3c,
-	0x9b, 0xf0, 0x3d, 0xeb, 0xdc, 0x86, 0x7f, 0x52, 0x81, 0x2a, 0xc3, 0xc3, 0x55, 0xa8, 0x7d, 0x1e,
-	0x21, 0x02, 0x35, 0x89, 0x92, 0xdf, 0xbf, 0x06, 0xb5, 0x2f, 0x03, 0xe5, 0x5d, 0x6e, 0x95, 0x04,
-	0xcb, 0x6b, 0x16, 0x2c, 0x25, 0xa6, 0x25, 0xc3, 0x00, 0x6d, 0x03, 0xca, 0x68, 0xf1, 0x98, 0xf1,
-	0xae, 0x8d, 0x99, 0x92, 0xd1, 0x4e, 0x41, 0xb0, 0xb0, 0x79, 0xc4, 0x61, 0x72, 0xa1, 0x33, 0xe4,
-	0x73, 0x1b, 0xef, 0xab, 0xfa, 0x4c, 0x42, 0x37, 0x17, 0x41, 0xbe, 0xd2, 0xed, 0xa7, 0x82, 0xe8,
-	0x13, 0xb8, 0xab, 0x1c, 0x5e, 0x18, 0x0f, 0x21, 0x33, 0x5b, 0x16, 0x9f, 0x60, 0x2c, 0x24, 0x84,
-	0xfd, 0xe4, 0x78, 0xfa, 0x46, 0xd9, 0xf7, 0x8b, 0x42, 0x6a, 0x03, 0x16, 0x93, 0x4c, 0x15, 0xd9,
-	0x49, 0xb6, 0x8a, 0x58, 0x0a, 0x9a, 0x57, 0xd9, 0x2a, 0xb2, 0x65, 0xc2, 0xca, 0xd8, 0xd0, 0x86,
-	0x95, 0x4d, 0x9c, 0xb5, 0xe9, 0xc7, 0x44, 0xd9, 0x6c, 0xc3, 0xbd, 0x4c, 0x3b, 0xc9, 0xf9, 0x98,
-	0xb2, 0x26, 0xcc, 0xfa, 0x4e, 0xaa, 0x45, 0x75, 0x4a, 0x56, 0x08, 0x23, 0xfb, 0x9c, 0x83, 0x19,
-	0x65, 0x61, 0x44, 0xaf, 0xb3, 0x30, 0xcf, 0x61, 0x45, 0xc1, 0x48, 0xf7, 0x2b, 0x80, 0x73, 0x06,
-	0xb0, 0x24, 0x15, 0x76, 0x99, 0xe7, 0x27, 0x9a, 0x66, 0x1c, 0x70, 0x31, 0x66, 0x9a, 0xf6, 0xc1,
-	0x97, 0x3c, 0x61, 0xe4, 0x0f, 0x2d, 0x87, 0x16, 0xb1, 0xcf, 0x3a, 0x97, 0x99, 0xdd, 0x6b, 0xf6,
-	0xcc, 0xf2, 0x15, 0xd5, 0x30, 0x96, 0x62, 0x4a, 0x63, 0x4c, 0x4e, 0x61, 0x39, 0x89, 0x22, 0xd8,
-	0xab, 0x37, 0xc3, 0x3a, 0x94, 0xe2, 0x38, 0xec, 0x87, 0x00, 0x67, 0x84, 0x84, 0x02, 0xe7, 0x67,
-	0x99, 0x05, 0xd1, 0xce, 0xe1, 0xe1, 0x3e, 0xb7, 0xae, 0x51, 0x1d, 0x69, 0x50, 0x95, 0x87, 0x01,
-	0x9d, 0x3f, 0xc8, 0x1c, 0xb4, 0xd3, 0xd9, 0x4d, 0x9d, 0x08, 0x2b, 0x25, 0xf4, 0x1b, 0xb0, 0x90,
-	0x8b, 0x23, 0xc6, 0xa2, 0xf3, 0x47, 0x7c, 0xfa, 0x43, 0x99, 0x38, 0x62, 0x55, 0xa8, 0x0f, 0x6b,
-	0x45, 0x26, 0x49, 0x1c, 0x74, 0xfe, 0x98, 0x1b, 0xdf, 0x1e, 0x37, 0x56, 0x61, 0x90, 0x69, 0x38,
-	0xf5, 0x45, 0x3a, 0x3f, 0xcf, 0x35, 0x7c, 0xa0, 0x1c, 0x9e, 0x69, 0x38, 0xfd, 0x11, 0x93, 0x86,
-	0xff, 0x24, 0xd7, 0x70, 0x62, 0x9c, 0x34, 0xdc, 0x81, 0x39, 0xba, 0x32, 0x31, 0x5d, 0xa7, 0xf3,
-	0x4b, 0x31, 0xc7, 0xd3, 0xf2, 0xc0, 0x79, 0x51, 0x81, 0x19, 0x9a, 0xa2, 0x5e, 0x00, 0x54, 0x65,
-	0xba, 0xfa, 0xbc, 0x52, 0xfd, 0x45, 0x49, 0xfb, 0x65, 0xc9, 0x00, 0x2f, 0x38, 0x35, 0xc3, 0x08,
-	0x9f, 0xb8, 0x97, 0xfa, 0x67, 0x30, 0x5f, 0xf4, 0xb1, 0x56, 0xa1, 0xaa, 0x82, 0x90, 0x03, 0xab,
-	0x32, 0xdd, 0x9b, 0x30, 0x96, 0x62, 0xc1, 0xce, 0x0b, 0xfa, 0xdf, 0x96, 0xa0, 0xa6, 0x3e, 0x23,
-	0xdf, 0x7b, 0x90, 0xb3, 0xc0, 0xe1, 0xeb, 0x2c, 0xb6, 0xf7, 0x60, 0x45, 0xf4, 0x04, 0x66, 0x43,
-	0x8b, 0x9c, 0xc9, 0xc5, 0xd4, 0x6a, 0x3e, 0x02, 0x1e, 0xef, 0x5b, 0xe4, 0x8c, 0xc7, 0x02, 0x57,
-	0x5c, 0xfd, 0x02, 0x6a, 0x4a, 0x86, 0x96, 0x60, 0x16, 0x5f, 0x5a, 0x36, 0xe1, 0xac, 0x76, 0xa6,
-	0x0c, 0x5e, 0x44, 0x1d, 0xa8, 0xf0, 0x1e, 0xf1, 0xf5, 0xdf, 0xce, 0x94, 0x21, 0xca, 0x2f, 0x1a,
-	0x00, 0x14, 0x87, 0xc7, 0x9d, 0xfe, 0xd7, 0x25, 0x68, 0xa4, 0xc3, 0x07, 0x7d, 0x0a, 0x75, 0xcb,
-	0xf7, 0x03, 0xc2, 0x4e, 0x35, 0xe5, 0xaa, 0xf0, 0xdd, 0x82, 0x40, 0x7b, 0xdc, 0x4b, 0xd4, 0xf8,
-	0x6e, 0x2e, 0x6d, 0xb8, 0xfa, 0x09, 0x68, 0x79, 0x85, 0xb7, 0xda, 0xd7, 0x3d, 0x87, 0x76, 0x6e,
-	0xda, 0x60, 0xab, 0x5c, 0x3a, 0x0f, 0x51, 0xfb, 0x59, 0xbe, 0x11, 0xa3, 0x32, 0x36, 0xe1, 0x94,
-	0xb9, 0x8c, 0xfe, 0xd6, 0x5f, 0x42, 0x55, 0x4d, 0xb8, 0x1d, 0xa8, 0x88, 0x23, 0x8d, 0x92, 0x58,
-	0xea, 0x88, 0x32, 0x5a, 0x48, 0xaf, 0x8f, 0x77, 0xa6, 0xf8, 0x0a, 0xf9, 0x85, 0x06, 0x2d, 0x5e,
-	0x6f, 0x06, 0x11, 0x0b, 0x3e, 0xfd, 0x29, 0xd4, 0xd4, 0x04, 0x49, 0xf9, 0x9e, 0xb8, 0x51, 0x4c,
-	0x04, 0x07, 0x5e, 0xa0, 0x24, 0x3c, 0x2b, 0x26, 0x92, 0x04, 0xfd, 0xad, 0xff, 0x45, 0x09, 0x50,
-	0xfe, 0x54, 0x66, 0xd0, 0xa7, 0x1b, 0xb8, 0x20, 0xb2, 0xcf, 0x70, 0x4c, 0x22, 0x8b, 0x04, 0x11,
-	0x8d, 0x54, 0xde, 0xf5, 0x56, 0x5a, 0x3c, 0x70, 0xd0, 0x3d, 0xa8, 0xab, 0x23, 0x20, 0xd7, 0x11,
-	0xe7, 0x03, 0x20, 0x45, 0x5c, 0x41, 0x1d, 0x0d, 0xb9, 0x0e, 0x5b, 0x3f, 0xd7, 0x0c, 0x90, 0xa2,
-	0x81, 0xf3, 0xf9, 0x4c, 0xb5, 0xa4, 0x95, 0x8d, 0xea, 0x59, 0x10, 0x13, 0xd6, 0x91, 0x4b, 0x58,
-	0x2a, 0xbe, 0x3c, 0x44, 0xef, 0xa7, 0xf6, 0x1a, 0x2b, 0x13, 0x4e, 0x94, 0xc4, 0x9e, 0xe6, 0x23,
-	0xa8, 0xca, 0x26, 0xc4, 0xb1, 0xda, 0xf2, 0xa4, 0xdb, 0x43, 0xa5, 0xa8, 0xff, 0xcf, 0x34, 0x68,
-	0xf9, 0x6a, 0xea, 0xca, 0x98, 0x58, 0x44, 0x6e, 0xed, 0x78, 0xa1, 0x68, 0xd7, 0x42, 0xc3, 0x66,
-	0x68, 0xd9, 0xc2, 0x05, 0xf4, 0x27, 0xed, 0xbb, 0xbc, 0xb5, 0xa6, 0x73, 0x30, 0x5f, 0x57, 0x83,
-	0x10, 0xd1, 0x69, 0xf7, 0x36, 0xd4, 0xdc, 0xf0, 0x7c, 0x93, 0x2e, 0x87, 0xf8, 0xda, 0xba, 0x66,
-	0x54, 0xa9, 0x60, 0x17, 0x13, 0x59, 0xd9, 0xe5, 0x95, 0x15, 0x55, 0xd9, 0x65, 0x95, 0x0f, 0x61,
-	0x96, 0x6e, 0x9f, 0xe4, 0x4a, 0x5a, 0x2e, 0xe7, 0x0e, 0x5d, 0x1c, 0x0d, 0xfc, 0x93, 0xc0, 0xe0,
-	0xb5, 0xe8, 0x7d, 0xa8, 0xf2, 0x06, 0x2c, 0xd2, 0xa9, 0x32, 0xcd, 0x96, 0xba, 0x7a, 0x22, 0x4c,
-	0x71, 0x8e, 0xb5, 0x67, 0x11, 0xa1, 0xda, 0x65, 0xaa, 0xb5, 0x89, 0xaa, 0x5d, 0xaa, 0xda, 0x83,
-	0xbb, 0x96, 0xe7, 0x05, 0x17, 0x66, 0x1c, 0x06, 0xc1, 0x09, 0x76, 0x4c, 0x71, 0xf6, 0xc4, 0x87,
-	0x2e, 0x96, 0x6b, 0xe9, 0x55, 0xa6, 0x74, 0xc0, 0x75, 0xf8, 0x61, 0xcf, 0xbe, 0xd0, 0x40, 0x9f,
-	0x67, 0xc7, 0x6f, 0x9d, 0x35, 0xb8, 0x3e, 0xe1, 0x1b, 0xfd, 0x3f, 0x8f, 0xe1, 0xad, 0xf1, 0x88,
-	0x13, 0xbb, 0xdb, 0x9b, 0x47, 0x9c, 0xde, 0x83, 0x56, 0xfa, 0xc4, 0x76, 0xd0, 0xcf, 0x47, 0x7e,
-	0xf9, 0x8d, 0x91, 0xef, 0x01, 0x1a, 0xbf, 0xd8, 0x47, 0x0f, 0x53, 0x1c, 0x16, 0x0b, 0xce, 0x86,
-	0x45, 0xc4, 0x7f, 0x98, 0x8a, 0xf8, 0xe9, 0xcc, 0xb4, 0x9b, 0xb9, 0xdd, 0x4f, 0xa2, 0xfd, 0xbf,
-	0xca, 0xd0, 0x48, 0x57, 0x15, 0x9d, 0x61, 0xe4, 0x23, 0xb8, 0x3c, 0x16, 0xc1, 0x2a, 0x0e, 0xa7,
-	0xaf, 0x8d, 0xc3, 0xc7, 0x30, 0x8f, 0x2f, 0x43, 0x6c, 0x13, 0xec, 0x98, 0x2c, 0x20, 0x2d, 0xc7,
-	0x89, 0xe4, 0x88, 0xb8, 0x25, 0xab, 0x06, 0xe1, 0xf9, 0x66, 0x8f, 0x56, 0xe4, 0xf5, 0xbb, 0x42,
-	0x7f, 0x76, 0x4c, 0xbf, 0xcb, 0xf5, 0x7f, 0x08, 0x6d, 0xb5, 0x5f, 0x37, 0x39, 0xa1, 0x4a, 0x31,
-	0xa1, 0x96, 0xd2, 0x3b, 0x64, 0xcc, 0x9e, 0x42, 0x4b, 0x6e, 0xee, 0xcd, 0x6b, 0x47, 0x54, 0x43,
-	0xec, 0xf9, 0xb9, 0xd9, 0x26, 0x34, 0x4f, 0x82, 0xe8, 0xc2, 0x8a, 0x64, 0x73, 0xd5, 0x09, 0x56,
-	0x42, 0x8b, 0x59, 0xe9, 0xbf, 0x99, 0xfd, 0xc2, 0x22, 0xca, 0x6e, 0xf6, 0x85, 0xf5, 0x08, 0xaa,
-	0x12, 0xb6, 0xf0, 0x5b, 0xbd, 0x0f, 0x9a, 0xeb, 0x9f, 0x46, 0x38, 0x8e, 0xf9, 0x53, 0x14, 0x57,
-	0xcd, 0xf5, 0x6d, 0x21, 0xdf, 0x17, 0x62, 0x9a, 0xde, 0x71, 0x4e, 0x53, 0x9c, 0xcf, 0xe1, 0x8c,
-	0xa2, 0xfe, 0x0c, 0xe6, 0xc4, 0xe8, 0x47, 0x8b, 0x50, 0xc1, 0x97, 0x74, 0x4f, 0x21, 0x33, 0x21,
-	0xbe, 0x24, 0x83, 0x90, 0x8a, 0x59, 0x80, 0x87, 0x72, 0x5c, 0x51, 0xc2, 0xa1, 0x6e, 0xc0, 0x7c,
-	0xc1, 0xd5, 0x0b, 0x7a, 0x00, 0x4d, 0x37, 0x0e, 0x4c, 0xe2, 0x0e, 0x71, 0x4c, 0xac, 0xa1, 0xc4,
-	0x6a, 0xb8, 0x71, 0x70, 0x28, 0x65, 0x68, 0x09, 0x2a, 0xa3, 0x90, 0xaa, 0x30, 0xc8, 0x92, 0x21,
-	0x4a, 0x7a, 0x08, 0x9d, 0x49, 0xd7, 0x2e, 0x37, 0x1d, 0x25, 0x1f, 0x40, 0x85, 0x5f, 0x08, 0x88,
-	0xb3, 0xae, 0x45, 0x75, 0x6b, 0x9a, 0xb9, 0x70, 0x10, 0x4a, 0xfa, 0x3a, 0xb4, 0xb2, 0x35, 0xec,
-	0x40, 0x99, 0x03, 0xc8, 0x03, 0x65, 0xae, 0xd9, 0x2b, 0xe2, 0xf6, 0x76, 0xdf, 0xf7, 0x12, 0xee,
-	0x5c, 0x77, 0x1b, 0xf3, 0x36, 0xd3, 0xdf, 0x5b, 0x76, 0x73, 0x30, 0xa9, 0xe5, 0xb7, 0x4f, 0x83,
-	0xa7, 0xb0, 0x58, 0x78, 0xab, 0x82, 0xee, 0x02, 0x84, 0xa3, 0x63, 0xcf, 0xb5, 0xcd, 0x24, 0x2f,
-	0xd7, 0xb8, 0xe4, 0x0b, 0x7c, 0xf5, 0xd6, 0x87, 0x5b, 0xfa, 0x2d, 0x68, 0xe7, 0x2e, 0x5b, 0xf4,
-	0x3f, 0x2d, 0xc3, 0x52, 0xf1, 0x05, 0x26, 0x5d, 0x18, 0xcb, 0x34, 0x2b, 0x17, 0xc6, 0xb2, 0xac,
-	0x26, 0x61, 0x9a, 0x62, 0x44, 0x10, 0xb3, 0x49, 0x93, 0x66, 0x16, 0x35, 0x09, 0xb3, 0xca, 0x69,
-	0x55, 0xc9, 0xd2, 0x0e, 0x45, 0xb5, 0x62, 0xb1, 0x6e, 0xe3, 0x0b, 0x1b, 0x55, 0x46, 0x3d, 0xa8,
-	0x78, 0xd6, 0x31, 0xf6, 0xe4, 0x99, 0xd9, 0xfb, 0xd7, 0xde, 0xb0, 0x3e, 0x7e, 0xc9, 0x74, 0xc5,
-	0x75, 0x03, 0x37, 0x5c, 0x7d, 0x0e, 0xf5, 0x94, 0xf8, 0xad, 0xa6, 0xb4, 0xdf, 0x1e, 0xf7, 0x84,
-	0xf8, 0x96, 0xff, 0x57, 0x4f, 0xe8, 0xaf, 0x78, 0xee, 0xca, 0x3d, 0x68, 0xfa, 0xae, 0xe0, 0x7e,
-	0x55, 0x76, 0x7b, 0xb0, 0x50, 0x74, 0xd3, 0x7e, 0x03, 0xc0, 0x6e, 0x1e, 0xb0, 0x5b, 0x0c, 0x78,
-	0x63, 0x86, 0x13, 0x00, 0xb7, 0xa1, 0x95, 0x7d, 0xb2, 0x55, 0x70, 0xb5, 0x32, 0x13, 0x06, 0x81,
-	0x27, 0xc6, 0x6c, 0x3b, 0xff, 0x48, 0x8b, 0x55, 0xea, 0xf7, 0x13, 0x98, 0x09, 0x97, 0x26, 0x3f,
-	0x83, 0xaa, 0xd4, 0x60, 0xfb, 0x0e, 0xd7, 0x51, 0x27, 0xee, 0xf4, 0x37, 0x5a, 0x03, 0x18, 0x5a,
-	0xf1, 0x37, 0x23, 0x1c, 0x59, 0x62, 0x47, 0x52, 0x35, 0x52, 0x12, 0xde, 0x0b, 0x37, 0x34, 0x87,
-	0x74, 0xc3, 0xa2, 0x42, 0xde, 0x0d, 0x5f, 0xd1, 0xcd, 0xcd, 0x5d, 0x80, 0xf3, 0x4b, 0xcf, 0xf2,
-	0x79, 0x2d, 0x0f, 0xfa, 0x1a, 0x93, 0xd0, 0x6a, 0xfd, 0x0f, 0x4b, 0xd0, 0xcc, 0xbc, 0x40, 0x41,
-	0xef, 0x40, 0x83, 0xa1, 0x61, 0xdf, 0x3a, 0xf6, 0x30, 0xe7, 0x59, 0x35, 0xea, 0x54, 0xb6, 0xcd,
-	0x45, 0x74, 0x52, 0xe0, 0x98, 0x52, 0x87, 0x73, 0x6a, 0x30, 0xa1, 0x54, 0x5a, 0x07, 0x2d, 0xa3,
-	0x64, 0x9e, 0x77, 0xc5, 0x49, 0x7d, 0x2b, 0xad, 0x77, 0xd4, 0xd5, 0xff, 0xa1, 0x04, 0x0b, 0x45,
-	0x2f, 0xc8, 0xd0, 0x7b, 0xa9, 0x34, 0xb6, 0x5c, 0x78, 0x14, 0x22, 0xd2, 0xe7, 0x8f, 0xd5, 0xd8,
-	0xe5, 0xbb, 0xdd, 0xf7, 0xae, 0x79, 0x97, 0xf6, 0x5d, 0x8f, 0xdc, 0x1f, 0xe7, 0xc9, 0xab, 0xdb,
-	0xef, 0x9b, 0x91, 0xd7, 0xfb, 0xa0, 0xe5, 0xe5, 0xd9, 0x6b, 0x8a, 0x52, 0xfe, 0x9a, 0xa2, 0xe8,
-	0x0a, 0xe6, 0xef, 0x4b, 0xd0, 0xce, 0x3d, 0x71, 0x43, 0x7a, 0x8a, 0x02, 0xca, 0xbf, 0x60, 0x13,
-	0xae, 0xfb, 0x38, 0xe7, 0x3a, 0xbd, 0xf8, 0xb9, 0xdc, 0x77, 0xed, 0xb5, 0xa7, 0x29, 0xb6, 0xc2,
-	0x61, 0x37, 0x60, 0xab, 0xbf, 0x03, 0xf5, 0x94, 0xa8, 0xf0, 0x16, 0xef, 0x10, 0x80, 0xbf, 0x54,
-	0x3b, 0x14, 0xfb, 0x78, 0x1a, 0xb9, 0x22, 0x8a, 0xd9, 0x6f, 0xc6, 0x8a, 0x46, 0xa0, 0x08, 0x5b,
-	0x5e, 0xa0, 0x2e, 0x57, 0xaf, 0x08, 0xe4, 0x95, 0x92, 0x12, 0xe8, 0xff, 0x56, 0x86, 0x7a, 0xea,
-	0xed, 0x1e, 0x7a, 0x37, 0x75, 0x66, 0x90, 0x4c, 0x7c, 0x4c, 0x23, 0xb9, 0xce, 0x45, 0x1f, 0xd1,
-	0xb1, 0xc4, 0xdf, 0x73, 0x32, 0x6d, 0x3e, 0x4d, 0xde, 0x52, 0x89, 0x82, 0x0e, 0x79, 0xa6, 0x0e,
-	0x6e, 0x28, 0x7f, 0x53, 0x37, 0x3a, 0x31, 0x91, 0xdb, 0x52, 0x27, 0x26, 0x48, 0x87, 0x26, 0x3b,
-	0x34, 0x0d, 0x1c, 0x7e, 0x70, 0x25, 0x86, 0x71, 0xdd, 0x89, 0xc9, 0x6e, 0xe0, 0xb0, 0x73, 0x2a,
-	0xb4, 0x06, 0x75, 0xa5, 0xe3, 0x86, 0xf2, 0x6a, 0x4b, 0x68, 0x0c, 0x42, 0xba, 0x31, 0x88, 0xad,
-	0x21, 0x36, 0xe3, 0xd1, 0xb1, 0x8f, 0x09, 0x7b, 0xe6, 0x51, 0x35, 0x80, 0x8a, 0x0e, 0x98, 0x84,
-	0x8e, 0x7b, 0xba, 0xa4, 0x0e, 0x46, 0xe4, 0x34, 0x70, 0xfd, 0x53, 0x76, 0x85, 0x53, 0x35, 0xea,
-	0xbe, 0x45, 0xf6, 0x84, 0x08, 0x3d, 0x84, 0x96, 0x17, 0xd8, 0x96, 0x67, 0xca, 0xe3, 0x02, 0x76,
-	0x87, 0x53, 0x35, 0x9a, 0x4c, 0x2a, 0x17, 0x18, 0x68, 0x03, 0xea, 0x84, 0x7d, 0x01, 0xde, 0x69,
-	0xfe, 0xe0, 0x42, 0x76, 0x3a, 0xf9, 0x36, 0x06, 0x10, 0xf5, 0x5b, 0xbf, 0x27, 0xdc, 0x2b, 0x62,
-	0x41, 0xf8, 0xa0, 0xac, 0x7c, 0xa0, 0xff, 0x67, 0x09, 0x56, 0x26, 0xbe, 0x65, 0x64, 0x81, 0x40,
-	0xf3, 0x9b, 0x0c, 0x04, 0x9a, 0xf9, 0xc4, 0xf6, 0xbe, 0x9c, 0x6c, 0xef, 0x33, 0x13, 0xd2, 0x74,
-	0x6e, 0xe1, 0xb0, 0x0e, 0x5a, 0x68, 0x45, 0xd8, 0x27, 0xa6, 0x83, 0xd9, 0x11, 0xa1, 0x1b, 0x0a,
-	0x3f, 0xb7, 0xb8, 0xbc, 0xcf, 0xc4, 0x7c, 0x05, 0x3d, 0xb4, 0x6c, 0x9a, 0xcf, 0xb8, 0x97, 0x67,
-	0x87, 0x96, 0x7d, 0xd4, 0xcd, 0x4e, 0x26, 0x95, 0xdc, 0xca, 0xe3, 0x07, 0x80, 0xf2, 0xe8, 0xe7,
-	0x5d, 0xf6, 0x15, 0x6a, 0x86, 0x96, 0xc5, 0x3f, 0xef, 0xea, 0x1f, 0x16, 0xf6, 0x55, 0xf8, 0xa6,
-	0xa0, 0xaf, 0xfa, 0xcf, 0x4b, 0xb0, 0x3c, 0xe1, 0x45, 0xe5, 0xb5, 0x13, 0x60, 0x76, 0x91, 0x57,
-	0xce, 0x2f, 0xf2, 0x1e, 0xc3, 0xbc, 0xeb, 0x13, 0x1c, 0x9d, 0x58, 0x9c, 0x71, 0xc6, 0x75, 0xb7,
-	0x54, 0x95, 0xdc, 0x06, 0xea, 0x4f, 0x0b, 0x58, 0xbc, 0x79, 0x1a, 0xd6, 0xff, 0xbc, 0x04, 0x2b,
-	0x13, 0xdf, 0x0e, 0x5e, 0xcb, 0x5f, 0x87, 0x66, 0xc2, 0x9f, 0x7e, 0x11, 0xde, 0x85, 0xba, 0xea,
-	0xc2, 0x51, 0x77, 0xac, 0x13, 0xdd, 0x89, 0x9d, 0xe0, 0xf3, 0xfe, 0xb3, 0x42, 0x32, 0x37, 0xe8,
-	0xc6, 0x3f, 0x96, 0x60, 0xb1, 0xf0, 0x6d, 0x28, 0xda, 0x80, 0x45, 0x79, 0xf0, 0x6c, 0x7b, 0xa3,
-	0x98, 0xe0, 0xc8, 0xa4, 0x33, 0xbb, 0x3c, 0xb4, 0x9d, 0x17, 0x95, 0x5b, 0xbc, 0x6e, 0x8b, 0x56,
-	0xa1, 0xcd, 0xe4, 0x99, 0x34, 0xbe, 0x24, 0x38, 0xf2, 0x2d, 0x4f, 0x18, 0x95, 0xc5, 0x1d, 0x25,
-	0xaf, 0xdd, 0x16, 0x95, 0xdc, 0xea, 0x47, 0xb0, 0x2a, 0xad, 0xe8, 0x58, 0x3c, 0xb6, 0x3c, 0xcb,
-	0xb7, 0x55, 0x73, 0x7c, 0xcf, 0xd8, 0x11, 0x1a, 0x2f, 0x53, 0x0a, 0xcc, 0x5a, 0xff, 0x1a, 0xea,
-	0x62, 0x2a, 0xda, 0x0f, 0x22, 0x42, 0x3b, 0x2b, 0x0f, 0x3c, 0x65, 0x67, 0xd5, 0x01, 0x28, 0x82,
-	0x19, 0xaa, 0x23, 0xcf, 0x26, 0xa5, 0x3e, 0xcd, 0x36, 0x4c, 0x3e, 0xcd, 0xe4, 0xaa, 0x4c, 0xc7,
-	0x6f, 0x33, 0xf3, 0x56, 0xb5, 0x70, 0x4b, 0x9c, 0x99, 0xf7, 0xca, 0x05, 0xf3, 0x9e, 0x7a, 0x4f,
-	0x53, 0x13, 0x29, 0xf6, 0x2e, 0x80, 0x74, 0xa9, 0x1a, 0xb0, 0x35, 0x21, 0x19, 0x84, 0x74, 0xe3,
-	0x9c, 0xf1, 0x83, 0x4a, 0x8d, 0xad, 0xb4, 0x78, 0x10, 0xd2, 0xf4, 0xa7, 0xdc, 0xec, 0x86, 0xf2,
-	0xfc, 0xae, 0x2e, 0x65, 0x83, 0x30, 0x46, 0xeb, 0x30, 0x9b, 0xbe, 0x0c, 0x47, 0xd9, 0x49, 0x9d,
-	0x1d, 0xe6, 0x72, 0x05, 0xbd, 0xa7, 0xfa, 0x9a, 0x1a, 0xb3, 0x6f, 0xd5, 0xd7, 0x47, 0xeb, 0x50,
-	0x53, 0xdb, 0x28, 0x34, 0x07, 0xd3, 0xbd, 0xdd, 0xaf, 0xb5, 0x29, 0x54, 0x85, 0x99, 0xc1, 0xfe,
-	0xd1, 0xa6, 0x36, 0x23, 0x7e, 0x75, 0xb5, 0xca, 0xa3, 0x3f, 0x2b, 0x41, 0x4d, 0x4d, 0x3c, 0xa8,
-	0x09, 0xb5, 0xad, 0x41, 0xdf, 0x30, 0x07, 0xbb, 0x9f, 0xee, 0x69, 0x53, 0x68, 0x1e, 0xda, 0xc6,
-	0xf6, 0xab, 0xbd, 0xc3, 0x6d, 0xf3, 0xab, 0x3d, 0xe3, 0x8b, 0x97, 0x7b, 0xbd, 0xbe, 0x56, 0x42,
-	0x6d, 0xa8, 0x0b, 0xe1, 0xce, 0xde, 0xc1, 0xa1, 0x56, 0x46, 0x08, 0x5a, 0x2f, 0xf7, 0xb6, 0x7a,
-	0x2f, 0x13, 0xa5, 0x69, 0xd4, 0x02, 0xe0, 0x32, 0xa6, 0x33, 0x83, 0x6e, 0x41, 0x53, 0x18, 0x1d,
-	0x7e, 0xb9, 0xbb, 0xbb, 0xfd, 0x52, 0x9b, 0x45, 0x1a, 0x34, 0xb8, 0x8a, 0x90, 0x54, 0x1e, 0x3d,
-	0x07, 0x48, 0x66, 0x35, 0xca, 0x71, 0x77, 0x6f, 0x77, 0x5b, 0x9b, 0x42, 0x0d, 0xa8, 0xee, 0xee,
-	0x99, 0xdb, 0xbb, 0x5b, 0xbd, 0x7d, 0xad, 0x84, 0x6a, 0x30, 0xcb, 0xd2, 0x9b, 0x56, 0xe6, 0xdd,
-	0x18, 0xec, 0x6b, 0xd3, 0x1b, 0x9f, 0x00, 0xf0, 0x27, 0x24, 0xec, 0x7f, 0xaa, 0x9e, 0xc0, 0x0c,
-	0xfb, 0xab, 0x9c, 0x9c, 0xfc, 0xa7, 0xd6, 0xaa, 0x94, 0xa5, 0xfe, 0x5b, 0xeb, 0x49, 0xe9, 0xc5,
-	0xf2, 0x2f, 0xbe, 0x5d, 0x2b, 0xfd, 0xf3, 0xb7, 0x6b, 0xa5, 0x7f, 0xff, 0x76, 0xad, 0xf4, 0x97,
-	0xff, 0xb1, 0x36, 0xf5, 0x93, 0x59, 0x76, 0x85, 0x7e, 0x5c, 0x61, 0x7f, 0x3e, 0xfa, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x43, 0xda, 0xdb, 0xf5, 0x0b, 0x36, 0x00, 0x00,
+	// 5362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x5b, 0x73, 0x24, 0xc9,
+	0x55, 0xf0, 0x74, 0xeb, 0xd6, 0x7d, 0x5a, 0xdd, 0xea, 0x49, 0xdd, 0x4a, 0x5a, 0xcd, 0xc5, 0xb5,
+	0xb3, 0xde, 0xd9, 0xb5, 0x77, 0x3c, 0xdf, 0x78, 0x46, 0xe3, 0xf5, 0x67, 0xd6, 0xd1, 0xba, 0xec,
+	0xaa, 0xed, 0x19, 0x8d, 0x5c, 0x92, 0x67, 0xb1, 0x71, 0x44, 0x51, 0xaa, 0x4a, 0x49, 0xe5, 0xed,
+	0xae, 0xaa, 0xad, 0xcc, 0xd6, 0xc5, 0x3c, 0x01, 0x26, 0xb0, 0x31, 0x60, 0x03, 0xc6, 0x98, 0x5b,
+	0x60, 0xae, 0x11, 0x80, 0xf9, 0x05, 0x3c, 0xf0, 0x6a, 0x07, 0x2f, 0x10, 0x7e, 0xe2, 0x81, 0x08,
+	0x62, 0x79, 0xe3, 0x0d, 0x7e, 0x01, 0x71, 0xf2, 0x56, 0x55, 0xdd, 0xd5, 0x9a, 0x19, 0xaf, 0x83,
+	0x27, 0x75, 0x9e, 0x5b, 0x9e, 0x3c, 0x79, 0xf2, 0xe4, 0xc9, 0x93, 0x59, 0x02, 0x72, 0x44, 0x7b,
+	0xe1, 0xf9, 0xa1, 0xe7, 0xbf, 0x47, 0xa3, 0xe0, 0x4e, 0x92, 0xc6, 0x3c, 0x26, 0x53, 0x02, 0x66,
+	0xdf, 0x83, 0xc6, 0xfe, 0x45, 0xe4, 0x3b, 0xf4, 0xfd, 0x01, 0x65, 0x9c, 0xbc, 0x0c, 0x4d, 0xbf,
+	0x37, 0x60, 0x9c, 0xa6, 0x2e, 0xe3, 0x1e, 0xa7, 0x56, 0xe5, 0x66, 0xe5, 0x76, 0xcd, 0x99, 0x55,
+	0xc0, 0x7d, 0x84, 0xd9, 0xff, 0xbc, 0x04, 0x8d, 0x83, 0x78, 0xcb, 0xe3, 0x5e, 0xd2, 0xf3, 0x22,
+	0x4a, 0x6e, 0xc3, 0x4c, 0x18, 0xb9, 0xec, 0x22, 0xf2, 0x05, 0x79, 0xe3, 0x5e, 0xf3, 0x8e, 0x10,
+	0x7e, 0xa7, 0x1b, 0xa1, 0xec, 0x9d, 0x2b, 0xce, 0x74, 0x28, 0x7e, 0x91, 0x87, 0x30, 0x1b, 0x26,
+	0x8c, 0x72, 0x77, 0x90, 0x04, 0x28, 0xbd, 0x2a, 0xc8, 0x89, 0x26, 0xdf, 0xdb, 0xa7, 0xfc, 0x8b,
+	0x02, 0xb3, 0x73, 0xc5, 0x69, 0x08, 0x4a, 0xd9, 0x24, 0xef, 0x00, 0x91, 0x8c, 0x01, 0xed, 0x71,
+	0x4f, 0xb3, 0x4f, 0x08, 0xf6, 0xe5, 0x3c, 0xfb, 0x16, 0xe2, 0x8d, 0x8c, 0xb6, 0x60, 0xca, 0xc1,
+	0x32, 0x0d, 0x52, 0xda, 0x8f, 0x4f, 0xa9, 0x35, 0x39, 0xaa, 0x81, 0x23, 0x30, 0x46, 0x03, 0xd9,
+	0x24, 0x7b, 0xb0, 0xe8, 0xf9, 0x3c, 0x3c, 0xa5, 0x6e, 0x92, 0xc6, 0x47, 0x61, 0x8f, 0x6a, 0x25,
+	0xa6, 0x84, 0x84, 0x55, 0x25, 0xa1, 0x23, 0x68, 0xf6, 0x24, 0x89, 0xd1, 0x63, 0xde, 0x1b, 0x05,
+	0x97, 0x48, 0x54, 0x3a, 0x4d, 0x8f, 0x97, 0x68, 0x74, 0x9b, 0xf7, 0x46, 0xc1, 0xe4, 0x31, 0x2c,
+	0x68, 0x89, 0x71, 0x2f, 0xf4, 0x2f, 0xb4, 0x8a, 0x33, 0x42, 0xe0, 0x4a, 0x51, 0xa0, 0xa0, 0x30,
+	0x1a, 0x12, 0x6f, 0x04, 0x3a, 0x2a, 0x4e, 0xe9, 0x57, 0x1b, 0x2b, 0xce, 0xa8, 0x47, 0xbc, 0x11,
+	0x28, 0x8a, 0x3b, 0x89, 0x19, 0x77, 0x69, 0x14, 0x24, 0x71, 0x18, 0x19, 0x27, 0xa8, 0x17, 0xc4,
+	0xed, 0xc4, 0x8c, 0x6f, 0x2b, 0x8a, 0x4c, 0xbb, 0x93, 0x11, 0xe8, 0xa8, 0x38, 0xa5, 0x1d, 0x8c,
+	0x15, 0x97, 0x69, 0x77, 0x32, 0x02, 0x25, 0x5f, 0x02, 0xeb, 0x2c, 0x4e, 0xdf, 0xeb, 0xc5, 0x5e,
+	0x30, 0xa2, 0x61, 0x43, 0x88, 0xbc, 0xa6, 0x44, 0xbe, 0xab, 0xc8, 0x46, 0xb4, 0x5c, 0x3a, 0x2b,
+	0xc5, 0x94, 0x8b, 0x56, 0xda, 0xce, 0x5e, 0x2a, 0xda, 0x68, 0xbc, 0x74, 0x56, 0x8a, 0x21, 0x9f,
+	0x86, 0xa6, 0x1f, 0x47, 0x47, 0xe1, 0xb1, 0x56, 0xb5, 0x29, 0xe4, 0xcd, 0x2b, 0x79, 0x9b, 0x02,
+	0x67, 0x14, 0x9c, 0xf5, 0x73, 0x6d, 0x63, 0xc0, 0x3e, 0xe5, 0x5e, 0xe0, 0x65, 0xab, 0xaa, 0x35,
+	0x62, 0xc0, 0xc7, 0x8a, 0xa2, 0x38, 0x1f, 0x45, 0x28, 0x79, 0x15, 0xe6, 0x18, 0x46, 0x91, 0xc8,
+	0xa7, 0x6e, 0x34, 0xe8, 0x1f, 0xd2, 0xd4, 0x9a, 0xbb, 0x59, 0xb9, 0x3d, 0xe9, 0xb4, 0x34, 0x78,
+	0x57, 0x40, 0x49, 0x07, 0xda, 0x61, 0xe2, 0xf5, 0xdd, 0x24, 0x8e, 0x7b, 0xba, 0xcf, 0xb6, 0xe8,
+	0x73, 0xd1, 0x2c, 0xc3, 0xce, 0xe3, 0xbd, 0x38, 0xee, 0x99, 0xfe, 0x5a, 0xc8, 0x90, 0x41, 0x8a,
+	0x22, 0x94, 0x25, 0xaf, 0x96, 0x8a, 0x30, 0x16, 0x34, 0x22, 0x86, 0xbc, 0xd1, 0x8c, 0x5e, 0x89,
+	0x21, 0x63, 0x47, 0x5f, 0x74, 0x9f, 0x22, 0x94, 0xec, 0xc3, 0x12, 0xa3, 0xe9, 0x69, 0xe8, 0x53,
+	0xd7, 0xf3, 0xfd, 0x78, 0x90, 0x39, 0xcf, 0xbc, 0x10, 0xf8, 0x92, 0x12, 0xb8, 0x2f, 0x89, 0x3a,
+	0x92, 0xc6, 0x0c, 0x70, 0x81, 0x95, 0xc0, 0xcb, 0x84, 0x2a, 0x2d, 0x17, 0x2e, 0x11, 0x6a, 0xf4,
+	0x5c, 0x60, 0x25, 0x70, 0xb2, 0x09, 0xed, 0xc8, 0xeb, 0x53, 0x96, 0x78, 0xbe, 0x89, 0x61, 0x8b,
+	0x42, 0xdc, 0x92, 0x12, 0xb7, 0xab, 0xd1, 0x46, 0xbd, 0xb9, 0xa8, 0x08, 0x2a, 0x0a, 0x51, 0x3a,
+	0x2d, 0x95, 0x0b, 0x31, 0xea, 0xcc, 0x45, 0x45, 0x10, 0xc6, 0xe2, 0x34, 0x1e, 0x70, 0xa3, 0xc5,
+	0x72, 0x21, 0x16, 0x3b, 0x88, 0xca, 0x76, 0x83, 0x34, 0x6b, 0x66, 0x8c, 0xaa, 0x67, 0x6b, 0x94,
+	0x31, 0x0b, 0xe2, 0x69, 0xd6, 0x24, 0x9b, 0xd0, 0x38, 0xe5, 0x34, 0xd1, 0x1d, 0xae, 0x08, 0xbe,
+	0x9b, 0x8a, 0xef, 0xe9, 0xcf, 0x3f, 0xea, 0xec, 0x1e, 0x0c, 0xa2, 0x88, 0xf6, 0x46, 0x96, 0x36,
+	0x20, 0x9b, 0x19, 0xbb, 0x14, 0xa2, 0x3a, 0x5f, 0x7d, 0x96, 0x10, 0xa3, 0x8a, 0x10, 0xa2, 0x34,
+	0xf9, 0x0a, 0xac, 0x9c, 0x85, 0x29, 0x3d, 0x1e, 0x78, 0xe9, 0x68, 0xbc, 0x79, 0x49, 0x88, 0xbc,
+	0xae, 0x83, 0x82, 0xa6, 0x1b, 0xd1, 0x6a, 0xf9, 0xac, 0x1c, 0x35, 0x46, 0xba, 0x52, 0x78, 0xed,
+	0x72, 0xe9, 0x46, 0xdd, 0xe5, 0xb3, 0x72, 0x14, 0x79, 0x17, 0xac, 0xe3, 0x5e, 0x7c, 0xe8, 0xf5,
+	0xdc, 0xc3, 0xe3, 0xc4, 0x2d, 0xc6, 0x9f, 0x6b, 0x42, 0xf8, 0x9a, 0x12, 0xfe, 0x8e, 0x20, 0xdb,
+	0x78, 0x67, 0x6f, 0x28, 0x10, 0x2d, 0x4a, 0xfe, 0x8d, 0xe3, 0x24, 0x8f, 0x20, 0x9f, 0x81, 0x26,
+	0x8d, 0x7c, 0x2f, 0x61, 0x83, 0x9e, 0xc7, 0xc3, 0x38, 0xb2, 0xae, 0x0b, 0x69, 0x0b, 0x4a, 0xda,
+	0x76, 0x1e, 0xb7, 0x73, 0xc5, 0x29, 0x12, 0x93, 0x9f, 0x83, 0x96, 0x5e, 0x2d, 0x4a, 0x99, 0x1b,
+	0x05, 0x76, 0xb5, 0x4a, 0x8c, 0x12, 0x4d, 0x96, 0x07, 0xe4, 0xd9, 0x95, 0xa1, 0x6e, 0x96, 0xb1,
+	0x1b, 0xf3, 0x34, 0x59, 0x1e, 0x40, 0x7c, 0x58, 0x2b, 0x31, 0xf9, 0xe9, 0xba, 0xd6, 0xe5, 0x23,
+	0x05, 0x37, 0x19, 0xb1, 0xfa, 0xd3, 0x75, 0xa3, 0xd7, 0xca, 0xd9, 0x38, 0xe4, 0xf8, 0x4e, 0x94,
+	0xc6, 0xf6, 0xb3, 0x3a, 0x31, 0xda, 0xaf, 0x9c, 0x8d, 0x43, 0x92, 0x03, 0x58, 0x2e, 0x46, 0xc6,
+	0x6c, 0x10, 0x2f, 0x17, 0xc2, 0x4e, 0x3e, 0x38, 0xe6, 0xf4, 0x5f, 0x38, 0x29, 0x81, 0x97, 0x4a,
+	0x55, 0x5a, 0xdf, 0xba, 0x44, 0x6a, 0x16, 0xcc, 0x4e, 0x4a, 0xe0, 0xe4, 0xcb, 0xb0, 0x32, 0x24,
+	0xf5, 0x7e, 0xa6, 0xed, 0x2b, 0x85, 0xbd, 0xb5, 0x20, 0xf7, 0x7e, 0x4e, 0xdf, 0xa5, 0x82, 0xe4,
+	0xfb, 0xa7, 0x5a, 0xe3, 0x72, 0xd9, 0x4a, 0xe7, 0x8f, 0x5e, 0x2a, 0x3b, 0xdb, 0xb7, 0x87, 0x65,
+	0x4b, 0xcc, 0x46, 0x1d, 0x66, 0x12, 0xef, 0x02, 0x37, 0x74, 0xfb, 0x27, 0x53, 0xd0, 0x7c, 0x3b,
+	0x8d, 0xfb, 0x59, 0x3e, 0xbd, 0x07, 0x8b, 0x49, 0x1a, 0xfb, 0x94, 0x31, 0x91, 0x84, 0x0f, 0x58,
+	0x31, 0xdf, 0xd5, 0x89, 0xe1, 0x9e, 0xa4, 0xd9, 0x17, 0x24, 0x59, 0xaa, 0x99, 0x8c, 0x82, 0xc9,
+	0x2f, 0xc2, 0x4b, 0xc5, 0x5c, 0xa9, 0x28, 0x57, 0x26, 0xc1, 0x37, 0x4a, 0x52, 0xa6, 0x21, 0xe1,
+	0xd6, 0xc9, 0x18, 0xdc, 0xd8, 0x1e, 0x94, 0xb9, 0xa6, 0x9e, 0xd1, 0x83, 0x31, 0x98, 0x75, 0x32,
+	0x06, 0x47, 0x7a, 0x70, 0x63, 0x34, 0x8b, 0x2a, 0x8e, 0x43, 0x26, 0xce, 0x2f, 0x8f, 0x49, 0xa6,
+	0x86, 0xc6, 0xb2, 0x76, 0x76, 0x09, 0xfe, 0xd2, 0xde, 0xd4, 0x98, 0x66, 0x9e, 0xa3, 0x37, 0x33,
+	0xae, 0xb5, 0xb3, 0x4b, 0xf0, 0x65, 0xb9, 0x53, 0xad, 0x34, 0x77, 0x7a, 0x0a, 0x59, 0x54, 0x1e,
+	0x1a, 0x7c, 0xbd, 0x10, 0x79, 0xcd, 0xda, 0x1f, 0x1a, 0xf5, 0xe2, 0x59, 0x19, 0x82, 0x6c, 0xc1,
+	0xd5, 0x40, 0xfb, 0x9f, 0xab, 0x0f, 0x73, 0x50, 0xd8, 0xd0, 0x8d, 0x7f, 0x9a, 0x53, 0xdd, 0x5c,
+	0x50, 0x04, 0xe5, 0xbd, 0xfa, 0x5f, 0xab, 0x30, 0x5b, 0x88, 0xed, 0x0f, 0x61, 0x5a, 0xee, 0x14,
+	0x56, 0xe5, 0xe6, 0x44, 0xce, 0x17, 0xf2, 0x44, 0xaa, 0xb1, 0x1d, 0xf1, 0xf4, 0xc2, 0x51, 0xe4,
+	0xe4, 0x17, 0x60, 0x81, 0xc5, 0x83, 0xd4, 0xa7, 0x2e, 0x8f, 0xdd, 0xd4, 0x3b, 0x53, 0x1b, 0x8e,
+	0x55, 0x15, 0x62, 0x5e, 0x2f, 0x13, 0xb3, 0x2f, 0xe8, 0x0f, 0x62, 0xc7, 0x3b, 0xcb, 0x4b, 0xbc,
+	0xca, 0x86, 0xe1, 0xc4, 0x82, 0x99, 0x3e, 0x65, 0xcc, 0x3b, 0x96, 0x8b, 0xab, 0xee, 0xe8, 0xe6,
+	0xea, 0x9b, 0xd0, 0xc8, 0xf1, 0x92, 0x36, 0x4c, 0xbc, 0x47, 0x2f, 0xc4, 0xf9, 0xb6, 0xee, 0xe0,
+	0x4f, 0xb2, 0x00, 0x53, 0xa7, 0x5e, 0x6f, 0x20, 0x0f, 0xb1, 0x75, 0x47, 0x36, 0x3e, 0x5d, 0xfd,
+	0x54, 0x65, 0xf5, 0x29, 0x2c, 0x95, 0x6b, 0x90, 0x97, 0xd2, 0x94, 0x52, 0x3e, 0x9a, 0x97, 0xd2,
+	0xb8, 0xd7, 0xd6, 0x39, 0x8c, 0xe6, 0xcb, 0xc9, 0xb5, 0xbf, 0x5b, 0x81, 0x7a, 0xa6, 0xfa, 0x12,
+	0x4c, 0xcb, 0xf1, 0x28, 0xa5, 0x54, 0x8b, 0xdc, 0x87, 0xe9, 0x82, 0x85, 0xd6, 0x86, 0x45, 0x96,
+	0x59, 0xf9, 0x43, 0x0c, 0xd7, 0xbe, 0x05, 0xd3, 0x72, 0xfe, 0xc9, 0x2a, 0xd4, 0x70, 0xf9, 0x62,
+	0x9e, 0xa7, 0x58, 0x4d, 0xdb, 0xfe, 0x7e, 0x05, 0x1a, 0xb9, 0x03, 0x3e, 0x69, 0x41, 0x35, 0x0c,
+	0x14, 0x55, 0x35, 0x0c, 0xe4, 0x4c, 0xa0, 0x8f, 0x33, 0xa1, 0x77, 0xdd, 0xd1, 0x4d, 0x72, 0x17,
+	0x26, 0xf9, 0x45, 0x22, 0x27, 0xa8, 0x65, 0x86, 0x93, 0x93, 0x25, 0x7f, 0x1f, 0x5c, 0x24, 0xd4,
+	0x11, 0x94, 0xf6, 0x1b, 0x50, 0x37, 0x20, 0x32, 0x0d, 0xd5, 0xee, 0x5e, 0xfb, 0x0a, 0x99, 0xc3,
+	0xfe, 0xdd, 0xce, 0xee, 0x96, 0xbb, 0xf7, 0xc4, 0x39, 0x68, 0x57, 0xc8, 0x0c, 0x4c, 0xec, 0x6e,
+	0x1f, 0xb4, 0xab, 0x76, 0x02, 0xed, 0xe1, 0xda, 0xc1, 0x88, 0x7a, 0x2f, 0x43, 0xd3, 0x0b, 0x02,
+	0x1a, 0xb8, 0x45, 0x25, 0x67, 0x05, 0xf0, 0xb1, 0xd2, 0xf4, 0x55, 0x98, 0x93, 0xb1, 0x21, 0x23,
+	0x9b, 0x10, 0x64, 0x2d, 0x05, 0x56, 0x84, 0xf6, 0x35, 0x65, 0x0b, 0xb5, 0xfc, 0x87, 0x3a, 0xb3,
+	0x3d, 0x98, 0x2f, 0xa9, 0x23, 0x90, 0x9b, 0x86, 0x2c, 0x73, 0x14, 0x45, 0xd1, 0xdd, 0x12, 0x5a,
+	0xde, 0x86, 0x19, 0x55, 0x4b, 0x50, 0xfe, 0xd4, 0x2a, 0x92, 0x39, 0x1a, 0x6d, 0x3f, 0x1c, 0xea,
+	0x42, 0x69, 0xf2, 0xcc, 0x2e, 0xec, 0x1b, 0x50, 0x37, 0x00, 0x42, 0x60, 0x32, 0x37, 0xd9, 0xe2,
+	0xb7, 0x1d, 0xc3, 0x8c, 0x22, 0x20, 0x77, 0xa1, 0x19, 0x46, 0x87, 0xf1, 0x20, 0x0a, 0xdc, 0x74,
+	0xd0, 0xa3, 0x4c, 0x2d, 0xfd, 0x86, 0xf6, 0xc8, 0x41, 0x8f, 0x3a, 0xb3, 0x8a, 0x02, 0x1b, 0x8c,
+	0xdc, 0x83, 0x56, 0x3c, 0xe0, 0x79, 0x96, 0xea, 0x28, 0x4b, 0x53, 0x93, 0x08, 0x1e, 0xfb, 0x2b,
+	0x40, 0x46, 0x4b, 0x1a, 0xe4, 0x46, 0x6e, 0x24, 0x73, 0x7a, 0x24, 0x82, 0x40, 0xd9, 0xea, 0x15,
+	0x98, 0x96, 0x65, 0x0d, 0xab, 0x5a, 0x28, 0x5a, 0x49, 0x22, 0x47, 0x21, 0xed, 0x07, 0x45, 0xe9,
+	0xca, 0x4e, 0xcf, 0x92, 0x6e, 0xdf, 0x83, 0x9a, 0x6e, 0xa3, 0x95, 0x78, 0x48, 0x53, 0x6d, 0x25,
+	0xfc, 0x6d, 0x2c, 0x57, 0xcd, 0x59, 0xee, 0x7f, 0x2a, 0x30, 0x2d, 0x99, 0xfe, 0x6f, 0x2c, 0x47,
+	0xd6, 0xa0, 0x3e, 0x88, 0x78, 0x8a, 0x75, 0xc1, 0x40, 0x2c, 0xaf, 0x9a, 0x93, 0x01, 0xc8, 0x0a,
+	0xd4, 0x92, 0x94, 0xba, 0x41, 0xe4, 0x71, 0x91, 0x21, 0xd4, 0xd0, 0x7b, 0xe8, 0x56, 0xe4, 0x71,
+	0x64, 0x34, 0x87, 0x39, 0xb1, 0xb7, 0xd7, 0x9d, 0x0c, 0x40, 0x3e, 0x06, 0x57, 0xe3, 0x34, 0x3c,
+	0x0e, 0x23, 0xaf, 0xe7, 0x32, 0xda, 0xa3, 0x3e, 0x8f, 0x53, 0xb1, 0x37, 0xd7, 0x9d, 0xb6, 0x46,
+	0xec, 0x2b, 0xb8, 0xfd, 0x6f, 0xd7, 0x61, 0x12, 0xb5, 0xc1, 0x78, 0xe6, 0xf9, 0x22, 0xeb, 0x57,
+	0xf1, 0x4c, 0xb6, 0xc8, 0x27, 0x00, 0xc2, 0xc4, 0x3d, 0xa5, 0x29, 0x43, 0x5c, 0x55, 0x04, 0x81,
+	0xb6, 0x09, 0x02, 0x4f, 0x25, 0xdc, 0xa9, 0x87, 0x89, 0xfa, 0x49, 0x3e, 0x86, 0x7a, 0xc7, 0x3c,
+	0xf6, 0xe3, 0x9e, 0x35, 0x51, 0x9c, 0x21, 0x05, 0x76, 0x0c, 0x01, 0x59, 0x86, 0x19, 0x96, 0xfa,
+	0x6e, 0x44, 0x71, 0x8c, 0x13, 0x22, 0x8c, 0xa6, 0xfe, 0x2e, 0xe5, 0xe4, 0x0d, 0xa8, 0x23, 0x22,
+	0x89, 0x53, 0xce, 0xac, 0x29, 0x61, 0x4a, 0xb3, 0x20, 0xe2, 0x94, 0x3b, 0x5e, 0x74, 0x4c, 0x9d,
+	0x1a, 0x4b, 0x7d, 0x6c, 0x31, 0x94, 0x13, 0x30, 0x2e, 0xe4, 0x4c, 0x4b, 0x39, 0x01, 0xe3, 0x4a,
+	0x0e, 0x22, 0xa4, 0x9c, 0x99, 0x71, 0x72, 0x02, 0xc6, 0xa5, 0x9c, 0x6b, 0x50, 0x0f, 0xfd, 0x7e,
+	0xe2, 0x8a, 0x88, 0x87, 0x39, 0xc0, 0xd4, 0xce, 0x15, 0xa7, 0x86, 0x20, 0x11, 0xcc, 0xde, 0x82,
+	0x96, 0x41, 0xbb, 0x7e, 0x1c, 0xe8, 0x6d, 0x5f, 0x6f, 0xd2, 0x5d, 0x45, 0xd8, 0x89, 0x82, 0xcd,
+	0x38, 0x10, 0x35, 0x1f, 0xcd, 0x8b, 0x6d, 0xf2, 0x32, 0xb4, 0x70, 0x54, 0x61, 0xe2, 0x32, 0xca,
+	0xdd, 0x30, 0x60, 0x16, 0x08, 0x6d, 0x1b, 0x2c, 0xf5, 0xbb, 0xc9, 0x3e, 0xe5, 0xdd, 0x80, 0x21,
+	0x11, 0xaa, 0x9c, 0x23, 0x6a, 0x48, 0xa2, 0x80, 0x71, 0x43, 0xf4, 0x10, 0x56, 0x84, 0xe1, 0xbc,
+	0x3e, 0x0d, 0xc4, 0xe8, 0xf2, 0xf4, 0xb3, 0x82, 0x7e, 0x01, 0x4d, 0x89, 0x78, 0x1c, 0x5a, 0x9e,
+	0x51, 0x58, 0xaa, 0x94, 0xb1, 0x29, 0x19, 0xd1, 0x76, 0x23, 0x8c, 0x1f, 0x87, 0x79, 0xa5, 0x96,
+	0xe0, 0xd2, 0x2c, 0x73, 0x82, 0x65, 0x4e, 0xe8, 0x86, 0xf4, 0x8a, 0xfa, 0x1e, 0xcc, 0x46, 0x31,
+	0x77, 0x8d, 0x27, 0x1c, 0x95, 0x7b, 0x42, 0x23, 0x8a, 0xb9, 0x6e, 0x90, 0xeb, 0x80, 0x4d, 0x57,
+	0x3b, 0xc4, 0xb1, 0x90, 0x5c, 0x8f, 0x62, 0xbe, 0x2f, 0x7d, 0xe2, 0x3e, 0x34, 0x35, 0x5e, 0xce,
+	0xe7, 0xc9, 0x98, 0xf9, 0x6c, 0x48, 0x1e, 0x39, 0xa5, 0x4a, 0xaa, 0x76, 0x8f, 0xd0, 0x48, 0xdd,
+	0x62, 0x3c, 0x27, 0x35, 0xf3, 0x92, 0xaf, 0x5e, 0x22, 0x75, 0x4b, 0x3b, 0xca, 0x2d, 0xc9, 0x95,
+	0x39, 0xcb, 0x7b, 0xc2, 0x59, 0x2a, 0x82, 0x4a, 0xbb, 0x01, 0xd9, 0x06, 0x52, 0xa0, 0x92, 0x3e,
+	0xd3, 0xbb, 0xd4, 0x67, 0x2a, 0xce, 0x5c, 0x4e, 0x04, 0x82, 0xc8, 0xeb, 0x40, 0xf4, 0xc0, 0x73,
+	0x93, 0xd5, 0x97, 0x7b, 0x9b, 0x1c, 0xab, 0x99, 0x26, 0x45, 0x3b, 0xe4, 0x41, 0x91, 0xa1, 0xdd,
+	0xca, 0x39, 0xd1, 0x5b, 0x70, 0xcd, 0x18, 0xbc, 0xd4, 0x1f, 0x12, 0xc1, 0xb6, 0xac, 0xa6, 0x60,
+	0xc4, 0x25, 0x14, 0xff, 0x78, 0x7f, 0x7a, 0xdf, 0xf0, 0x6f, 0x95, 0xb9, 0xd4, 0x3d, 0x58, 0xcc,
+	0x22, 0x55, 0xea, 0x67, 0xd1, 0x2a, 0x15, 0x21, 0x68, 0xde, 0x44, 0xab, 0xd4, 0xd7, 0x01, 0xab,
+	0xc0, 0x83, 0x1d, 0x1b, 0x1e, 0x56, 0xe4, 0xd9, 0x62, 0xdc, 0xf0, 0x6c, 0xc3, 0x8d, 0x42, 0x3f,
+	0x59, 0xed, 0xcc, 0x70, 0x73, 0xc1, 0xbd, 0x96, 0xeb, 0xd1, 0x54, 0xd0, 0x4a, 0xc5, 0xe8, 0x31,
+	0x0f, 0x89, 0x19, 0x14, 0xc5, 0xa8, 0x51, 0x17, 0xc5, 0xbc, 0x09, 0x2b, 0x46, 0x8c, 0x36, 0xbf,
+	0x11, 0x70, 0x2a, 0x04, 0x2c, 0x69, 0x82, 0x5d, 0x61, 0xf9, 0xb1, 0xac, 0x05, 0x03, 0x9c, 0x8d,
+	0xb0, 0xe6, 0x6d, 0xf0, 0x45, 0x19, 0x30, 0x86, 0x0b, 0x9a, 0x7d, 0x8f, 0xfb, 0x27, 0xd6, 0x79,
+	0xe1, 0x64, 0x5b, 0xac, 0x67, 0x3e, 0x46, 0x0a, 0x67, 0x89, 0xa5, 0x7e, 0x09, 0x1c, 0xc5, 0x4a,
+	0x25, 0xca, 0xc4, 0x5e, 0x3c, 0x5b, 0x6c, 0xc0, 0x78, 0x09, 0x1c, 0x77, 0x9d, 0x13, 0xce, 0x13,
+	0x25, 0xe7, 0x6b, 0x85, 0x84, 0x68, 0xe7, 0xe0, 0x60, 0x4f, 0x72, 0xd7, 0x91, 0x46, 0x33, 0xd4,
+	0x74, 0xa1, 0xc0, 0xfa, 0xa5, 0x42, 0x11, 0x1e, 0x77, 0x37, 0x53, 0x2d, 0x36, 0x44, 0xe4, 0xff,
+	0xc1, 0xc2, 0x90, 0x1f, 0x09, 0x2d, 0xac, 0x5f, 0x91, 0xdb, 0x1f, 0x29, 0xf8, 0x91, 0x40, 0x91,
+	0x2d, 0xb8, 0x5e, 0xc6, 0x92, 0xf9, 0x81, 0xf5, 0xab, 0x92, 0xf9, 0xa5, 0x51, 0x66, 0xe3, 0x06,
+	0x85, 0x8e, 0x73, 0x33, 0x62, 0x7d, 0x7d, 0xa8, 0xe3, 0xfd, 0xd4, 0x2f, 0xeb, 0x38, 0x3f, 0x89,
+	0x59, 0xc7, 0xbf, 0x36, 0xd4, 0x71, 0xc6, 0x9c, 0x75, 0xdc, 0x05, 0x8c, 0xd2, 0xae, 0x17, 0x45,
+	0x31, 0x17, 0x25, 0x3b, 0x66, 0xfd, 0x7a, 0xf1, 0x30, 0x88, 0xa6, 0xba, 0xb3, 0xc5, 0x78, 0x27,
+	0x23, 0x91, 0xc7, 0x94, 0x56, 0x50, 0x00, 0x62, 0xf4, 0xf3, 0x92, 0xc4, 0x44, 0x77, 0x66, 0x7d,
+	0xa3, 0xa2, 0xf2, 0xf1, 0x24, 0xd1, 0xe1, 0x1c, 0x43, 0xd1, 0x55, 0x11, 0xb2, 0x98, 0xdb, 0x8b,
+	0x7d, 0xe1, 0xb1, 0x01, 0xb5, 0xbe, 0x29, 0xaf, 0x34, 0x71, 0x1f, 0xec, 0xb2, 0x47, 0x08, 0xdf,
+	0xc5, 0x10, 0x77, 0x0b, 0x9a, 0x5f, 0x3d, 0xe3, 0xae, 0x37, 0x08, 0x42, 0x3c, 0x6f, 0x33, 0xeb,
+	0x37, 0x94, 0xc4, 0xaf, 0x9e, 0xf1, 0x8e, 0x06, 0x92, 0x9b, 0x20, 0xeb, 0xc9, 0x72, 0xe4, 0xd6,
+	0xb7, 0x24, 0x0d, 0x08, 0x98, 0x18, 0x28, 0xf9, 0x08, 0xcc, 0xaa, 0x30, 0x89, 0x97, 0x13, 0xcc,
+	0xfa, 0x4d, 0x45, 0x22, 0x36, 0x58, 0xbc, 0x7f, 0x60, 0x98, 0x1f, 0xe5, 0x67, 0x4f, 0x06, 0xfd,
+	0xdf, 0xaa, 0x98, 0x7d, 0x4c, 0x19, 0x4e, 0xc6, 0x79, 0x24, 0x0e, 0x53, 0xea, 0xcb, 0xf2, 0x2d,
+	0xf6, 0x4c, 0xb9, 0xf5, 0x6d, 0x4d, 0x2c, 0x30, 0x8e, 0x40, 0xe0, 0x56, 0x72, 0x07, 0x48, 0x20,
+	0x8a, 0x30, 0xb9, 0xba, 0x28, 0xb3, 0xbe, 0x23, 0xa9, 0xb1, 0xd3, 0x42, 0x09, 0x95, 0x91, 0x8f,
+	0x42, 0x8b, 0xf7, 0x98, 0xcb, 0x69, 0xda, 0x0f, 0x23, 0x8f, 0xd3, 0xc0, 0xfa, 0x1d, 0x69, 0x9d,
+	0x26, 0xef, 0xb1, 0x03, 0x03, 0xc5, 0x7c, 0x0f, 0xe5, 0xa6, 0xd4, 0x0b, 0x2e, 0xac, 0xdf, 0x95,
+	0x24, 0x98, 0xb3, 0x38, 0x08, 0xc0, 0x63, 0xcf, 0x71, 0x9a, 0xf8, 0xae, 0xef, 0xf5, 0x7a, 0x62,
+	0x97, 0x61, 0xd6, 0xef, 0xc9, 0x2e, 0x9b, 0x08, 0xdf, 0xf4, 0x7a, 0x3d, 0xdc, 0x49, 0x30, 0x5c,
+	0xaf, 0xe5, 0xb6, 0x10, 0x79, 0x9e, 0x3a, 0x0b, 0xf9, 0x09, 0x16, 0x1c, 0xa8, 0xcf, 0xac, 0xef,
+	0xca, 0x83, 0xf1, 0xb2, 0x4e, 0x46, 0x3a, 0x48, 0xf1, 0xae, 0x20, 0xd8, 0xa7, 0xbe, 0xe0, 0xcf,
+	0x6d, 0x2b, 0xa3, 0xfc, 0xbf, 0xaf, 0xf8, 0x75, 0x9e, 0x32, 0xcc, 0xff, 0xd9, 0x42, 0xff, 0xbe,
+	0x97, 0x06, 0xe8, 0xaa, 0x21, 0xbf, 0x70, 0xbd, 0x43, 0xac, 0xe8, 0x7c, 0x4f, 0xf2, 0x5b, 0xba,
+	0xff, 0xcd, 0x8c, 0xa2, 0x83, 0x04, 0xe4, 0x01, 0x2c, 0xa5, 0xf2, 0xa6, 0xdc, 0xed, 0x79, 0x87,
+	0x34, 0x97, 0xde, 0xfe, 0x81, 0xf4, 0xff, 0x05, 0x85, 0x7e, 0x84, 0x58, 0x13, 0xfa, 0x9e, 0xc2,
+	0x42, 0x31, 0xea, 0x0b, 0x66, 0x66, 0x7d, 0x5f, 0x7a, 0xff, 0xcb, 0x79, 0xef, 0xcf, 0x07, 0x7e,
+	0x21, 0x45, 0xad, 0x00, 0xc2, 0x46, 0x10, 0xe4, 0x01, 0x2c, 0x0b, 0x7b, 0x44, 0xca, 0xbf, 0xc5,
+	0x9d, 0xd8, 0x61, 0x2f, 0xf6, 0xdf, 0xb3, 0xfe, 0x50, 0x4e, 0x12, 0x66, 0x4c, 0xdd, 0x48, 0x78,
+	0x79, 0x37, 0xf1, 0xfa, 0x1b, 0x88, 0x23, 0xaf, 0x43, 0x1b, 0x67, 0xfd, 0x28, 0x8c, 0x8e, 0x69,
+	0x9a, 0xa4, 0x61, 0xc4, 0x99, 0xf5, 0x47, 0xca, 0xa3, 0x78, 0x8f, 0xbd, 0x9d, 0x83, 0x63, 0xb0,
+	0xc0, 0x38, 0x3f, 0x42, 0xff, 0xc7, 0x92, 0x1e, 0xb7, 0xfa, 0x83, 0x21, 0x96, 0xbb, 0x00, 0xc2,
+	0x1d, 0x64, 0xe8, 0xfc, 0x93, 0xe2, 0x61, 0xf2, 0x9d, 0x34, 0xf1, 0x55, 0xec, 0x3c, 0xd6, 0x3f,
+	0xc5, 0x6a, 0xee, 0xf5, 0xe2, 0x33, 0xf7, 0xc4, 0x0b, 0xd3, 0x24, 0x8c, 0xac, 0x3f, 0x55, 0xcf,
+	0x0e, 0x04, 0x74, 0x47, 0x02, 0x91, 0x0a, 0x47, 0xdb, 0x0b, 0x19, 0xa7, 0x51, 0x18, 0x1d, 0x5b,
+	0x7f, 0xa6, 0xa8, 0x02, 0xc6, 0x1f, 0x69, 0x20, 0x4e, 0x91, 0xc8, 0xcf, 0xd2, 0x30, 0xf2, 0xc3,
+	0xc4, 0xeb, 0xb9, 0x49, 0x4a, 0x8f, 0xc2, 0x73, 0xca, 0xac, 0x1f, 0x54, 0x4c, 0x56, 0xba, 0xa7,
+	0xb1, 0x7b, 0x0a, 0x39, 0xca, 0xc6, 0x06, 0x47, 0x92, 0xed, 0xcf, 0x4b, 0xd8, 0xf6, 0x07, 0x47,
+	0x86, 0x4d, 0xe4, 0x6d, 0xa3, 0xbd, 0xfd, 0x45, 0xc5, 0xa4, 0xb2, 0xa5, 0xbd, 0x15, 0xd9, 0x4c,
+	0x6f, 0x7f, 0x59, 0xc2, 0x66, 0x7a, 0x5b, 0x93, 0x67, 0x92, 0xaf, 0xc5, 0x11, 0x65, 0xd6, 0x5f,
+	0x49, 0x4a, 0x3c, 0x82, 0x7c, 0x39, 0x8e, 0x64, 0x6c, 0x42, 0x6c, 0x4a, 0x8f, 0xc5, 0xaa, 0xff,
+	0xeb, 0x2c, 0xf0, 0x38, 0x12, 0x84, 0xa9, 0x8b, 0x5c, 0xc6, 0x78, 0x9a, 0xc2, 0x93, 0x1d, 0x53,
+	0x71, 0xec, 0x6f, 0xd4, 0x6c, 0x8a, 0x25, 0x2d, 0x90, 0x5b, 0x11, 0x93, 0xf1, 0xec, 0x3e, 0x2c,
+	0xe7, 0xd2, 0xb9, 0x42, 0xe6, 0xfd, 0xb7, 0x99, 0x0f, 0x6c, 0x0d, 0x65, 0xdf, 0x6f, 0xc0, 0xbc,
+	0x89, 0x82, 0x39, 0x8e, 0xbf, 0x53, 0x5e, 0xa6, 0x82, 0xa1, 0x21, 0x57, 0x9d, 0x94, 0xb1, 0xfc,
+	0x7d, 0xd6, 0xc9, 0xfe, 0x10, 0xd7, 0xc7, 0xe1, 0xaa, 0x1f, 0x47, 0x11, 0x15, 0xe7, 0x44, 0x37,
+	0xa5, 0x03, 0x46, 0x03, 0xeb, 0x87, 0xd2, 0x29, 0xda, 0x19, 0xc6, 0x11, 0x08, 0xf2, 0x8a, 0x3c,
+	0xfa, 0x78, 0x4c, 0x55, 0x58, 0x99, 0xf5, 0x0f, 0x28, 0xba, 0xe9, 0x60, 0xbc, 0xee, 0x30, 0x59,
+	0x60, 0x65, 0x58, 0x87, 0xc2, 0xe3, 0xb3, 0x1b, 0x06, 0xd6, 0x8f, 0xd5, 0x41, 0x14, 0xdb, 0xdd,
+	0x60, 0xb5, 0x03, 0xf3, 0x25, 0x5b, 0xd3, 0x0b, 0x55, 0x06, 0xb7, 0x61, 0x79, 0xcc, 0xfa, 0x7e,
+	0x11, 0x31, 0x1b, 0xd3, 0x30, 0x89, 0x19, 0xfd, 0x06, 0x40, 0x4d, 0x67, 0xf7, 0x9f, 0x9b, 0xae,
+	0xfd, 0xa8, 0xd2, 0xfe, 0x71, 0xc5, 0x81, 0x5e, 0x7c, 0xac, 0xbc, 0xd0, 0xfe, 0x56, 0x05, 0xe6,
+	0xcb, 0x92, 0x9b, 0x55, 0xa8, 0x99, 0xc0, 0xa5, 0xea, 0x74, 0xba, 0x8d, 0xbd, 0x4a, 0x9f, 0x90,
+	0x05, 0x2e, 0xd9, 0xc0, 0xf2, 0x17, 0x4f, 0x07, 0x8c, 0xbb, 0x41, 0xdc, 0xf7, 0xc2, 0x48, 0xd7,
+	0xb5, 0x66, 0x05, 0x70, 0x4b, 0xc2, 0xc8, 0x35, 0x00, 0xbc, 0xd7, 0x53, 0x3e, 0x25, 0x4b, 0x06,
+	0x75, 0x84, 0x88, 0x01, 0xdb, 0x3f, 0x99, 0x81, 0xba, 0x49, 0x9d, 0x64, 0xbd, 0x8f, 0x9f, 0xc4,
+	0x81, 0xac, 0x6d, 0xd4, 0x1d, 0xdd, 0x24, 0x77, 0x61, 0x2a, 0xf1, 0xf8, 0x89, 0x2e, 0x60, 0xac,
+	0x0e, 0x67, 0x5d, 0x77, 0xf6, 0x3c, 0x7e, 0x22, 0x7e, 0x39, 0x92, 0x10, 0xb5, 0xf3, 0xe3, 0x88,
+	0xd3, 0x88, 0xab, 0xed, 0x47, 0x69, 0xa7, 0x80, 0x72, 0xf3, 0xb9, 0x07, 0x8b, 0xe1, 0x71, 0x14,
+	0xa7, 0xd4, 0xe5, 0xa9, 0x17, 0xf6, 0xc2, 0xe8, 0xd8, 0x65, 0x3d, 0x8f, 0x9d, 0x28, 0x45, 0xe7,
+	0x25, 0xf2, 0x40, 0xe1, 0xf6, 0x11, 0x45, 0x36, 0x61, 0xf6, 0xfd, 0x01, 0x4d, 0x2f, 0xdc, 0xc4,
+	0x4b, 0xbd, 0xbe, 0xae, 0x03, 0xdc, 0x1c, 0xd1, 0xe8, 0x0b, 0x48, 0xb4, 0x87, 0x34, 0x52, 0xaf,
+	0xc6, 0xfb, 0x06, 0xc0, 0xc8, 0x6b, 0xd0, 0xf6, 0x3d, 0x86, 0x65, 0x75, 0x46, 0x23, 0x16, 0x62,
+	0x2d, 0x49, 0x54, 0x43, 0x6a, 0xce, 0x1c, 0xc2, 0xbb, 0x19, 0x98, 0xac, 0xc3, 0xcc, 0x09, 0xf5,
+	0x02, 0x9a, 0xea, 0x52, 0xc1, 0xda, 0x48, 0x57, 0x3b, 0x02, 0x2f, 0xbb, 0xd1, 0xc4, 0x38, 0xa1,
+	0x83, 0xe4, 0x38, 0xf5, 0x02, 0xca, 0xac, 0x9a, 0x0c, 0x0b, 0xba, 0x4d, 0x6e, 0xc8, 0xe3, 0xa7,
+	0x36, 0x76, 0x5d, 0xa0, 0x21, 0x8a, 0xf9, 0x63, 0x09, 0x21, 0x0f, 0x01, 0x0f, 0xa3, 0xae, 0xb4,
+	0x39, 0x3c, 0xd3, 0xe6, 0xe8, 0x72, 0x7b, 0xc2, 0xec, 0xb7, 0xa0, 0xd5, 0xf7, 0xce, 0xdd, 0xc3,
+	0x38, 0xb8, 0x70, 0x0f, 0x2f, 0x38, 0x65, 0xe2, 0xa1, 0xcc, 0xa4, 0x33, 0xdb, 0xf7, 0xce, 0x37,
+	0xe2, 0xe0, 0x62, 0x03, 0x61, 0xb8, 0xee, 0x52, 0xca, 0x92, 0x38, 0x62, 0xf2, 0xf4, 0x29, 0xab,
+	0x03, 0x4d, 0xa7, 0xa9, 0xa1, 0x78, 0xc2, 0xc4, 0x54, 0x64, 0xae, 0x1f, 0x46, 0x6e, 0x30, 0x48,
+	0xc5, 0xe2, 0x72, 0xfb, 0x4c, 0xbc, 0x65, 0x99, 0x74, 0x9a, 0xfd, 0x30, 0xda, 0x52, 0xd0, 0xc7,
+	0x92, 0xce, 0x3b, 0x2f, 0xd0, 0xb5, 0x14, 0x9d, 0x77, 0x9e, 0xd1, 0xad, 0xfa, 0x50, 0x37, 0x3a,
+	0x93, 0x25, 0x98, 0xa2, 0xe7, 0x9e, 0xcf, 0xa5, 0xb7, 0xef, 0x5c, 0x71, 0x64, 0x93, 0x58, 0x30,
+	0x2d, 0x97, 0x8a, 0x5c, 0x63, 0xf8, 0x52, 0x4d, 0xb6, 0x91, 0x23, 0xa5, 0xc7, 0xf4, 0xdc, 0x9a,
+	0xd0, 0x1c, 0xa2, 0xb9, 0x31, 0x0b, 0x80, 0x86, 0x92, 0x9b, 0xdb, 0xea, 0x09, 0xcc, 0x0d, 0x4d,
+	0x7d, 0x59, 0x49, 0x34, 0xeb, 0xbe, 0x5a, 0xec, 0x7e, 0x15, 0xcb, 0xb5, 0x94, 0xd1, 0x88, 0xcb,
+	0xea, 0xdb, 0xce, 0x15, 0x47, 0x03, 0x36, 0x9a, 0xd0, 0x10, 0x0b, 0x5e, 0xf5, 0xf4, 0xbd, 0x0a,
+	0x34, 0x72, 0x53, 0xff, 0x42, 0xdd, 0x64, 0xa3, 0x9c, 0x18, 0x37, 0xca, 0xc9, 0xc2, 0x28, 0xf3,
+	0x8a, 0x4d, 0x5d, 0xae, 0x98, 0xdd, 0x81, 0xba, 0xd9, 0xd3, 0x65, 0x60, 0x11, 0xf1, 0x46, 0xaf,
+	0x6a, 0xd3, 0xce, 0x2f, 0xf8, 0x6a, 0x61, 0xc1, 0xdb, 0xdf, 0xab, 0xc0, 0x6c, 0xfe, 0x90, 0x44,
+	0xde, 0x86, 0x46, 0xfe, 0x90, 0x20, 0xb3, 0xa4, 0x5b, 0x25, 0xc7, 0xa9, 0x3b, 0x23, 0x07, 0x85,
+	0x3c, 0xe3, 0xea, 0x5b, 0xd0, 0xfe, 0x30, 0xe1, 0xda, 0x7e, 0x13, 0xe6, 0x86, 0x8a, 0x23, 0x68,
+	0x77, 0x51, 0x6d, 0x41, 0xfe, 0x29, 0x79, 0xdd, 0x80, 0x30, 0x51, 0x56, 0xa9, 0x4a, 0x18, 0xfe,
+	0xb6, 0x1f, 0x41, 0xcd, 0x94, 0x95, 0x2c, 0x98, 0x56, 0x97, 0x7a, 0x15, 0x55, 0xd0, 0x53, 0x6d,
+	0xb2, 0x90, 0xaf, 0x02, 0xef, 0x5c, 0x91, 0xf3, 0xb8, 0xd1, 0x86, 0x96, 0xc4, 0xbb, 0x71, 0x2a,
+	0x82, 0xa9, 0xfd, 0x00, 0xea, 0xa6, 0x0c, 0x84, 0xfa, 0x1e, 0x85, 0x29, 0xe3, 0x4a, 0x07, 0xd9,
+	0x40, 0x25, 0x7a, 0x1e, 0xe3, 0x5a, 0x09, 0xfc, 0x6d, 0x7f, 0xbb, 0x02, 0x64, 0xf8, 0x5e, 0xb2,
+	0xbb, 0x85, 0xf9, 0x7a, 0x9c, 0xfa, 0x27, 0x94, 0xf1, 0xd4, 0xe3, 0x71, 0x8a, 0x5b, 0x9d, 0x1c,
+	0x7a, 0x2b, 0x0f, 0xee, 0x06, 0x18, 0x3a, 0xcc, 0x25, 0x68, 0x18, 0xa8, 0x1b, 0x32, 0xd0, 0x20,
+	0x49, 0x60, 0x2e, 0x47, 0xc3, 0x40, 0x7a, 0x91, 0x03, 0x1a, 0xd4, 0x0d, 0x3e, 0x37, 0x59, 0xab,
+	0xb4, 0xab, 0xb9, 0x5b, 0xa0, 0x73, 0x58, 0x2a, 0x7f, 0x3e, 0x47, 0x5e, 0xcb, 0x55, 0xd4, 0x57,
+	0xc6, 0xdc, 0xa9, 0xaa, 0xca, 0xfd, 0x27, 0xa1, 0xa6, 0xbb, 0xb0, 0xa6, 0x0a, 0x4f, 0x40, 0x87,
+	0x19, 0x1c, 0x43, 0x68, 0xff, 0x60, 0x12, 0xda, 0xc3, 0x68, 0x34, 0x65, 0xf6, 0xcc, 0xb5, 0xee,
+	0xc8, 0x46, 0x59, 0x6d, 0x1e, 0xdd, 0xa6, 0xef, 0xf9, 0xca, 0x04, 0xf8, 0x13, 0xc7, 0xae, 0xdf,
+	0x6d, 0x62, 0x9e, 0x22, 0xab, 0xc7, 0xa0, 0x40, 0x98, 0x9e, 0xbc, 0x04, 0xf5, 0x30, 0x39, 0xbd,
+	0x8f, 0x07, 0x36, 0xb9, 0x73, 0xd4, 0x9d, 0x1a, 0x02, 0x76, 0x29, 0xd7, 0xc8, 0x75, 0x89, 0x9c,
+	0x36, 0xc8, 0x75, 0x81, 0x7c, 0x05, 0xa6, 0x78, 0x98, 0x6d, 0x02, 0xba, 0x68, 0x79, 0x10, 0xd2,
+	0xb4, 0x1b, 0x1d, 0xc5, 0x8e, 0xc4, 0x92, 0xd7, 0xa0, 0x26, 0x3b, 0xf0, 0xb8, 0x88, 0xfa, 0xd9,
+	0x75, 0xcf, 0xae, 0xc7, 0x05, 0xe1, 0x8c, 0xe8, 0xcf, 0xe3, 0x8a, 0x74, 0x5d, 0x90, 0xd6, 0xc7,
+	0x92, 0xae, 0x23, 0x69, 0x07, 0xae, 0xc9, 0x64, 0x9c, 0x25, 0x71, 0x7c, 0x44, 0x03, 0x57, 0xdd,
+	0xbe, 0x9a, 0xcc, 0x56, 0x56, 0x8c, 0x57, 0x05, 0xd1, 0xbe, 0xa4, 0x91, 0xd7, 0x9d, 0x26, 0xbd,
+	0xfd, 0x5c, 0x71, 0xfd, 0x36, 0x44, 0x87, 0xb7, 0xc7, 0xcc, 0xd1, 0xe5, 0x6b, 0x98, 0xbc, 0x06,
+	0x53, 0xf2, 0x80, 0xdc, 0xbc, 0x39, 0x91, 0x2b, 0xaa, 0x68, 0x6e, 0xb1, 0x2c, 0x24, 0xc5, 0x87,
+	0x5e, 0xee, 0x0e, 0xcc, 0xe6, 0xc5, 0x96, 0xc6, 0xd8, 0xd5, 0xdc, 0xe5, 0x82, 0x14, 0x60, 0xda,
+	0x48, 0x8f, 0x8a, 0x08, 0x27, 0x69, 0x3a, 0xe2, 0xb7, 0xbd, 0x39, 0xea, 0xf0, 0xea, 0x0a, 0xe9,
+	0xf9, 0x1d, 0xde, 0xee, 0x40, 0x2b, 0xff, 0x64, 0xa2, 0xbb, 0x35, 0xbc, 0xf0, 0xaa, 0xcf, 0x5c,
+	0x78, 0x3d, 0x20, 0xa3, 0x2f, 0x6b, 0xc9, 0x2b, 0x39, 0x1d, 0x16, 0x4b, 0x1e, 0x67, 0xa8, 0x05,
+	0xf7, 0x89, 0xdc, 0x82, 0x9b, 0x28, 0xd4, 0xb6, 0xf2, 0xc4, 0xb9, 0xc5, 0xf6, 0xdf, 0x55, 0x98,
+	0xcd, 0xa3, 0x4a, 0x4d, 0x39, 0xb4, 0x80, 0xaa, 0x23, 0x0b, 0xc8, 0x2c, 0x83, 0x89, 0x4b, 0x97,
+	0xc1, 0x1d, 0x98, 0xa7, 0xe7, 0x09, 0xf5, 0x39, 0x0d, 0x5c, 0xb1, 0x1e, 0xbc, 0x20, 0x48, 0xf5,
+	0x82, 0xbc, 0xaa, 0x51, 0xdd, 0xe4, 0xf4, 0x7e, 0x27, 0x08, 0x46, 0xe9, 0xd7, 0x15, 0xfd, 0xd4,
+	0x08, 0xfd, 0xba, 0xa4, 0xff, 0x14, 0xcc, 0x99, 0x4b, 0x31, 0x57, 0x2a, 0x34, 0x5d, 0xae, 0x50,
+	0xcb, 0xd0, 0x1d, 0x08, 0xcd, 0x1e, 0x40, 0x4b, 0xdf, 0xa0, 0xb9, 0x97, 0x2e, 0xe8, 0x59, 0x75,
+	0xb1, 0x26, 0xd9, 0xee, 0x43, 0xf3, 0x28, 0x4e, 0xcf, 0xbc, 0x54, 0x77, 0x57, 0x1b, 0xc3, 0xa5,
+	0xa8, 0x04, 0x97, 0xfd, 0xff, 0x8b, 0x33, 0xac, 0xbc, 0xec, 0xf9, 0x66, 0xd8, 0x4e, 0xa1, 0xa6,
+	0xc5, 0x96, 0xce, 0xd5, 0x6b, 0xd0, 0x0e, 0xa3, 0xe3, 0x94, 0x32, 0x26, 0xdf, 0x82, 0x87, 0xe6,
+	0x80, 0x30, 0xa7, 0xe0, 0x7b, 0x0a, 0x8c, 0xbb, 0x0b, 0x1d, 0xa2, 0x54, 0x97, 0xe0, 0xb4, 0x40,
+	0x68, 0x3f, 0x84, 0x19, 0x15, 0x7c, 0xc8, 0x22, 0x4c, 0xd3, 0x73, 0x3c, 0x60, 0xea, 0x40, 0x4c,
+	0xcf, 0x79, 0x37, 0x41, 0xb0, 0x70, 0xf0, 0x44, 0xaf, 0x55, 0x54, 0x38, 0xb1, 0x1d, 0x98, 0x2f,
+	0x79, 0xfb, 0x84, 0xa7, 0x80, 0x90, 0xc5, 0x2e, 0x0f, 0xfb, 0x94, 0x71, 0xaf, 0xaf, 0x65, 0xcd,
+	0x86, 0x2c, 0x3e, 0xd0, 0x30, 0xbc, 0x65, 0x1c, 0x24, 0x48, 0x22, 0x44, 0x56, 0x1c, 0xd5, 0xb2,
+	0x13, 0xb0, 0xc6, 0xbd, 0x7b, 0x7a, 0xde, 0x55, 0xf2, 0x06, 0x4c, 0xcb, 0x17, 0x39, 0x56, 0xb5,
+	0x40, 0x5a, 0x94, 0xe9, 0x28, 0x22, 0xfb, 0x36, 0xb4, 0x8a, 0x18, 0xd4, 0x4d, 0x09, 0xd0, 0x2f,
+	0x3a, 0x24, 0x65, 0xa7, 0x4c, 0xb7, 0x17, 0x9b, 0xdf, 0x73, 0x58, 0xbb, 0xec, 0x39, 0xd4, 0x8b,
+	0xec, 0xbe, 0x2f, 0x38, 0xcc, 0xee, 0xb8, 0x9e, 0x5f, 0x3c, 0x0c, 0x1e, 0xc3, 0x62, 0xe9, 0xb3,
+	0x26, 0x3c, 0x78, 0x26, 0x83, 0xc3, 0x5e, 0xe8, 0xbb, 0x59, 0xac, 0xaf, 0x4b, 0xc8, 0xe7, 0xe9,
+	0xc5, 0x0b, 0xdf, 0x20, 0xdb, 0x57, 0x61, 0x6e, 0xe8, 0xb5, 0x93, 0xfd, 0x8d, 0x2a, 0x2c, 0x95,
+	0xbf, 0x20, 0xbc, 0xec, 0xd5, 0x8b, 0xc9, 0x01, 0x30, 0xc4, 0xe8, 0xfd, 0x22, 0x54, 0x91, 0xc8,
+	0xe4, 0x00, 0x02, 0x39, 0x61, 0x90, 0x22, 0xec, 0xa0, 0x54, 0x8f, 0xa9, 0xb4, 0x51, 0xe6, 0x55,
+	0xa6, 0x4d, 0x3a, 0x30, 0xad, 0x2a, 0x88, 0xf2, 0x40, 0xfa, 0xda, 0xa5, 0x4f, 0x1c, 0xef, 0xe4,
+	0xcb, 0x88, 0x8a, 0x11, 0xdf, 0xfb, 0xfc, 0x94, 0xd5, 0x07, 0xfb, 0x0b, 0xa3, 0x96, 0x50, 0x73,
+	0xf9, 0xd3, 0x5a, 0xc2, 0x7e, 0x0c, 0x24, 0x2f, 0xf2, 0x43, 0x1a, 0x76, 0x58, 0xdc, 0x87, 0xd5,
+	0xee, 0x09, 0x2c, 0x94, 0x3d, 0x75, 0x7d, 0x0e, 0x81, 0xeb, 0xc3, 0x02, 0xd7, 0xcb, 0x05, 0x3e,
+	0xb7, 0x86, 0x63, 0x04, 0x6e, 0x43, 0xab, 0xf8, 0xcd, 0x44, 0xc9, 0xfb, 0xa5, 0x49, 0xbc, 0x8f,
+	0x50, 0x6b, 0x76, 0x6e, 0xf8, 0x2b, 0x09, 0x81, 0xb4, 0x6f, 0x66, 0x62, 0xc6, 0xbc, 0x4c, 0xfa,
+	0xed, 0x0a, 0xd4, 0x34, 0x89, 0x38, 0xf7, 0x84, 0x81, 0x79, 0xd7, 0x82, 0xbf, 0xc9, 0x75, 0x80,
+	0xbe, 0xc7, 0xb0, 0xfc, 0xe1, 0xa9, 0x13, 0x51, 0xcd, 0xc9, 0x41, 0xe4, 0x30, 0xc2, 0xc4, 0xed,
+	0xe3, 0x81, 0xc9, 0xf8, 0x7c, 0x98, 0x3c, 0xc6, 0xc3, 0xd5, 0x35, 0x80, 0xd3, 0xf3, 0x9e, 0x17,
+	0x49, 0xac, 0xf4, 0xfa, 0xba, 0x80, 0x3c, 0x56, 0x67, 0x2f, 0x61, 0x9a, 0xa9, 0xdc, 0x9b, 0x99,
+	0x5f, 0xae, 0x40, 0xb3, 0x70, 0xa9, 0x81, 0x17, 0x30, 0xa2, 0x07, 0x1a, 0x79, 0x87, 0x3d, 0x1a,
+	0xa8, 0x2f, 0xd8, 0x1a, 0x08, 0xdb, 0x96, 0x20, 0xdc, 0x29, 0x64, 0x3f, 0x9a, 0x46, 0xea, 0x39,
+	0x2b, 0x80, 0x9a, 0xe8, 0x36, 0xb4, 0x0b, 0x44, 0xee, 0xe9, 0xba, 0x7a, 0x23, 0xd3, 0xca, 0xd3,
+	0x3d, 0x5d, 0xb7, 0xff, 0xb1, 0x02, 0x0b, 0x65, 0xdf, 0x75, 0x90, 0x57, 0x73, 0xb1, 0x6d, 0xb9,
+	0xf4, 0x12, 0x52, 0xc5, 0xd4, 0xcf, 0x9a, 0x05, 0x2d, 0x6b, 0x5e, 0xaf, 0x5e, 0xf2, 0xb5, 0xc8,
+	0xcf, 0x7a, 0x39, 0x7f, 0x76, 0x58, 0x79, 0xf3, 0x26, 0xf5, 0xf9, 0x94, 0xb7, 0xb7, 0xa0, 0x3d,
+	0x0c, 0x2f, 0x3e, 0x10, 0xaa, 0x0c, 0x3f, 0x10, 0x2a, 0x7b, 0xfc, 0xf4, 0xc3, 0x0a, 0xcc, 0x0d,
+	0x7d, 0x78, 0x42, 0xec, 0x9c, 0x0a, 0x64, 0xf8, 0xbb, 0x12, 0x65, 0xba, 0x4f, 0x0f, 0x99, 0xce,
+	0x2e, 0xff, 0x88, 0xe5, 0x67, 0x6d, 0xb5, 0x07, 0x39, 0x6d, 0x95, 0xc1, 0x9e, 0x43, 0x5b, 0xfb,
+	0x23, 0xd0, 0xc8, 0x81, 0x4a, 0xdf, 0xcf, 0x1d, 0x00, 0xc8, 0xef, 0x47, 0x0e, 0x54, 0x6d, 0x01,
+	0x3d, 0x57, 0x79, 0xb1, 0xf8, 0x2d, 0xb4, 0x42, 0x0f, 0x54, 0x6e, 0x2b, 0x1b, 0x68, 0x72, 0xf3,
+	0xb6, 0x57, 0x3f, 0xe6, 0x32, 0x00, 0xfb, 0xdf, 0xab, 0xd0, 0xc8, 0x7d, 0x51, 0x43, 0x6e, 0xe5,
+	0xea, 0x18, 0xd9, 0x6e, 0x28, 0x28, 0xb2, 0x87, 0x94, 0xe4, 0x93, 0x30, 0xab, 0x2e, 0x32, 0xe5,
+	0x1b, 0x13, 0xb9, 0x77, 0x5e, 0x35, 0xd1, 0x03, 0xc3, 0x80, 0x20, 0x87, 0x30, 0xd1, 0xbf, 0xd1,
+	0x8c, 0x01, 0xe3, 0xfa, 0xa8, 0x1c, 0x30, 0x4e, 0x6c, 0x79, 0x73, 0x83, 0xd7, 0xaf, 0xa2, 0x9e,
+	0xa1, 0x96, 0x36, 0xbe, 0x27, 0xc2, 0xbb, 0x57, 0xb4, 0x08, 0xbe, 0x92, 0x31, 0x34, 0x61, 0xa2,
+	0x1f, 0x95, 0x29, 0x8a, 0x6e, 0x82, 0xa7, 0x05, 0xe6, 0xf5, 0xa9, 0xcb, 0x06, 0x87, 0x78, 0x03,
+	0x3a, 0x23, 0x23, 0x0b, 0x82, 0xf6, 0x05, 0x04, 0xd7, 0x3d, 0xe6, 0xd9, 0xf1, 0x80, 0x1f, 0xc7,
+	0x78, 0x3b, 0x54, 0x93, 0xeb, 0x3e, 0xf2, 0xf8, 0x13, 0x05, 0xc2, 0x52, 0xa4, 0xbc, 0x28, 0xd3,
+	0x25, 0x0c, 0xf1, 0x7a, 0xaa, 0xe6, 0x34, 0x05, 0x54, 0x67, 0x1d, 0xe4, 0x1e, 0x34, 0xb8, 0x98,
+	0x01, 0x39, 0x68, 0xf9, 0x0c, 0x5a, 0x0f, 0x3a, 0x9b, 0x1b, 0x07, 0xb8, 0xf9, 0x6d, 0xdf, 0x50,
+	0xe6, 0x55, 0xbe, 0xa0, 0x6c, 0x50, 0x35, 0x36, 0xb0, 0xff, 0xab, 0x02, 0x2b, 0x63, 0xbf, 0x30,
+	0x12, 0x8e, 0x10, 0x07, 0x72, 0x3a, 0xd0, 0x11, 0xe2, 0xc0, 0x94, 0x1c, 0xaa, 0x59, 0xc9, 0xa1,
+	0xb0, 0x4b, 0x4d, 0x0c, 0x65, 0x13, 0xb7, 0xa1, 0x9d, 0x78, 0x29, 0x8d, 0xb8, 0x1b, 0x50, 0x71,
+	0xaf, 0x1c, 0x26, 0xca, 0xce, 0x2d, 0x09, 0xdf, 0x12, 0x60, 0x99, 0x56, 0xf7, 0x3d, 0x1f, 0xe3,
+	0x99, 0xb4, 0xf2, 0x54, 0xdf, 0xf3, 0x9f, 0xae, 0x17, 0x77, 0x98, 0xe9, 0xa1, 0x74, 0xe4, 0xe3,
+	0x40, 0x86, 0xa5, 0x9f, 0xae, 0x8b, 0x59, 0xa8, 0x3b, 0xed, 0xa2, 0xfc, 0xd3, 0x75, 0xfb, 0x13,
+	0xa5, 0x63, 0x55, 0xb6, 0x29, 0x19, 0xab, 0xfd, 0xf5, 0x0a, 0x2c, 0x8f, 0xf9, 0xce, 0xe9, 0xd2,
+	0x5d, 0xb1, 0x98, 0xf9, 0x55, 0x87, 0x33, 0xbf, 0x3b, 0x30, 0x1f, 0x46, 0x9c, 0xa6, 0x47, 0x9e,
+	0xd4, 0xb8, 0x60, 0xba, 0xab, 0x06, 0xa5, 0xcf, 0x86, 0xf6, 0x83, 0x12, 0x2d, 0x9e, 0xbd, 0x37,
+	0xe3, 0x3d, 0xcb, 0xca, 0xd8, 0x2f, 0x7a, 0x2e, 0xd5, 0xdf, 0x86, 0x66, 0xa6, 0x3f, 0xce, 0x88,
+	0x1c, 0x42, 0xc3, 0x0c, 0xe1, 0xe9, 0xfa, 0xc8, 0x20, 0xd6, 0xc7, 0x0e, 0x42, 0x26, 0x03, 0x0f,
+	0x4b, 0x95, 0x79, 0x8e, 0x61, 0xfc, 0x53, 0x05, 0x16, 0x4b, 0xbf, 0xd8, 0xc2, 0xbb, 0x13, 0xfd,
+	0x5a, 0x41, 0x7f, 0x1e, 0x8e, 0xbb, 0xbd, 0x2e, 0xf2, 0xce, 0x2b, 0xe4, 0xa6, 0xc4, 0x6d, 0x22,
+	0x8a, 0xdc, 0xcf, 0x3e, 0x5e, 0xa4, 0xe7, 0x9c, 0xa6, 0x91, 0xd7, 0x53, 0x4c, 0x55, 0x75, 0xa1,
+	0x2a, 0xb1, 0xdb, 0x0a, 0x29, 0xb9, 0x3e, 0x03, 0xab, 0x9a, 0x0b, 0xd7, 0xe2, 0xa1, 0xd7, 0xf3,
+	0x22, 0xdf, 0x74, 0x27, 0x0f, 0x92, 0x96, 0xa2, 0x78, 0x94, 0x23, 0x10, 0xdc, 0x76, 0x1f, 0x1a,
+	0xb9, 0xc7, 0x13, 0x64, 0x35, 0x2b, 0xc2, 0xea, 0xc1, 0xee, 0xe5, 0x8a, 0x35, 0x48, 0xa3, 0xeb,
+	0xa5, 0x9a, 0x1e, 0xa3, 0xcd, 0x9e, 0x2e, 0xe2, 0x4c, 0x39, 0xa6, 0x8d, 0xf4, 0xbb, 0x59, 0xe8,
+	0x12, 0xbf, 0x71, 0x4d, 0x37, 0x0b, 0x5f, 0x95, 0x95, 0x9e, 0x9d, 0x0b, 0x7b, 0x61, 0xb5, 0x64,
+	0x2f, 0x34, 0xaf, 0xdb, 0xeb, 0x2a, 0xec, 0x5e, 0x03, 0xd0, 0x66, 0x36, 0x8b, 0xb8, 0xae, 0x20,
+	0xdd, 0x04, 0x4f, 0xd8, 0x05, 0xdb, 0x98, 0x70, 0xd9, 0xca, 0x83, 0xbb, 0x09, 0x86, 0x44, 0x63,
+	0xfa, 0x30, 0xd1, 0x75, 0xc6, 0x86, 0x86, 0x75, 0x13, 0x46, 0x6e, 0xeb, 0xf2, 0x9a, 0xac, 0x4c,
+	0x90, 0xe2, 0x46, 0x9f, 0xab, 0xae, 0xd9, 0x1d, 0x33, 0xd6, 0xdc, 0x3a, 0x7e, 0xa1, 0xb1, 0xbe,
+	0x7e, 0x1b, 0xdf, 0xe5, 0xeb, 0x67, 0xba, 0x33, 0x30, 0xd1, 0xd9, 0xfd, 0x52, 0xfb, 0x0a, 0xa9,
+	0xc1, 0x64, 0x77, 0xef, 0xe9, 0xfd, 0xf6, 0xa4, 0xfa, 0xb5, 0xde, 0x9e, 0x7e, 0xfd, 0x9b, 0xf8,
+	0xa9, 0x83, 0xde, 0x8c, 0x48, 0x13, 0xea, 0x9b, 0xdd, 0x2d, 0xc7, 0xed, 0xee, 0xbe, 0xfd, 0xa4,
+	0x7d, 0x85, 0xcc, 0xc3, 0x9c, 0xb3, 0xfd, 0xf8, 0xc9, 0xc1, 0xb6, 0xfb, 0xee, 0x13, 0xe7, 0xf3,
+	0x8f, 0x9e, 0x74, 0xb6, 0xda, 0x15, 0x7c, 0xde, 0xaf, 0x80, 0x3b, 0x4f, 0xf6, 0x0f, 0xda, 0x55,
+	0x42, 0xa0, 0xf5, 0xe8, 0xc9, 0x66, 0xe7, 0x51, 0x46, 0x34, 0x41, 0x5a, 0x00, 0x12, 0x26, 0x68,
+	0x26, 0xc9, 0x55, 0x68, 0x2a, 0xa6, 0x83, 0x2f, 0xee, 0xee, 0x6e, 0x3f, 0x6a, 0x4f, 0x91, 0x36,
+	0xcc, 0x4a, 0x12, 0x05, 0x99, 0x7e, 0xfd, 0x4d, 0x80, 0x6c, 0xa7, 0x43, 0x1d, 0x77, 0x9f, 0xec,
+	0x6e, 0xb7, 0xaf, 0x90, 0x59, 0xa8, 0xed, 0x3e, 0x71, 0xb7, 0x77, 0x37, 0x3b, 0x7b, 0xed, 0x0a,
+	0xa9, 0xc3, 0x94, 0x08, 0x79, 0xed, 0xaa, 0x1c, 0x46, 0x77, 0xaf, 0x3d, 0x71, 0xef, 0x2d, 0x00,
+	0xf9, 0xa0, 0x5b, 0x7c, 0x1e, 0x71, 0x17, 0x26, 0xc5, 0x5f, 0x63, 0xe4, 0xec, 0x1f, 0x2f, 0xac,
+	0x6a, 0x58, 0xee, 0xff, 0x2a, 0xdc, 0xad, 0x6c, 0x2c, 0xff, 0xe8, 0x83, 0xeb, 0x95, 0x7f, 0xf9,
+	0xe0, 0x7a, 0xe5, 0x3f, 0x3e, 0xb8, 0x5e, 0xf9, 0xce, 0x7f, 0x5e, 0xbf, 0xf2, 0xe5, 0x29, 0x51,
+	0x6d, 0x3c, 0x9c, 0x16, 0x7f, 0x3e, 0xf9, 0xbf, 0x03, 0x00, 0x52, 0xde, 0xdd, 0xd4, 0xda, 0x41,
+	0x00, 0x00,
 }
@@ -300,10 +300,15 @@ type Config struct {
 	VXLANVNI       int
 
 	IPIPEnabled            bool
+	IPIPEnabledV6          bool
 	FelixConfigIPIPEnabled *bool
 	// IPIPTunnelAddress is an address chosen from an IPAM pool, used as a source address
 	// by the host when sending traffic to a workload over IPIP.
-	IPIPTunnelAddress net.IP
+	IPIPTunnelAddress   net.IP
+	IPIPTunnelAddressV6 net.IP
+	// IPIPRemote, if set, is the remote address of a point-to-point IPIP tunnel, which uses
+	// the ipip-p2p.calico device rather than tunl0.
+	IPIPRemote net.IP
 	// Same for VXLAN.
 	VXLANTunnelAddress   net.IP
 	VXLANTunnelAddressV6 net.IP
@@ -37,6 +37,7 @@ const (
 	ProtoIPIP   = 4
 	ProtoTCP    = 6
 	ProtoUDP    = 17
+	ProtoIPv6   = 41
 	ProtoICMPv6 = 58
 )
 
@@ -269,6 +270,25 @@ func (r *DefaultRuleRenderer) filterInputChain(ipVersion uint8) *Chain {
 		)
 	}
 
+	if ipVersion == 6 && r.IPIPEnabledV6 {
+		// IPv6 IPIP is enabled, filter incoming IPv6-in-IPv6 packets in the same way.  The
+		// ip6tnl.calico device carries IPv6 in IPv6, which is protocol 41.
+		inputRules = append(inputRules,
+			Rule{
+				Match: Match().ProtocolNum(ProtoIPv6).
+					SourceIPSet(r.IPSetConfigV6.NameForMainIPSet(IPSetIDAllHostNets)).
+					DestAddrType(AddrTypeLocal),
+				Action:  r.filterAllowAction,
+				Comment: []string{"Allow IPv6 IPIP packets from Calico hosts"},
+			},
+			Rule{
+				Match:   Match().ProtocolNum(ProtoIPv6),
+				Action:  r.IptablesFilterDenyAction(),
+				Comment: []string{fmt.Sprintf("%s IPv6 IPIP packets from non-Calico hosts", r.IptablesFilterDenyAction())},
+			},
+		)
+	}
+
 	if ipVersion == 4 && r.VXLANEnabled {
 		// IPv4 VXLAN is enabled, filter incoming VXLAN packets that match our VXLAN port to ensure they
 		// come from a recognised host and are going to a local address on the host.
@@ -760,6 +780,19 @@ func (r *DefaultRuleRenderer) filterOutputChain(ipVersion uint8) *Chain {
 		)
 	}
 
+	if ipVersion == 6 && r.IPIPEnabledV6 {
+		// Likewise, auto-allow IPv6 IPIP traffic to other Calico nodes.
+		rules = append(rules,
+			Rule{
+				Match: Match().ProtocolNum(ProtoIPv6).
+					DestIPSet(r.IPSetConfigV6.NameForMainIPSet(IPSetIDAllHostNets)).
+					SrcAddrType(AddrTypeLocal, false),
+				Action:  r.filterAllowAction,
+				Comment: []string{"Allow IPv6 IPIP packets to other Calico hosts"},
+			},
+		)
+	}
+
 	if ipVersion == 4 && r.VXLANEnabled {
 		// When IPv4 VXLAN is enabled, auto-allow VXLAN traffic to other Calico nodes.  Without this,
 		// it's too easy to make a host policy that blocks VXLAN traffic, resulting in very confusing
@@ -912,7 +945,14 @@ func (r *DefaultRuleRenderer) StaticNATPostroutingChains(ipVersion uint8) []*Cha
 	var tunnelIfaces []string
 
 	if ipVersion == 4 && r.IPIPEnabled && len(r.IPIPTunnelAddress) > 0 {
-		tunnelIfaces = append(tunnelIfaces, "tunl0")
+		if r.IPIPRemote != nil {
+			tunnelIfaces = append(tunnelIfaces, "ipip-p2p.calico")
+		} else {
+			tunnelIfaces = append(tunnelIfaces, "tunl0")
+		}
+	}
+	if ipVersion == 6 && r.IPIPEnabledV6 && len(r.IPIPTunnelAddressV6) > 0 {
+		tunnelIfaces = append(tunnelIfaces, "ip6tnl.calico")
 	}
 	if ipVersion == 4 && r.VXLANEnabled && len(r.VXLANTunnelAddress) > 0 {
 		tunnelIfaces = append(tunnelIfaces, "vxlan.calico")
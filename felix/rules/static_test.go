@@ -993,6 +993,31 @@ var _ = Describe("Static", func() {
 				})
 			})
 
+			Describe("with a point-to-point IPIP tunnel", func() {
+				BeforeEach(func() {
+					conf.IPIPRemote = net.IP{172, 16, 0, 2}
+				})
+
+				It("IPv4: Should SNAT traffic out of the point-to-point device", func() {
+					Expect(rr.StaticNATPostroutingChains(4)).To(Equal([]*Chain{
+						{
+							Name: "cali-POSTROUTING",
+							Rules: []Rule{
+								{Action: JumpAction{Target: "cali-fip-snat"}},
+								{Action: JumpAction{Target: "cali-nat-outgoing"}},
+								{
+									Match: Match().
+										OutInterface("ipip-p2p.calico").
+										NotSrcAddrType(AddrTypeLocal, true).
+										SrcAddrType(AddrTypeLocal, false),
+									Action: MasqAction{},
+								},
+							},
+						},
+					}))
+				})
+			})
+
 			Describe("with IPv6 VXLAN enabled", func() {
 				BeforeEach(func() {
 					conf.VXLANEnabledV6 = true
@@ -1039,6 +1064,69 @@ var _ = Describe("Static", func() {
 				})
 			})
 
+			Describe("with IPv6 IPIP enabled", func() {
+				BeforeEach(func() {
+					conf.IPIPEnabledV6 = true
+				})
+
+				It("IPv6: should filter incoming IPv6 IPIP packets by source host", func() {
+					Expect(findChain(rr.StaticFilterTableChains(6), "cali-INPUT").Rules[:2]).To(Equal([]Rule{
+						{
+							Match: Match().
+								ProtocolNum(41).
+								SourceIPSet("cali60all-hosts-net").
+								DestAddrType("LOCAL"),
+							Action:  AcceptAction{},
+							Comment: []string{"Allow IPv6 IPIP packets from Calico hosts"},
+						},
+						{
+							Match:   Match().ProtocolNum(41),
+							Action:  denyAction,
+							Comment: []string{fmt.Sprintf("%s IPv6 IPIP packets from non-Calico hosts", denyAction)},
+						},
+					}))
+				})
+				It("IPv6: should allow outgoing IPv6 IPIP packets to other hosts", func() {
+					Expect(findChain(rr.StaticFilterTableChains(6), "cali-OUTPUT").Rules).To(ContainElement(Rule{
+						Match: Match().
+							ProtocolNum(41).
+							DestIPSet("cali60all-hosts-net").
+							SrcAddrType(AddrTypeLocal, false),
+						Action:  AcceptAction{},
+						Comment: []string{"Allow IPv6 IPIP packets to other Calico hosts"},
+					}))
+				})
+				It("IPv4: should not have IPv6 IPIP rules", func() {
+					Expect(findChain(rr.StaticFilterTableChains(4), "cali-INPUT").Rules).NotTo(ContainElement(
+						HaveField("Match", Match().ProtocolNum(41))))
+				})
+
+				Describe("and IPv6 tunnel IP", func() {
+					BeforeEach(func() {
+						conf.IPIPTunnelAddressV6 = net.ParseIP("dead:beef::1")
+					})
+
+					It("IPv6: Should SNAT traffic out of the IPv6 IPIP device", func() {
+						Expect(rr.StaticNATPostroutingChains(6)).To(Equal([]*Chain{
+							{
+								Name: "cali-POSTROUTING",
+								Rules: []Rule{
+									{Action: JumpAction{Target: "cali-fip-snat"}},
+									{Action: JumpAction{Target: "cali-nat-outgoing"}},
+									{
+										Match: Match().
+											OutInterface("ip6tnl.calico").
+											NotSrcAddrType(AddrTypeLocal, true).
+											SrcAddrType(AddrTypeLocal, false),
+										Action: MasqAction{},
+									},
+								},
+							},
+						}))
+					})
+				})
+			})
+
 			It("IPv6: Should return expected NAT postrouting chain", func() {
 				Expect(rr.StaticNATPostroutingChains(6)).To(Equal([]*Chain{
 					{
@@ -84,6 +84,41 @@ type Rule struct {
 	// These fields allow us to pass through application layer selectors from the V3 datamodel.
 	HTTPMatch *HTTPMatch `json:"http,omitempty" validate:"omitempty"`
 
+	// These fields are only matched by Dikastes.  They have no equivalent in the V3 datamodel yet.
+	DstAnnotations           map[string]string `json:"dst_annotations,omitempty" validate:"omitempty"`
+	AppProtocols             []string          `json:"app_protocols,omitempty" validate:"omitempty"`
+	SrcIsLocalNode           bool              `json:"src_is_local_node,omitempty"`
+	JWTAudiences             []string          `json:"jwt_audiences,omitempty" validate:"omitempty"`
+	RouteNames               []string          `json:"route_names,omitempty" validate:"omitempty"`
+	SrcIPPools               []string          `json:"src_ip_pools,omitempty" validate:"omitempty"`
+	DstServicePorts          []string          `json:"dst_service_ports,omitempty" validate:"omitempty"`
+	DirectRemoteNets         []*net.IPNet      `json:"direct_remote_nets,omitempty" validate:"omitempty"`
+	DstEncapsulations        []string          `json:"dst_encapsulations,omitempty" validate:"omitempty"`
+	TLSTerminated            bool              `json:"tls_terminated,omitempty"`
+	DstReady                 bool              `json:"dst_ready,omitempty"`
+	GRPCCallTypes            []string          `json:"grpc_call_types,omitempty" validate:"omitempty"`
+	SrcIPSetAddedWithinSecs  uint32            `json:"src_ip_set_added_within_secs,omitempty"`
+	DstIPSetAddedWithinSecs  uint32            `json:"dst_ip_set_added_within_secs,omitempty"`
+	SrcIPSetCardinalityAbove uint32            `json:"src_ip_set_cardinality_above,omitempty"`
+	RequestLabelSelector     string            `json:"request_label_selector,omitempty" validate:"omitempty,selector"`
+	SrcNamespaceLabels       map[string]string `json:"src_namespace_labels,omitempty" validate:"omitempty"`
+	DstInLocalIPAMBlock      bool              `json:"dst_in_local_ipam_block,omitempty" validate:"omitempty"`
+	TLSFingerprints          []string          `json:"tls_fingerprints,omitempty" validate:"omitempty"`
+	NotTLSFingerprints       []string          `json:"not_tls_fingerprints,omitempty" validate:"omitempty"`
+	GRPCServices             []string          `json:"grpc_services,omitempty" validate:"omitempty"`
+	GRPCMethods              []string          `json:"grpc_methods,omitempty" validate:"omitempty"`
+	AllowHairpin             bool              `json:"allow_hairpin,omitempty"`
+	DstListening             bool              `json:"dst_listening,omitempty"`
+	SrcPrincipalPrefixes     []string          `json:"src_principal_prefixes,omitempty" validate:"omitempty"`
+	SrcPrincipalSuffixes     []string          `json:"src_principal_suffixes,omitempty" validate:"omitempty"`
+	DstPrincipalPrefixes     []string          `json:"dst_principal_prefixes,omitempty" validate:"omitempty"`
+	DstPrincipalSuffixes     []string          `json:"dst_principal_suffixes,omitempty" validate:"omitempty"`
+	SrcZones                 []string          `json:"src_zones,omitempty" validate:"omitempty"`
+	SrcRegions               []string          `json:"src_regions,omitempty" validate:"omitempty"`
+	DstReverseDNSNames       []string          `json:"dst_reverse_dns_names,omitempty" validate:"omitempty"`
+	ConnectionReused         bool              `json:"connection_reused,omitempty"`
+	SrcASNumbers             []uint32          `json:"src_as_numbers,omitempty" validate:"omitempty"`
+
 	LogPrefix string `json:"log_prefix,omitempty" validate:"omitempty"`
 
 	Metadata *RuleMetadata `json:"metadata,omitempty" validate:"omitempty"`
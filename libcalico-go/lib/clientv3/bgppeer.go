@@ -150,3 +150,32 @@ func (r bgpPeers) List(ctx context.Context, opts options.ListOptions) (*apiv3.BG
 func (r bgpPeers) Watch(ctx context.Context, opts options.ListOptions) (watch.Interface, error) {
 	return r.client.resources.Watch(ctx, opts, apiv3.KindBGPPeer, nil)
 }
+
+// BGPPeerBatchResult summarises the outcome of CreateBGPPeers.
+type BGPPeerBatchResult struct {
+	// Created holds the stored representation of each BGPPeer that was created.
+	Created []*apiv3.BGPPeer
+	// AlreadyExisted holds the names of the BGPPeers that were skipped because they already exist.
+	AlreadyExisted []string
+	// Errors holds the error for each BGPPeer that could not be created, keyed by name.
+	Errors map[string]error
+}
+
+// CreateBGPPeers creates each of the given BGPPeers in turn.  Unlike Create, a peer that already
+// exists is not treated as a failure, and a failure to create one peer does not prevent the rest
+// from being created; the outcome for each peer is recorded in the returned result.
+func CreateBGPPeers(ctx context.Context, client BGPPeerInterface, peers []*apiv3.BGPPeer, opts options.SetOptions) BGPPeerBatchResult {
+	result := BGPPeerBatchResult{Errors: map[string]error{}}
+	for _, peer := range peers {
+		out, err := client.Create(ctx, peer, opts)
+		switch err.(type) {
+		case nil:
+			result.Created = append(result.Created, out)
+		case cerrors.ErrorResourceAlreadyExists:
+			result.AlreadyExisted = append(result.AlreadyExisted, peer.Name)
+		default:
+			result.Errors[peer.Name] = err
+		}
+	}
+	return result
+}
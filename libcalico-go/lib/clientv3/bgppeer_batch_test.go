@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// fakeBGPPeers is an in-memory BGPPeerInterface that only supports Create.
+type fakeBGPPeers struct {
+	BGPPeerInterface
+	peers     map[string]*apiv3.BGPPeer
+	failNames map[string]error
+}
+
+func (f *fakeBGPPeers) Create(ctx context.Context, res *apiv3.BGPPeer, opts options.SetOptions) (*apiv3.BGPPeer, error) {
+	if err := f.failNames[res.Name]; err != nil {
+		return nil, err
+	}
+	if _, ok := f.peers[res.Name]; ok {
+		return nil, cerrors.ErrorResourceAlreadyExists{Identifier: res.Name}
+	}
+	out := res.DeepCopy()
+	out.ResourceVersion = "1"
+	f.peers[res.Name] = out
+	return out, nil
+}
+
+var _ = Describe("CreateBGPPeers", func() {
+	peer := func(name, ip string) *apiv3.BGPPeer {
+		return &apiv3.BGPPeer{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       apiv3.BGPPeerSpec{PeerIP: ip, ASNumber: 64512},
+		}
+	}
+
+	It("should create new peers, skip existing ones and collect errors", func() {
+		backendErr := errors.New("datastore unavailable")
+		fake := &fakeBGPPeers{
+			peers:     map[string]*apiv3.BGPPeer{"existing": peer("existing", "10.0.0.1")},
+			failNames: map[string]error{"broken": backendErr},
+		}
+
+		result := CreateBGPPeers(context.Background(), fake, []*apiv3.BGPPeer{
+			peer("new-1", "10.0.0.2"),
+			peer("existing", "10.0.0.1"),
+			peer("broken", "10.0.0.3"),
+			peer("new-2", "10.0.0.4"),
+		}, options.SetOptions{})
+
+		Expect(result.Created).To(HaveLen(2))
+		Expect(result.Created[0].Name).To(Equal("new-1"))
+		Expect(result.Created[0].ResourceVersion).To(Equal("1"))
+		Expect(result.Created[1].Name).To(Equal("new-2"))
+		Expect(result.AlreadyExisted).To(Equal([]string{"existing"}))
+		Expect(result.Errors).To(Equal(map[string]error{"broken": backendErr}))
+		Expect(fake.peers).To(HaveLen(3))
+	})
+})
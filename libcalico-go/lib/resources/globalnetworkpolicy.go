@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"reflect"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v3listers "github.com/projectcalico/api/pkg/client/listers_generated/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+// GlobalNetworkPoliciesForNamespace returns the GlobalNetworkPolicies in the lister whose namespace selector
+// selects the given namespace, sorted by name. A policy with no namespace selector applies to every namespace.
+// Policies with a namespace selector that fails to parse are skipped.
+func GlobalNetworkPoliciesForNamespace(
+	lister v3listers.GlobalNetworkPolicyLister,
+	ns *corev1.Namespace,
+) ([]*v3.GlobalNetworkPolicy, error) {
+	gnps, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	// Namespace selectors may also select on the namespace name, which Calico exposes as a label.
+	nsLabels := map[string]string{conversion.NameLabel: ns.Name}
+	for k, v := range ns.Labels {
+		nsLabels[k] = v
+	}
+
+	var matches []*v3.GlobalNetworkPolicy
+	for _, gnp := range gnps {
+		if gnp.Spec.NamespaceSelector == "" {
+			matches = append(matches, gnp)
+			continue
+		}
+		sel, err := selector.Parse(gnp.Spec.NamespaceSelector)
+		if err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"policy":   gnp.Name,
+				"selector": gnp.Spec.NamespaceSelector,
+			}).Warn("Failed to parse namespace selector, skipping policy")
+			continue
+		}
+		if sel.Evaluate(nsLabels) {
+			matches = append(matches, gnp)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Name < matches[j].Name
+	})
+	return matches, nil
+}
+
+// DiffGlobalNetworkPolicy returns true if the specs of the two GlobalNetworkPolicies differ.  Metadata, such as the
+// resource version and managed fields, is ignored so that controllers can use this to skip no-op updates.
+func DiffGlobalNetworkPolicy(a, b *v3.GlobalNetworkPolicy) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return !reflect.DeepEqual(a.Spec, b.Spec)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v3listers "github.com/projectcalico/api/pkg/client/listers_generated/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+)
+
+var tcp = numorstring.ProtocolFromString("TCP")
+
+func gnp(name, nsSelector string) *v3.GlobalNetworkPolicy {
+	return &v3.GlobalNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v3.GlobalNetworkPolicySpec{NamespaceSelector: nsSelector},
+	}
+}
+
+func TestGlobalNetworkPoliciesForNamespace(t *testing.T) {
+	RegisterTestingT(t)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, p := range []*v3.GlobalNetworkPolicy{
+		gnp("all-namespaces", ""),
+		gnp("prod-only", "env == 'prod'"),
+		gnp("dev-only", "env == 'dev'"),
+		gnp("by-name", "projectcalico.org/name == 'payments'"),
+		gnp("other-name", "projectcalico.org/name == 'frontend'"),
+		gnp("has-team", "has(team)"),
+		gnp("bad-selector", "env === 'prod'"),
+	} {
+		Expect(indexer.Add(p)).To(Succeed())
+	}
+	lister := v3listers.NewGlobalNetworkPolicyLister(indexer)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "payments",
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+	gnps, err := GlobalNetworkPoliciesForNamespace(lister, ns)
+	Expect(err).NotTo(HaveOccurred())
+
+	var names []string
+	for _, p := range gnps {
+		names = append(names, p.Name)
+	}
+	Expect(names).To(Equal([]string{"all-namespaces", "by-name", "prod-only"}))
+}
+
+func TestGlobalNetworkPoliciesForNamespaceNoPolicies(t *testing.T) {
+	RegisterTestingT(t)
+
+	lister := v3listers.NewGlobalNetworkPolicyLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	gnps, err := GlobalNetworkPoliciesForNamespace(lister, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(gnps).To(BeEmpty())
+}
+
+func TestDiffGlobalNetworkPolicy(t *testing.T) {
+	RegisterTestingT(t)
+
+	order := 100.0
+	a := &v3.GlobalNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gnp", ResourceVersion: "1"},
+		Spec: v3.GlobalNetworkPolicySpec{
+			Order:    &order,
+			Selector: "app == 'frontend'",
+			Ingress:  []v3.Rule{{Action: v3.Allow, Protocol: &tcp}},
+		},
+	}
+
+	// Metadata-only differences are ignored.
+	b := a.DeepCopy()
+	b.ResourceVersion = "2"
+	b.Generation = 3
+	b.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate}}
+	Expect(DiffGlobalNetworkPolicy(a, b)).To(BeFalse())
+	Expect(DiffGlobalNetworkPolicy(a, a)).To(BeFalse())
+
+	// A different rule is a difference.
+	b = a.DeepCopy()
+	b.Spec.Ingress[0].Action = v3.Deny
+	Expect(DiffGlobalNetworkPolicy(a, b)).To(BeTrue())
+
+	// As is a different order.
+	b = a.DeepCopy()
+	otherOrder := 200.0
+	b.Spec.Order = &otherOrder
+	Expect(DiffGlobalNetworkPolicy(a, b)).To(BeTrue())
+
+	Expect(DiffGlobalNetworkPolicy(a, nil)).To(BeTrue())
+	Expect(DiffGlobalNetworkPolicy(nil, nil)).To(BeFalse())
+}